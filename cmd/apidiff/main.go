@@ -0,0 +1,380 @@
+// API surface diff for the generated client.
+// Compares the exported API of lib between two versions, by default the
+// last commit and the working tree after a regeneration, and reports the
+// methods, functions, types, fields and constants that were added, removed
+// or changed, with the version bump that implies. A game patch that renames
+// or retypes a field shows up here before it breaks downstream code.
+//
+// Usage (in cmd/): go run ./apidiff [-old HEAD] [-new ../lib] [-json] [-fail-breaking]
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	oldRef := flag.String("old", "HEAD", "Old version: a directory, or a git revision of the -new directory")
+	newDir := flag.String("new", "../lib", "Directory of the new version")
+	asJSON := flag.Bool("json", false, "Print the report as JSON")
+	failBreaking := flag.Bool("fail-breaking", false, "Exit with status 2 when the change is breaking")
+	flag.Parse()
+
+	rep, err := run(*oldRef, *newDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(rep)
+	} else {
+		rep.write(os.Stdout)
+	}
+	if *failBreaking && rep.Bump == "major" {
+		os.Exit(2)
+	}
+}
+
+func run(oldRef, newDir string) (*report, error) {
+	newFiles, err := readDir(newDir)
+	if err != nil {
+		return nil, err
+	}
+	var oldFiles map[string][]byte
+	if fi, err := os.Stat(oldRef); err == nil && fi.IsDir() {
+		oldFiles, err = readDir(oldRef)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		oldFiles, err = readGit(newDir, oldRef)
+		if err != nil {
+			return nil, err
+		}
+	}
+	oldAPI, err := surface(oldFiles)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", oldRef, err)
+	}
+	newAPI, err := surface(newFiles)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", newDir, err)
+	}
+	rep := compare(oldAPI, newAPI)
+	rep.Old, rep.New = oldRef, newDir
+	return rep, nil
+}
+
+// readDir reads the package's non-test Go files.
+func readDir(dir string) (map[string][]byte, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte)
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		files[filepath.Base(name)] = data
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return files, nil
+}
+
+// readGit reads the package's Go files as they were at a revision.
+func readGit(dir, rev string) (map[string][]byte, error) {
+	out, err := exec.Command("git", "-C", dir, "ls-tree", "--name-only", rev, "./").Output()
+	if err != nil {
+		return nil, fmt.Errorf("git ls-tree %s: %w", rev, gitErr(err))
+	}
+	files := make(map[string][]byte)
+	for _, name := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		name = filepath.Base(name)
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		data, err := exec.Command("git", "-C", dir, "show", rev+":./"+name).Output()
+		if err != nil {
+			return nil, fmt.Errorf("git show %s:%s: %w", rev, name, gitErr(err))
+		}
+		files[name] = data
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files in %s at %s", dir, rev)
+	}
+	return files, nil
+}
+
+func gitErr(err error) error {
+	if ee, ok := err.(*exec.ExitError); ok && len(ee.Stderr) > 0 {
+		return fmt.Errorf("%s", strings.TrimSpace(string(ee.Stderr)))
+	}
+	return err
+}
+
+// surface maps every exported identifier of a package to its declaration:
+// "func NewClient" → "func(string) *Client", "(*Client).RestWatchStandings"
+// → "func() ([]RestWatchStandingsResponseItem, error)", "Struct.Field" →
+// its type and tag. Parameter names are left out, they do not affect
+// callers.
+func surface(files map[string][]byte) (map[string]string, error) {
+	fset := token.NewFileSet()
+	api := make(map[string]string)
+	for name, data := range files {
+		f, err := parser.ParseFile(fset, name, data, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if !d.Name.IsExported() {
+					continue
+				}
+				key := "func " + d.Name.Name
+				if d.Recv != nil && len(d.Recv.List) > 0 {
+					recv := expr(fset, d.Recv.List[0].Type)
+					if !ast.IsExported(strings.TrimPrefix(recv, "*")) {
+						continue
+					}
+					key = "(" + recv + ")." + d.Name.Name
+				}
+				api[key] = signature(fset, d.Type)
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					switch s := spec.(type) {
+					case *ast.TypeSpec:
+						if s.Name.IsExported() {
+							typeSurface(fset, api, s)
+						}
+					case *ast.ValueSpec:
+						kind := "var"
+						if d.Tok == token.CONST {
+							kind = "const"
+						}
+						for _, n := range s.Names {
+							if !n.IsExported() {
+								continue
+							}
+							typ := ""
+							if s.Type != nil {
+								typ = " " + expr(fset, s.Type)
+							}
+							api[kind+" "+n.Name] = kind + typ
+						}
+					}
+				}
+			}
+		}
+	}
+	return api, nil
+}
+
+func typeSurface(fset *token.FileSet, api map[string]string, s *ast.TypeSpec) {
+	name := s.Name.Name
+	alias := ""
+	if s.Assign.IsValid() {
+		alias = "= "
+	}
+	switch t := s.Type.(type) {
+	case *ast.StructType:
+		api["type "+name] = "struct"
+		for _, f := range t.Fields.List {
+			tag := ""
+			if f.Tag != nil {
+				tag = " " + f.Tag.Value
+			}
+			typ := expr(fset, f.Type)
+			if len(f.Names) == 0 { // embedded
+				api[name+"."+strings.TrimPrefix(typ, "*")] = "embedded " + typ + tag
+				continue
+			}
+			for _, n := range f.Names {
+				if n.IsExported() {
+					api[name+"."+n.Name] = typ + tag
+				}
+			}
+		}
+	case *ast.InterfaceType:
+		api["type "+name] = "interface"
+		for _, m := range t.Methods.List {
+			if ft, ok := m.Type.(*ast.FuncType); ok {
+				for _, n := range m.Names {
+					api[name+"."+n.Name] = signature(fset, ft)
+				}
+			} else {
+				api[name+"."+expr(fset, m.Type)] = "embedded"
+			}
+		}
+	default:
+		api["type "+name] = alias + expr(fset, s.Type)
+	}
+}
+
+// signature prints a function type without parameter names.
+func signature(fset *token.FileSet, ft *ast.FuncType) string {
+	list := func(fl *ast.FieldList) []string {
+		var out []string
+		if fl == nil {
+			return out
+		}
+		for _, f := range fl.List {
+			n := len(f.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				out = append(out, expr(fset, f.Type))
+			}
+		}
+		return out
+	}
+	sig := "func(" + strings.Join(list(ft.Params), ", ") + ")"
+	switch res := list(ft.Results); len(res) {
+	case 0:
+	case 1:
+		sig += " " + res[0]
+	default:
+		sig += " (" + strings.Join(res, ", ") + ")"
+	}
+	return sig
+}
+
+func expr(fset *token.FileSet, e ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, e)
+	return buf.String()
+}
+
+// change is one difference in the API surface.
+type change struct {
+	Name string `json:"name"`
+	Old  string `json:"old,omitempty"`
+	New  string `json:"new,omitempty"`
+}
+
+type report struct {
+	Old     string   `json:"old"`
+	New     string   `json:"new"`
+	Bump    string   `json:"bump"` // major, minor or none
+	Removed []change `json:"removed"`
+	Changed []change `json:"changed"`
+	Added   []change `json:"added"`
+}
+
+// compare lists the differences. Removals and changes break callers and
+// need a major version; additions need a minor one. Members of a removed
+// type are reported with it, not one by one.
+func compare(oldAPI, newAPI map[string]string) *report {
+	rep := &report{Removed: []change{}, Changed: []change{}, Added: []change{}}
+	gone := map[string]bool{}
+	for key := range oldAPI {
+		if name, ok := strings.CutPrefix(key, "type "); ok {
+			if _, still := newAPI[key]; !still {
+				gone[name] = true
+			}
+		}
+	}
+	added := map[string]bool{}
+	for key := range newAPI {
+		if name, ok := strings.CutPrefix(key, "type "); ok {
+			if _, before := oldAPI[key]; !before {
+				added[name] = true
+			}
+		}
+	}
+	for key, o := range oldAPI {
+		n, ok := newAPI[key]
+		switch {
+		case !ok && !gone[owner(key)]:
+			rep.Removed = append(rep.Removed, change{Name: key, Old: o})
+		case ok && n != o:
+			rep.Changed = append(rep.Changed, change{Name: key, Old: o, New: n})
+		}
+	}
+	for key, n := range newAPI {
+		if _, ok := oldAPI[key]; !ok && !added[owner(key)] {
+			rep.Added = append(rep.Added, change{Name: key, New: n})
+		}
+	}
+	for _, list := range [][]change{rep.Removed, rep.Changed, rep.Added} {
+		sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	}
+	switch {
+	case len(rep.Removed)+len(rep.Changed) > 0:
+		rep.Bump = "major"
+	case len(rep.Added) > 0:
+		rep.Bump = "minor"
+	default:
+		rep.Bump = "none"
+	}
+	return rep
+}
+
+// owner is the type a member key belongs to: "Standings.Speed" →
+// "Standings", "(*Client).Foo" → "Client"; "" for top-level names.
+func owner(key string) string {
+	if strings.HasPrefix(key, "(") {
+		recv, _, _ := strings.Cut(strings.Trim(key, "(*"), ")")
+		return recv
+	}
+	if strings.Contains(key, " ") {
+		return ""
+	}
+	t, _, _ := strings.Cut(key, ".")
+	return t
+}
+
+func (r *report) write(w io.Writer) {
+	fmt.Fprintf(w, "# API changes: %s → %s\n\n", r.Old, r.New)
+	switch r.Bump {
+	case "major":
+		fmt.Fprintf(w, "**Breaking**: %d removed, %d changed, %d added. Needs a major version.\n", len(r.Removed), len(r.Changed), len(r.Added))
+	case "minor":
+		fmt.Fprintf(w, "Compatible: %d added. Needs a minor version.\n", len(r.Added))
+	default:
+		fmt.Fprintf(w, "No changes to the exported API.\n")
+		return
+	}
+	section := func(title string, list []change, line func(c change) string) {
+		if len(list) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "\n## %s\n\n", title)
+		for _, c := range list {
+			fmt.Fprintf(w, "- %s\n", line(c))
+		}
+	}
+	section("Removed", r.Removed, func(c change) string { return code(c.Name) + " " + code(c.Old) })
+	section("Changed", r.Changed, func(c change) string { return code(c.Name) + ": " + code(c.Old) + " → " + code(c.New) })
+	section("Added", r.Added, func(c change) string { return code(c.Name) + " " + code(c.New) })
+}
+
+// code formats a Markdown code span; struct tags contain backticks.
+func code(s string) string {
+	if strings.Contains(s, "`") {
+		return "`` " + s + " ``"
+	}
+	return "`" + s + "`"
+}
@@ -0,0 +1,405 @@
+// Conformance check of the generated client against recorded payloads.
+// Serves a fixture directory (as written by generate -record or proxy
+// -fixtures) to every parameterless GET method of lib.ClientInterface,
+// encodes what the method decoded back to JSON and compares it with the
+// raw response key by key. A struct field whose tag no longer matches the
+// payload, e.g. after a rename in generation, decodes silently to its zero
+// value; here it shows up as a payload key nothing decodes and a field
+// that is never set.
+//
+// conform/testdata is a curated set with a fixture for every known GET
+// endpoint; make check-conform runs against it with -complete, so an
+// endpoint added by a regeneration needs a fixture too.
+//
+// Usage (in cmd/): go run ./conform [-fixtures ../fixtures] [-complete] [-json] [-v]
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+func main() {
+	dir := flag.String("fixtures", "../fixtures", "Directory of recorded responses, one <path>.json per endpoint")
+	asJSON := flag.Bool("json", false, "Print the report as JSON")
+	verbose := flag.Bool("v", false, "Also list conforming endpoints and endpoints without a fixture")
+	complete := flag.Bool("complete", false, "Also fail when a GET endpoint has no fixture, for a set meant to cover them all")
+	flag.Parse()
+
+	rep, err := check(*dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		enc.Encode(rep)
+	} else {
+		rep.write(os.Stdout, *verbose)
+	}
+	if rep.Failed > 0 || *complete && rep.Missing > 0 {
+		os.Exit(2)
+	}
+}
+
+// Finding kinds.
+const (
+	// Unmapped is a payload key no field decodes.
+	Unmapped = "unmapped"
+	// NeverSet is a field whose key appears nowhere in the payload, listed
+	// where a key is unmapped beside it: the two together are the mark of
+	// a mis-tagged field. Elsewhere the sample just lacks the key.
+	NeverSet = "never set"
+	// TagCase is a key decoded by a field tagged in another case, which
+	// encoding/json accepts on decode but writes back differently.
+	TagCase = "tag case"
+	// Mismatch is a value that does not survive the round trip.
+	Mismatch = "mismatch"
+	// DecodeError is a payload the method fails to decode.
+	DecodeError = "decode"
+)
+
+// Finding is one difference, with array indices in Path written as [].
+type Finding struct {
+	Kind   string `json:"kind"`
+	Path   string `json:"path"`
+	Detail string `json:"detail,omitempty"`
+	Count  int    `json:"count"`
+}
+
+// Endpoint is the result for one method.
+type Endpoint struct {
+	Method   string    `json:"method"`
+	Path     string    `json:"path"`
+	Fixture  string    `json:"fixture,omitempty"` // empty when there is none
+	Findings []Finding `json:"findings,omitempty"`
+}
+
+type report struct {
+	Fixtures  string     `json:"fixtures"`
+	Endpoints []Endpoint `json:"endpoints"`
+	Checked   int        `json:"checked"`
+	Failed    int        `json:"failed"`
+	Missing   int        `json:"missing"` // GET endpoints without a fixture
+}
+
+// fixtureServer answers GET requests from the fixture directory and
+// remembers the last request, so a method can be matched with its path.
+type fixtureServer struct {
+	dir    string
+	method string
+	path   string
+	file   string
+	body   []byte
+}
+
+func (s *fixtureServer) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.method, s.path, s.file, s.body = req.Method, req.URL.Path, "", nil
+	status := http.StatusMethodNotAllowed
+	if req.Method == http.MethodGet {
+		status = http.StatusNotFound
+		name := fixtureName(req.URL.Path)
+		body, err := os.ReadFile(filepath.Join(s.dir, name))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if err == nil {
+			status, s.file, s.body = http.StatusOK, name, body
+		}
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(s.body)),
+		Request:    req,
+	}, nil
+}
+
+var nonAlpha = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// fixtureName is the name cmd/generate gives a path's fixture:
+// /rest/watch/standings → rest_watch_standings.json.
+func fixtureName(path string) string {
+	return strings.Trim(nonAlpha.ReplaceAllString(path, "_"), "_") + ".json"
+}
+
+// maxNeverSet is how many never set fields of one object are listed by
+// name.
+const maxNeverSet = 5
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+func check(dir string) (*report, error) {
+	if files, err := filepath.Glob(filepath.Join(dir, "*.json")); err != nil || len(files) == 0 {
+		return nil, fmt.Errorf("%s is not a fixture directory: no *.json files", dir)
+	}
+	srv := &fixtureServer{dir: dir}
+	client := lib.NewClient("http://fixtures")
+	client.HTTPClient = &http.Client{Transport: srv}
+	cv := reflect.ValueOf(client)
+
+	rep := &report{Fixtures: dir}
+	api := reflect.TypeOf((*lib.ClientInterface)(nil)).Elem()
+	for i := 0; i < api.NumMethod(); i++ {
+		m := api.Method(i)
+		if m.Type.NumIn() != 0 || m.Type.NumOut() != 2 || m.Type.Out(1) != errorType {
+			continue
+		}
+		out := cv.MethodByName(m.Name).Call(nil)
+		if srv.method != http.MethodGet {
+			continue
+		}
+		ep := Endpoint{Method: m.Name, Path: srv.path, Fixture: srv.file}
+		if srv.file == "" {
+			rep.Missing++
+			rep.Endpoints = append(rep.Endpoints, ep)
+			continue
+		}
+		rep.Checked++
+		if err, _ := out[1].Interface().(error); err != nil {
+			ep.Findings = []Finding{{Kind: DecodeError, Detail: err.Error(), Count: 1}}
+		} else {
+			ep.Findings, err = compare(srv.body, out[0].Interface())
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", m.Name, err)
+			}
+		}
+		if len(ep.Findings) > 0 {
+			rep.Failed++
+		}
+		rep.Endpoints = append(rep.Endpoints, ep)
+	}
+	sort.Slice(rep.Endpoints, func(i, j int) bool { return rep.Endpoints[i].Path < rep.Endpoints[j].Path })
+	return rep, nil
+}
+
+// compare round-trips the decoded value and walks it beside the raw
+// payload. A field counts as never set only when no element at its path
+// has the key, as one struct serves every element of a heterogeneous
+// array.
+func compare(raw []byte, decoded interface{}) ([]Finding, error) {
+	var want interface{}
+	if err := json.Unmarshal(raw, &want); err != nil {
+		return nil, fmt.Errorf("fixture: %w", err)
+	}
+	enc, err := json.Marshal(decoded)
+	if err != nil {
+		return nil, err
+	}
+	var got interface{}
+	if err := json.Unmarshal(enc, &got); err != nil {
+		return nil, err
+	}
+	w := &walker{found: map[string]*Finding{}, seen: map[string]bool{}, fields: map[string]bool{}}
+	w.walk("", want, got)
+	unmapped := map[string]bool{}
+	for _, f := range w.found {
+		if f.Kind == Unmapped {
+			unmapped[parent(f.Path)] = true
+		}
+	}
+	never := map[string][]string{}
+	for key := range w.fields {
+		if p := parent(key); !w.seen[key] && unmapped[p] {
+			never[p] = append(never[p], key)
+		}
+	}
+	for p, keys := range never {
+		sort.Strings(keys)
+		if len(keys) <= maxNeverSet {
+			for _, key := range keys {
+				w.add(NeverSet, key, "")
+			}
+			continue
+		}
+		// A sample with only some of the keys; naming them all would bury
+		// the unmapped ones.
+		names := make([]string, maxNeverSet)
+		for i, key := range keys[:maxNeverSet] {
+			names[i] = key[strings.LastIndex(key, ".")+1:]
+		}
+		w.add(NeverSet, join(p, "*"), fmt.Sprintf("%d fields, e.g. %s", len(keys), strings.Join(names, ", ")))
+	}
+	out := make([]Finding, 0, len(w.found))
+	for _, f := range w.found {
+		out = append(out, *f)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Path != out[j].Path {
+			return out[i].Path < out[j].Path
+		}
+		return out[i].Kind < out[j].Kind
+	})
+	return out, nil
+}
+
+type walker struct {
+	found  map[string]*Finding
+	seen   map[string]bool // paths of keys in the payload
+	fields map[string]bool // paths of keys in the round trip
+}
+
+func (w *walker) add(kind, path, detail string) {
+	k := kind + "\x00" + path
+	if f := w.found[k]; f != nil {
+		f.Count++
+		return
+	}
+	w.found[k] = &Finding{Kind: kind, Path: path, Detail: detail, Count: 1}
+}
+
+func (w *walker) walk(path string, want, got interface{}) {
+	switch want := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			w.add(Mismatch, path, fmt.Sprintf("object decoded as %s", brief(got)))
+			return
+		}
+		for k := range g {
+			w.fields[join(path, k)] = true
+		}
+		for k, v := range want {
+			p := join(path, k)
+			w.seen[p] = true
+			if gv, ok := g[k]; ok {
+				w.walk(p, v, gv)
+				continue
+			}
+			if tag := foldKey(g, k); tag != "" {
+				w.seen[join(path, tag)] = true
+				w.add(TagCase, p, "tagged "+strconv.Quote(tag))
+				continue
+			}
+			w.add(Unmapped, p, brief(v))
+		}
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok || len(g) != len(want) {
+			w.add(Mismatch, path, fmt.Sprintf("array of %d decoded as %s", len(want), brief(got)))
+			return
+		}
+		for i := range want {
+			w.walk(path+"[]", want[i], g[i])
+		}
+	case nil:
+		if !isZero(got) {
+			w.add(Mismatch, path, "null decoded as "+brief(got))
+		}
+	default:
+		if !reflect.DeepEqual(want, got) {
+			w.add(Mismatch, path, fmt.Sprintf("%s decoded as %s", brief(want), brief(got)))
+		}
+	}
+}
+
+// foldKey returns the key of m that equals k but for case, as
+// encoding/json matches them.
+func foldKey(m map[string]interface{}, k string) string {
+	for gk := range m {
+		if gk != k && strings.EqualFold(gk, k) {
+			return gk
+		}
+	}
+	return ""
+}
+
+func isZero(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		// a struct decoded from null
+		for _, x := range v {
+			if !isZero(x) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// parent returns the path of the object holding the key at path.
+func parent(path string) string {
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// brief describes a JSON value in a few characters.
+func brief(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return fmt.Sprintf("array of %d", len(v))
+	}
+	b, _ := json.Marshal(v)
+	if s := string(b); len(s) <= 40 {
+		return s
+	}
+	return string(b[:37]) + "..."
+}
+
+func (r *report) write(w io.Writer, verbose bool) {
+	for _, ep := range r.Endpoints {
+		switch {
+		case ep.Fixture == "":
+			if verbose {
+				fmt.Fprintf(w, "-    %-40s %s (no fixture)\n", ep.Path, ep.Method)
+			}
+		case len(ep.Findings) == 0:
+			if verbose {
+				fmt.Fprintf(w, "ok   %-40s %s\n", ep.Path, ep.Method)
+			}
+		default:
+			fmt.Fprintf(w, "FAIL %-40s %s\n", ep.Path, ep.Method)
+			for _, f := range ep.Findings {
+				line := fmt.Sprintf("     %-9s %s", f.Kind, f.Path)
+				if f.Detail != "" {
+					line += ": " + f.Detail
+				}
+				if f.Count > 1 {
+					line += fmt.Sprintf(" (%d×)", f.Count)
+				}
+				fmt.Fprintln(w, line)
+			}
+		}
+	}
+	fmt.Fprintf(w, "%d endpoints checked against %s, %d failed, %d without a fixture\n", r.Checked, r.Fixtures, r.Failed, r.Missing)
+}
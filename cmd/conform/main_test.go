@@ -0,0 +1,25 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestCurated checks the client against the curated payloads, as make
+// check-conform does.
+func TestCurated(t *testing.T) {
+	rep, err := check("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Failed > 0 || rep.Missing > 0 {
+		var b strings.Builder
+		rep.write(&b, false)
+		for _, ep := range rep.Endpoints {
+			if ep.Fixture == "" {
+				b.WriteString("no fixture for " + ep.Path + "\n")
+			}
+		}
+		t.Fatal(b.String())
+	}
+}
@@ -0,0 +1,229 @@
+// Control surface server for Stream Deck and similar button pads.
+// Serves the session state (position, fuel laps left, focused car, HUD) and
+// a set of actions (pit menu changes, camera switches, HUD toggles) over
+// HTTP and a WebSocket, in the JSON contract described in README.md, so a
+// Stream Deck plugin or any web request button can drive the game.
+//
+//	GET  /state            current state
+//	GET  /actions          action names
+//	POST /action/{action}  run an action, e.g. /action/pit/fuel+10
+//	GET  /ws               WebSocket: state pushed on change, actions sent in
+//
+// Usage (in cmd/): go run ./deck [-base http://localhost:6397] [-listen :7410] [-token t] [-read-only]
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/pit"
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+func main() {
+	baseURL := flag.String("base", "http://localhost:6397", "Base URL of the API")
+	listen := flag.String("listen", ":7410", "Address to serve the control surface on")
+	interval := flag.Duration("interval", 1*time.Second, "Poll interval for the state")
+	fuelEvery := flag.Duration("fuel-every", 5*time.Second, "Poll interval for fuel and energy")
+	cf := config.AddFlags(flag.CommandLine)
+	access := run.AddAccessFlags(flag.CommandLine)
+	flag.Parse()
+
+	_, prof, err := cf.Load(flag.CommandLine)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	client := lib.NewClient(*baseURL)
+	client.HTTPClient = prof.HTTPClient()
+
+	d := newDeck(client)
+	d.readOnly = access.ReadOnly
+	loop := run.New(*interval)
+	loop.Guard(access)
+	mux := loop.Mux(*listen)
+	mux.HandleFunc("/state", d.serveState)
+	mux.HandleFunc("/actions", d.serveActions)
+	mux.HandleFunc("/action/", d.serveAction)
+	mux.HandleFunc("/ws", d.serveWS)
+	loop.Defer(func() error { d.hub.close(); return nil })
+	fmt.Fprintf(os.Stderr, "Serving the control surface on %s\n", *listen)
+
+	brk := watch.NewBreaker()
+	var lastFuel time.Time
+	if err := loop.Poll(func(now time.Time) error {
+		if !brk.Allow(now) {
+			return nil
+		}
+		standings, err := client.RestWatchStandings()
+		brk.Record(now, err)
+		if err != nil {
+			d.update(func(s *State) { s.Connected = false })
+			return nil
+		}
+		focus, _ := client.RestWatchFocus()
+		hud, _ := client.RestHud()
+		fuel := now.Sub(lastFuel) >= *fuelEvery
+		var f fuelState
+		if fuel {
+			lastFuel = now
+			f = readFuel(client)
+		}
+		d.update(func(s *State) {
+			s.Connected = true
+			s.fill(standings, int(focus))
+			if hud != nil {
+				s.HUD = map[string]bool{"chat": hud.Chat, "mfd": hud.Mfd, "speedo": hud.Speedo, "timing": hud.Timing, "trackMap": hud.TrackMap}
+			}
+			if fuel {
+				s.Fuel, s.FuelLaps = f.fuel, f.laps
+			}
+		})
+		return nil
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Result is the answer to an action.
+type Result struct {
+	Type   string `json:"type"` // "result"
+	Action string `json:"action"`
+	OK     bool   `json:"ok"`
+	// Text is what changed, for the button's title, e.g. "FUEL: 52.0L".
+	Text  string `json:"text,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// actions are the action names; pit/ takes the pit actions of
+// cmd/internal/pit after the slash, hud/ a HUD component.
+var actions = []string{
+	"pit/fuel+N", "pit/fuel-N", "pit/energy+N", "pit/energy-N", "pit/tyres", "pit/damage", "pit/request",
+	"camera/next", "camera/prev", "camera/player", "camera/car/{number}", "camera/type/{n}",
+	"hud/chat", "hud/mfd", "hud/speedo", "hud/timing", "hud/trackMap",
+}
+
+// deck runs actions and keeps the state the clients see.
+type deck struct {
+	client   *lib.Client
+	pit      *pit.Control
+	hub      *hub
+	readOnly bool // actions fail, for spectators
+}
+
+func newDeck(client *lib.Client) *deck {
+	d := &deck{client: client, pit: pit.New(client)}
+	d.hub = newHub(d.run)
+	return d
+}
+
+// update changes the state and pushes it to the WebSocket clients when it
+// changed.
+func (d *deck) update(f func(*State)) {
+	d.hub.update(f)
+}
+
+// run runs one action.
+func (d *deck) run(action string) Result {
+	r := Result{Type: "result", Action: action}
+	if d.readOnly {
+		r.Error = run.ErrReadOnly.Error()
+		return r
+	}
+	text, err := d.do(action)
+	if err != nil {
+		r.Error = err.Error()
+	} else {
+		r.OK, r.Text = true, text
+	}
+	return r
+}
+
+func (d *deck) do(action string) (string, error) {
+	group, arg, _ := strings.Cut(strings.Trim(action, "/"), "/")
+	switch group {
+	case "pit":
+		it, err := d.pit.Do(arg)
+		if err != nil {
+			return "", err
+		}
+		return it.Name + " " + it.Text(), nil
+	case "camera":
+		return d.camera(arg)
+	case "hud":
+		if arg == "" {
+			return "", fmt.Errorf("hud: which component?")
+		}
+		_, err := d.client.PostRestHudToggleComponent(arg)
+		return "", err
+	}
+	return "", fmt.Errorf("unknown action %q", action)
+}
+
+// camera switches the camera or the car it follows.
+func (d *deck) camera(arg string) (string, error) {
+	var err error
+	switch kind, val, _ := strings.Cut(arg, "/"); kind {
+	case "next":
+		_, err = d.client.PutRestWatchFocusForward()
+	case "prev":
+		_, err = d.client.PutRestWatchFocusBackward()
+	case "player":
+		slot := d.hub.state().PlayerSlot
+		if slot < 0 {
+			return "", fmt.Errorf("no player car")
+		}
+		_, err = d.client.PutRestWatchFocusSlotid(slot)
+	case "car":
+		slot, ok := d.hub.state().slot(val)
+		if !ok {
+			return "", fmt.Errorf("no car #%s", val)
+		}
+		_, err = d.client.PutRestWatchFocusSlotid(slot)
+	case "type":
+		var n int
+		if _, err := fmt.Sscan(val, &n); err != nil {
+			return "", fmt.Errorf("camera/type: want a number, got %q", val)
+		}
+		_, err = d.client.PutRestWatchFocusCameraTypeTrackSideGroupShouldAdvance(n, 0, true)
+	default:
+		return "", fmt.Errorf("unknown camera action %q", arg)
+	}
+	return "", err
+}
+
+func (d *deck) serveState(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, d.hub.state())
+}
+
+func (d *deck) serveActions(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, actions)
+}
+
+func (d *deck) serveAction(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "use POST", http.StatusMethodNotAllowed)
+		return
+	}
+	res := d.run(strings.TrimPrefix(r.URL.Path, "/action/"))
+	if !res.OK {
+		w.WriteHeader(http.StatusBadGateway)
+	}
+	writeJSON(w, res)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/strategy"
+)
+
+// State is what the buttons show. It is sent as is by GET /state and over
+// the WebSocket whenever it changes.
+type State struct {
+	Type      string `json:"type"` // "state"
+	Connected bool   `json:"connected"`
+	// Position and ClassPosition are the player's, 0 without a player car.
+	Position      int    `json:"position"`
+	ClassPosition int    `json:"classPosition"`
+	Class         string `json:"class"`
+	Cars          int    `json:"cars"`
+	Lap           int    `json:"lap"`
+	// Fuel is the tank level as shown ("42.1 L" or "63 %"); FuelLaps the
+	// laps it lasts at the game's consumption estimate, 0 when unknown.
+	Fuel     string  `json:"fuel,omitempty"`
+	FuelLaps float64 `json:"fuelLaps"`
+	// PlayerSlot is the player car's slot, -1 without one.
+	PlayerSlot int `json:"playerSlot"`
+	// Focus is the car the camera follows.
+	Focus *Car            `json:"focus,omitempty"`
+	HUD   map[string]bool `json:"hud,omitempty"`
+
+	numbers map[string]int // car number to slot
+}
+
+// Car is a car in the state.
+type Car struct {
+	SlotID    int    `json:"slotId"`
+	CarNumber string `json:"carNumber"`
+	Driver    string `json:"driver"`
+	Position  int    `json:"position"`
+}
+
+// fill takes the positions and the focused car from a frame.
+func (s *State) fill(standings []lib.RestWatchStandingsResponseItem, focus int) {
+	s.Type, s.Cars = "state", len(standings)
+	s.Position, s.ClassPosition, s.Class, s.Lap, s.PlayerSlot, s.Focus = 0, 0, "", 0, -1, nil
+	s.numbers = make(map[string]int, len(standings))
+	var player *lib.RestWatchStandingsResponseItem
+	for i, c := range standings {
+		s.numbers[c.CarNumber] = int(c.SlotID)
+		if c.Player {
+			player = &standings[i]
+		}
+		if int(c.SlotID) == focus {
+			s.Focus = &Car{SlotID: int(c.SlotID), CarNumber: c.CarNumber, Driver: c.DriverName, Position: int(c.Position)}
+		}
+	}
+	if player == nil {
+		return
+	}
+	s.PlayerSlot = int(player.SlotID)
+	s.Position, s.Lap = int(player.Position), int(player.LapsCompleted)+1
+	s.Class = carclass.Lookup(player.CarClass).Abbrev
+	for _, c := range standings {
+		if c.CarClass == player.CarClass && c.Position <= player.Position {
+			s.ClassPosition++
+		}
+	}
+}
+
+// slot returns the slot of a car number.
+func (s State) slot(number string) (int, bool) {
+	slot, ok := s.numbers[number]
+	return slot, ok
+}
+
+type fuelState struct {
+	fuel string
+	laps float64
+}
+
+// readFuel reads the tank level and how many laps it lasts. Errors leave
+// the fuel unknown.
+func readFuel(c *lib.Client) fuelState {
+	var f fuelState
+	e, r, err := c.Energy()
+	if err != nil {
+		return f
+	}
+	if e.UsesVirtualEnergy() {
+		f.fuel = fmt.Sprintf("%.0f %%", e.EnergyPercent())
+	} else {
+		f.fuel = fmt.Sprintf("%.1f L", e.Fuel)
+	}
+	if u, err := c.ExpectedEnergyUsage(); err == nil {
+		if laps := strategy.NewTank(*e, *u, *r).LapsLeft(); !math.IsInf(laps, 0) {
+			f.laps = math.Round(laps*10) / 10
+		}
+	}
+	return f
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// A small WebSocket server (RFC 6455), enough for Stream Deck plugins:
+// text messages both ways, ping and close. The tools have no third-party
+// dependencies besides the database driver.
+
+const wsGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// maxMessage is the largest message read from a client.
+const maxMessage = 64 << 10
+
+const (
+	opText  = 1
+	opClose = 8
+	opPing  = 9
+	opPong  = 10
+)
+
+// hub holds the state and the WebSocket clients it is pushed to.
+type hub struct {
+	run func(action string) Result
+
+	mu      sync.Mutex
+	st      State
+	last    []byte // the state as last sent
+	clients map[*wsClient]bool
+}
+
+func newHub(run func(string) Result) *hub {
+	return &hub{run: run, st: State{Type: "state", PlayerSlot: -1}, clients: map[*wsClient]bool{}}
+}
+
+func (h *hub) state() State {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.st
+}
+
+// update changes the state and sends it to every client when it changed.
+func (h *hub) update(f func(*State)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	f(&h.st)
+	msg, _ := json.Marshal(h.st)
+	if bytes.Equal(msg, h.last) {
+		return
+	}
+	h.last = msg
+	for c := range h.clients {
+		c.queue(msg)
+	}
+}
+
+func (h *hub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for c := range h.clients {
+		c.conn.Close()
+	}
+}
+
+type wsClient struct {
+	conn net.Conn
+	send chan []byte
+	once sync.Once
+	wmu  sync.Mutex // one frame at a time
+}
+
+func (c *wsClient) write(op byte, payload []byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	return writeFrame(c.conn, op, payload)
+}
+
+// queue sends a message, dropping the client when it does not keep up.
+func (c *wsClient) queue(msg []byte) {
+	select {
+	case c.send <- msg:
+	default:
+		c.conn.Close()
+	}
+}
+
+// serve upgrades the request and runs the client until it goes away: the
+// state on connect and on every change, and a result for every
+// {"action": "..."} it sends.
+func (h *hub) serve(w http.ResponseWriter, r *http.Request) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") || key == "" {
+		http.Error(w, "WebSocket only", http.StatusBadRequest)
+		return
+	}
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "cannot upgrade", http.StatusInternalServerError)
+		return
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return
+	}
+	sum := sha1.Sum([]byte(key + wsGUID))
+	rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: " +
+		base64.StdEncoding.EncodeToString(sum[:]) + "\r\n\r\n")
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return
+	}
+
+	c := &wsClient{conn: conn, send: make(chan []byte, 16)}
+	h.mu.Lock()
+	h.clients[c] = true
+	msg, _ := json.Marshal(h.st)
+	c.queue(msg)
+	h.mu.Unlock()
+	defer func() {
+		h.mu.Lock()
+		delete(h.clients, c)
+		h.mu.Unlock()
+		conn.Close()
+		c.once.Do(func() { close(c.send) })
+	}()
+
+	go func() {
+		for msg := range c.send {
+			if c.write(opText, msg) != nil {
+				conn.Close()
+			}
+		}
+	}()
+	for {
+		op, payload, err := readFrame(rw.Reader)
+		if err != nil {
+			return
+		}
+		switch op {
+		case opText:
+			var req struct {
+				Action string `json:"action"`
+			}
+			if err := json.Unmarshal(payload, &req); err != nil || req.Action == "" {
+				msg, _ := json.Marshal(Result{Type: "result", Error: `want {"action": "..."}`})
+				c.queue(msg)
+				continue
+			}
+			// Actions call the game; run them outside the read loop so
+			// pings are still answered
+			go func() {
+				msg, _ := json.Marshal(h.run(req.Action))
+				h.mu.Lock()
+				if h.clients[c] {
+					c.queue(msg)
+				}
+				h.mu.Unlock()
+			}()
+		case opPing:
+			c.write(opPong, payload)
+		case opClose:
+			c.write(opClose, nil)
+			return
+		}
+	}
+}
+
+func (d *deck) serveWS(w http.ResponseWriter, r *http.Request) {
+	d.hub.serve(w, r)
+}
+
+// writeFrame writes an unfragmented, unmasked frame, as servers send them.
+func writeFrame(w io.Writer, op byte, payload []byte) error {
+	hdr := []byte{0x80 | op}
+	switch n := len(payload); {
+	case n < 126:
+		hdr = append(hdr, byte(n))
+	case n < 1<<16:
+		hdr = append(hdr, 126)
+		hdr = binary.BigEndian.AppendUint16(hdr, uint16(n))
+	default:
+		hdr = append(hdr, 127)
+		hdr = binary.BigEndian.AppendUint64(hdr, uint64(n))
+	}
+	_, err := w.Write(append(hdr, payload...))
+	return err
+}
+
+// readFrame reads a client frame and unmasks it. Fragmented messages are
+// not supported; the clients this serves send short ones.
+func readFrame(r *bufio.Reader) (op byte, payload []byte, err error) {
+	var hdr [2]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return 0, nil, err
+	}
+	if hdr[0]&0x80 == 0 {
+		return 0, nil, errors.New("fragmented message")
+	}
+	op = hdr[0] & 0x0f
+	n := uint64(hdr[1] & 0x7f)
+	switch n {
+	case 126:
+		var b [2]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return 0, nil, err
+		}
+		n = uint64(binary.BigEndian.Uint16(b[:]))
+	case 127:
+		var b [8]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return 0, nil, err
+		}
+		n = binary.BigEndian.Uint64(b[:])
+	}
+	if n > maxMessage {
+		return 0, nil, errors.New("message too large")
+	}
+	var mask [4]byte
+	if hdr[1]&0x80 != 0 {
+		if _, err := io.ReadFull(r, mask[:]); err != nil {
+			return 0, nil, err
+		}
+	}
+	payload = make([]byte, n)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return op, payload, nil
+}
@@ -0,0 +1,299 @@
+// Live delta for LMU.
+// Loads the player's personal best at the current track and car from the
+// history database (see lmu record) and shows a running delta against it,
+// or against the all-time record in the car or the class with -ref. The
+// last lap is compared with all three records. Records lmu record saw all
+// the way round carry mini-sector times, which give a finer delta.
+// Outside races, a target lap from the config gets its own live splits and
+// every run from the pits ends with a summary against it.
+//
+// Usage (in cmd/): go run ./delta [-base http://localhost:6397] [-db history.db] [-ref pb|car|class|target]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/cmd/internal/sqlite"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/delta"
+	"github.com/snipem/go-lmu-api/lib/identity"
+	"github.com/snipem/go-lmu-api/lib/store"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+func main() {
+	baseURL := flag.String("base", "http://localhost:6397", "Base URL of the API")
+	interval := flag.Duration("interval", 250*time.Millisecond, "Poll interval")
+	dbPath := flag.String("db", store.DefaultPath(), "History database")
+	refKind := flag.String("ref", "pb", "Reference lap: pb (your record), car (the car's record), class (the class record) or target (from the config)")
+	cf := config.AddFlags(flag.CommandLine)
+	flag.Parse()
+
+	if *refKind != "pb" && *refKind != "car" && *refKind != "class" && *refKind != "target" {
+		fmt.Fprintf(os.Stderr, "Error: -ref must be pb, car, class or target\n")
+		os.Exit(2)
+	}
+	cfg, prof, err := cf.Load(flag.CommandLine)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	db, err := sqlite.Open(*dbPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	client := lib.NewClient(*baseURL)
+	client.HTTPClient = prof.HTTPClient()
+
+	loop := run.New(*interval)
+	loop.Defer(db.Close)
+	loop.Terminal()
+
+	brk := watch.NewBreaker()
+	brk.OnChange = func(h watch.Health) {
+		if !h.Connected {
+			fmt.Fprintf(os.Stderr, "\rDisconnected: %v (retrying every %s)", h.Err, brk.Probe)
+		}
+	}
+
+	var tracker *delta.Tracker
+	var key string
+	var recs store.Records
+	lapsDone := -1
+	var (
+		target  *delta.Tracker // against the configured target, nil without one
+		run     *delta.Run
+		lastRun *delta.RunSummary
+		pitted  bool // the lap in progress started from or ends in the pits
+		runLaps float64
+	)
+	err = loop.Poll(func(now time.Time) error {
+		if !brk.Allow(now) {
+			return nil
+		}
+		standings, err := client.RestWatchStandings()
+		brk.Record(time.Now(), err)
+		if err != nil {
+			if !watch.IsConnError(err) {
+				fmt.Fprintf(os.Stderr, "\rError: %v", err)
+			}
+			return nil
+		}
+		si, err := client.RestWatchSessionInfo()
+		if err != nil {
+			return nil
+		}
+		var player *lib.RestWatchStandingsResponseItem
+		for i := range standings {
+			if standings[i].Player {
+				player = &standings[i]
+			}
+		}
+		if player == nil {
+			render(nil, delta.Reading{}, si.TrackName, recs, 0)
+			return nil
+		}
+		practice := !strings.HasPrefix(strings.ToUpper(si.Session), "RACE")
+
+		// (re)load the reference when track, car or driver change
+		car := player.CarId
+		if car == "" {
+			car = player.VehicleName
+		}
+		k := si.TrackName + "|" + car + "|" + player.DriverName
+		// The records are read again after every lap, as lmu record may
+		// have just set one
+		if k != key || int(player.LapsCompleted) != lapsDone {
+			lapsDone = int(player.LapsCompleted)
+			if recs, err = db.RecordsFor(si.TrackName, car, player.CarClass, string(identity.Of(*player))); err != nil {
+				fmt.Fprintf(os.Stderr, "\rError: %v", err)
+			}
+		}
+		if k != key {
+			key = k
+			target, run, lastRun = nil, nil, nil
+			ref := reference(recs, *refKind)
+			if t, ok := delta.TargetFor(cfg.Targets, si.TrackName, car, player.CarClass); ok {
+				tref := delta.TargetReference(t, reference(recs, "pb"))
+				target = delta.NewTracker(tref, si.LapDistance)
+				run = &delta.Run{Target: tref}
+				if *refKind == "target" {
+					ref = tref
+				}
+			}
+			tracker = delta.NewTracker(ref, si.LapDistance)
+			runLaps = player.LapsCompleted
+		}
+		r := tracker.Update(*player)
+		if target == nil || !practice {
+			render(tracker, r, si.TrackName, recs, player.LastLapTime)
+			return nil
+		}
+
+		// A run is the flying laps between leaving the pits and coming back
+		inPit := player.Pitting || player.PitState != "NONE" || player.InGarageStall
+		if player.LapsCompleted != runLaps {
+			runLaps = player.LapsCompleted
+			if !pitted && !inPit {
+				run.Add(player.LastLapTime, lastSectors(*player))
+			}
+			pitted = false
+		}
+		if inPit {
+			pitted = true
+			if run.Laps() > 0 {
+				s := run.Summary()
+				lastRun = &s
+				run = &delta.Run{Target: run.Target}
+			}
+		}
+		render(tracker, r, si.TrackName, recs, player.LastLapTime)
+		renderTarget(target, target.Update(*player), run, lastRun)
+		return nil
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// reference picks the reference lap from the records.
+func reference(recs store.Records, kind string) delta.Reference {
+	b, label := recs.Personal, "PB"
+	switch kind {
+	case "target":
+		// set from the config, see delta.TargetReference
+		return delta.Reference{}
+	case "car":
+		b, label = recs.Car, "car record, "+recs.Car.Driver
+	case "class":
+		b, label = recs.Class, "class record, "+recs.Class.Driver+" in the "+recs.Class.Car
+	}
+	if b.LapTime <= 0 {
+		return delta.Reference{}
+	}
+	return delta.Reference{
+		LapTime: b.LapTime,
+		Sectors: [3]float64{b.S1, b.S2, b.S3},
+		Label:   label + " " + b.Date.Local().Format("2006-01-02"),
+		Profile: b.MiniSectors,
+	}
+}
+
+// lastSectors returns the split times of the car's last lap.
+func lastSectors(s lib.RestWatchStandingsResponseItem) [3]float64 {
+	if s.LastSectorTime1 <= 0 || s.LastSectorTime2 <= 0 || s.LastLapTime <= 0 {
+		return [3]float64{}
+	}
+	return [3]float64{s.LastSectorTime1, s.LastSectorTime2 - s.LastSectorTime1, s.LastLapTime - s.LastSectorTime2}
+}
+
+// renderTarget appends the splits against the target to the screen: the
+// running delta and sectors of this lap, the run so far and the summary of
+// the last run.
+func renderTarget(t *delta.Tracker, r delta.Reading, run *delta.Run, last *delta.RunSummary) {
+	var b strings.Builder
+	b.WriteString("\033[K\n")
+	fmt.Fprintf(&b, "  target %s", fmtLap(t.Ref.LapTime))
+	if r.Valid {
+		fmt.Fprintf(&b, "  %s", colorDelta(r.Delta, fmt.Sprintf("%+7.3f", r.Delta)))
+		for i := 0; i < r.SectorsDone; i++ {
+			fmt.Fprintf(&b, "  S%d %s", i+1, colorDelta(r.SectorDeltas[i], fmt.Sprintf("%+6.3f", r.SectorDeltas[i])))
+		}
+	}
+	b.WriteString("\033[K\n")
+	if run.Laps() > 0 {
+		fmt.Fprintf(&b, "  this run   %s\033[K\n", runLine(run.Summary()))
+	}
+	if last != nil {
+		fmt.Fprintf(&b, "  last run   %s\033[K\n", runLine(*last))
+		b.WriteString("            best sectors")
+		for i, sec := range last.BestSectors {
+			if sec > 0 {
+				fmt.Fprintf(&b, "  S%d %.3f %s (%d/%d)", i+1, sec, colorDelta(last.SectorDeltas[i], fmt.Sprintf("%+.3f", last.SectorDeltas[i])), last.SectorsBeaten[i], last.Laps)
+			}
+		}
+		b.WriteString("\033[K\n")
+	}
+	b.WriteString("\033[J")
+	os.Stdout.WriteString(b.String())
+}
+
+// runLine sums up a run, e.g. "6 laps, 2 under target, best 2:16.420
+// -0.080, average 2:16.900 +0.400".
+func runLine(s delta.RunSummary) string {
+	laps := "laps"
+	if s.Laps == 1 {
+		laps = "lap"
+	}
+	return fmt.Sprintf("%d %s, %d under target, best %s %s, average %s %s", s.Laps, laps, s.Beaten,
+		fmtLap(s.Best), colorDelta(s.BestDelta, fmt.Sprintf("%+.3f", s.BestDelta)),
+		fmtLap(s.Mean), colorDelta(s.MeanDelta, fmt.Sprintf("%+.3f", s.MeanDelta)))
+}
+
+func render(t *delta.Tracker, r delta.Reading, track string, recs store.Records, lastLap float64) {
+	var b strings.Builder
+	b.WriteString("\033[H")
+	fmt.Fprintf(&b, "  LMU Delta  |  %s\033[K\n\n", track)
+	switch {
+	case t == nil:
+		b.WriteString("  waiting for the player car\033[K\n")
+	case !t.Ref.Valid():
+		b.WriteString("  no reference lap for this track and car yet (record some laps with lmu record)\033[K\n")
+	case !r.Valid:
+		fmt.Fprintf(&b, "  ref %s (%s)\033[K\n\n  waiting for a timed lap\033[K\n", fmtLap(t.Ref.LapTime), t.Ref.Label)
+	default:
+		fmt.Fprintf(&b, "  ref %s (%s)\033[K\n\n", fmtLap(t.Ref.LapTime), t.Ref.Label)
+		fmt.Fprintf(&b, "  %s\033[K\n\n", colorDelta(r.Delta, fmt.Sprintf("%+7.3f", r.Delta)))
+		for i := 0; i < r.SectorsDone; i++ {
+			fmt.Fprintf(&b, "  S%d %s", i+1, colorDelta(r.SectorDeltas[i], fmt.Sprintf("%+6.3f", r.SectorDeltas[i])))
+		}
+		b.WriteString("\033[K\n")
+		if n := t.Ref.Profile.MiniSectors(); n > 0 && r.MiniDone > 0 {
+			fmt.Fprintf(&b, "  mini-sector %d/%d %s\033[K\n", r.MiniDone, n, colorDelta(r.MiniDelta, fmt.Sprintf("%+6.3f", r.MiniDelta)))
+		}
+		if r.LastLapDelta != 0 {
+			fmt.Fprintf(&b, "  last lap %s\033[K\n", colorDelta(r.LastLapDelta, fmt.Sprintf("%+7.3f", r.LastLapDelta)))
+		}
+	}
+	if t != nil {
+		b.WriteString("\033[K\n")
+		for _, rec := range []struct {
+			name string
+			lap  store.BestLap
+		}{{"your record", recs.Personal}, {"car record", recs.Car}, {"class record", recs.Class}} {
+			if rec.lap.LapTime <= 0 {
+				continue
+			}
+			fmt.Fprintf(&b, "  %-13s %s  %-20s", rec.name, fmtLap(rec.lap.LapTime), rec.lap.Driver)
+			if lastLap > 0 {
+				d := lastLap - rec.lap.LapTime
+				fmt.Fprintf(&b, "  last lap %s vs %s", colorDelta(d, fmt.Sprintf("%+.3f", d)), rec.name)
+			}
+			b.WriteString("\033[K\n")
+		}
+	}
+	b.WriteString("\033[J")
+	os.Stdout.WriteString(b.String())
+}
+
+func colorDelta(d float64, s string) string {
+	if d < 0 {
+		return "\033[1;32m" + s + "\033[0m"
+	}
+	return "\033[1;31m" + s + "\033[0m"
+}
+
+func fmtLap(t float64) string {
+	mins := int(t) / 60
+	return fmt.Sprintf("%d:%06.3f", mins, t-float64(mins*60))
+}
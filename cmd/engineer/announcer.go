@@ -0,0 +1,255 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib/events"
+	"github.com/snipem/go-lmu-api/lib/locale"
+)
+
+// Priority orders announcements. Higher values interrupt the queue.
+type Priority int
+
+const (
+	Low Priority = iota
+	Normal
+	High
+	Critical
+)
+
+var kindPriority = map[events.Kind]Priority{
+	events.FuelCritical:       Critical,
+	events.RainSoon:           High,
+	events.PitWindowOpen:      High,
+	events.FuelLow:            High,
+	events.RivalPitted:        Normal,
+	events.PositionGained:     Normal,
+	events.PositionLost:       Normal,
+	events.PersonalBest:       Low,
+	events.TimeToGo:           Normal,
+	events.LapsToGo:           Normal,
+	events.PitWindowClose:     High,
+	events.TrackLimitsWarning: High,
+	events.BrakesHot:          High,
+	events.TyresHot:           Normal,
+	events.TyresCold:          Normal,
+	events.PitSpeeding:        Critical,
+	events.FormationLap:       Normal,
+	events.GreenFlag:          Critical,
+	events.TyreCrossover:      High,
+}
+
+// ttl is how long an announcement may wait in the queue before it is no
+// longer worth saying.
+var ttl = map[Priority]time.Duration{
+	Low:      10 * time.Second,
+	Normal:   20 * time.Second,
+	High:     60 * time.Second,
+	Critical: 2 * time.Minute,
+}
+
+var defaultTemplates = map[events.Kind]string{
+	events.PitWindowOpen:      `Pit window is open. {{.Detail}}.`,
+	events.FuelLow:            `Box soon, {{laps .Value}} of {{.Detail}} left.`,
+	events.FuelCritical:       `Box this lap! {{.Detail}} critical, {{laps .Value}} left.`,
+	events.RivalPitted:        `Car {{.CarNumber}} {{.Detail}} has pitted.`,
+	events.RainSoon:           `Rain expected in {{printf "%.0f" .Value}} minutes, {{.Detail}} chance.`,
+	events.PositionGained:     `Good job, P{{printf "%.0f" .Value}} in class.`,
+	events.PositionLost:       `Lost a place, now P{{printf "%.0f" .Value}} in class.`,
+	events.PersonalBest:       `Personal best, {{laptime .Value}}.`,
+	events.Alert:              `{{.Detail}}`,
+	events.TimeToGo:           `{{if eq .Value 1.0}}One minute{{else}}{{printf "%.0f" .Value}} minutes{{end}} to go.`,
+	events.LapsToGo:           `{{if eq .Value 1.0}}Final lap.{{else}}{{printf "%.0f" .Value}} laps to go.{{end}}`,
+	events.PitWindowClose:     `Pit window is closed.`,
+	events.TrackLimitsWarning: `Track limits warning, {{.Detail}}.`,
+	events.BrakesHot:          `{{wheel .Detail}} brake overheating, {{printf "%.0f" .Value}} degrees.`,
+	events.TyresHot:           `{{wheel .Detail}} tyre is overheating, {{printf "%.0f" .Value}} degrees.`,
+	events.TyresCold:          `{{wheel .Detail}} tyre is cold, {{printf "%.0f" .Value}} degrees.`,
+	events.PitSpeeding:        `Pit limiter! You are speeding, limit {{.Detail}}.`,
+	events.FormationLap:       `Formation lap. Warm the tyres and keep your position.`,
+	events.GreenFlag:          `{{if eq .Detail "restart"}}Green, green, green. Racing resumes.{{else}}Go, go, go!{{end}}`,
+	events.TyreCrossover:      `{{if eq .Detail "wets"}}Wets{{else}}Slicks{{end}} {{if eq .Value 0.0}}are faster now{{else}}faster in about {{laps .Value}}{{end}}.`,
+}
+
+// translations are defaultTemplates in other languages.
+var translations = map[string]map[events.Kind]string{
+	"de": {
+		events.PitWindowOpen:      `Boxenfenster ist offen.`,
+		events.FuelLow:            `Bald an die Box, noch {{laps .Value}} {{if eq .Detail "energy"}}Energie{{else}}Sprit{{end}}.`,
+		events.FuelCritical:       `Box in dieser Runde! {{if eq .Detail "energy"}}Energie{{else}}Sprit{{end}} kritisch, noch {{laps .Value}}.`,
+		events.RivalPitted:        `Auto {{.CarNumber}} {{if eq .Detail "ahead"}}vor dir{{else}}hinter dir{{end}} ist an der Box.`,
+		events.RainSoon:           `Regen in {{printf "%.0f" .Value}} Minuten erwartet, {{.Detail}} Wahrscheinlichkeit.`,
+		events.PositionGained:     `Gut gemacht, P{{printf "%.0f" .Value}} in der Klasse.`,
+		events.PositionLost:       `Platz verloren, jetzt P{{printf "%.0f" .Value}} in der Klasse.`,
+		events.PersonalBest:       `Persönliche Bestzeit, {{laptime .Value}}.`,
+		events.Alert:              `{{.Detail}}`,
+		events.TimeToGo:           `Noch {{if eq .Value 1.0}}eine Minute{{else}}{{printf "%.0f" .Value}} Minuten{{end}}.`,
+		events.LapsToGo:           `{{if eq .Value 1.0}}Letzte Runde.{{else}}Noch {{printf "%.0f" .Value}} Runden.{{end}}`,
+		events.PitWindowClose:     `Boxenfenster ist geschlossen.`,
+		events.TrackLimitsWarning: `Track-Limits-Verwarnung, {{.Detail}}.`,
+		events.BrakesHot:          `Bremse {{wheel .Detail}} überhitzt, {{printf "%.0f" .Value}} Grad.`,
+		events.TyresHot:           `Reifen {{wheel .Detail}} überhitzt, {{printf "%.0f" .Value}} Grad.`,
+		events.TyresCold:          `Reifen {{wheel .Detail}} ist kalt, {{printf "%.0f" .Value}} Grad.`,
+		events.PitSpeeding:        `Pitlimiter! Zu schnell in der Boxengasse, Limit {{.Detail}}.`,
+		events.FormationLap:       `Formationsrunde. Reifen aufwärmen und Position halten.`,
+		events.GreenFlag:          `{{if eq .Detail "restart"}}Grün, grün, grün. Das Rennen geht weiter.{{else}}Los, los, los!{{end}}`,
+		events.TyreCrossover:      `{{if eq .Detail "wets"}}Regenreifen{{else}}Slicks{{end}} {{if eq .Value 0.0}}sind jetzt schneller{{else}}in etwa {{laps .Value}} schneller{{end}}.`,
+	},
+	"fr": {
+		events.PitWindowOpen:      `La fenêtre de ravitaillement est ouverte.`,
+		events.FuelLow:            `Rentre bientôt, encore {{laps .Value}} {{if eq .Detail "energy"}}d'énergie{{else}}de carburant{{end}}.`,
+		events.FuelCritical:       `Rentre ce tour ! {{if eq .Detail "energy"}}Énergie{{else}}Carburant{{end}} critique, {{laps .Value}} restant.`,
+		events.RivalPitted:        `La voiture {{.CarNumber}} {{if eq .Detail "ahead"}}devant{{else}}derrière{{end}} est rentrée aux stands.`,
+		events.RainSoon:           `Pluie attendue dans {{printf "%.0f" .Value}} minutes, {{.Detail}} de probabilité.`,
+		events.PositionGained:     `Bien joué, P{{printf "%.0f" .Value}} dans la catégorie.`,
+		events.PositionLost:       `Une place perdue, maintenant P{{printf "%.0f" .Value}} dans la catégorie.`,
+		events.PersonalBest:       `Meilleur tour personnel, {{laptime .Value}}.`,
+		events.Alert:              `{{.Detail}}`,
+		events.TimeToGo:           `Plus que {{if eq .Value 1.0}}une minute{{else}}{{printf "%.0f" .Value}} minutes{{end}}.`,
+		events.LapsToGo:           `{{if eq .Value 1.0}}Dernier tour.{{else}}Plus que {{printf "%.0f" .Value}} tours.{{end}}`,
+		events.PitWindowClose:     `La fenêtre de ravitaillement est fermée.`,
+		events.TrackLimitsWarning: `Avertissement limites de piste, {{.Detail}}.`,
+		events.BrakesHot:          `Frein {{wheel .Detail}} en surchauffe, {{printf "%.0f" .Value}} degrés.`,
+		events.TyresHot:           `Pneu {{wheel .Detail}} en surchauffe, {{printf "%.0f" .Value}} degrés.`,
+		events.TyresCold:          `Pneu {{wheel .Detail}} froid, {{printf "%.0f" .Value}} degrés.`,
+		events.PitSpeeding:        `Limiteur ! Excès de vitesse dans les stands, limite {{.Detail}}.`,
+		events.FormationLap:       `Tour de formation. Chauffe les pneus et garde ta position.`,
+		events.GreenFlag:          `{{if eq .Detail "restart"}}Vert, vert, vert. La course reprend.{{else}}Go, go, go !{{end}}`,
+		events.TyreCrossover:      `{{if eq .Detail "wets"}}Les pneus pluie sont{{else}}Les slicks sont{{end}} {{if eq .Value 0.0}}plus rapides maintenant{{else}}plus rapides dans environ {{laps .Value}}{{end}}.`,
+	},
+}
+
+// Announcement is a rendered message waiting to be spoken.
+type Announcement struct {
+	Priority Priority
+	Text     string
+	Kind     events.Kind
+	Queued   time.Time
+}
+
+// Announcer turns events into prioritized, de-duplicated announcements.
+type Announcer struct {
+	MinGap   time.Duration
+	Cooldown time.Duration
+
+	templates map[events.Kind]*template.Template
+	queue     []Announcement
+	lastSaid  time.Time
+	recent    map[string]time.Time
+}
+
+// NewAnnouncer compiles the built-in templates in the locale's language plus
+// any overrides keyed by event kind.
+func NewAnnouncer(loc locale.Locale, overrides map[string]string) (*Announcer, error) {
+	a := &Announcer{
+		MinGap:    4 * time.Second,
+		Cooldown:  30 * time.Second,
+		templates: map[events.Kind]*template.Template{},
+		recent:    map[string]time.Time{},
+	}
+	src := map[events.Kind]string{}
+	for k, v := range defaultTemplates {
+		src[k] = v
+	}
+	for k, v := range translations[loc.Lang] {
+		src[k] = v
+	}
+	for k, v := range overrides {
+		src[events.Kind(k)] = v
+	}
+	funcs := template.FuncMap{"laps": loc.Laps, "laptime": loc.LapTime, "wheel": func(w string) string { return wheelName(loc, w) }}
+	for k, v := range src {
+		t, err := template.New(string(k)).Funcs(funcs).Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %w", k, err)
+		}
+		a.templates[k] = t
+	}
+	return a, nil
+}
+
+var wheelNames = map[string]string{"FL": "Front left", "FR": "Front right", "RL": "Rear left", "RR": "Rear right"}
+
+// wheelName spells out a wheel position for speech.
+func wheelName(loc locale.Locale, w string) string {
+	if n, ok := wheelNames[w]; ok {
+		return loc.T(n)
+	}
+	return w
+}
+
+// Add queues the announcement for an event unless the same kind for the same
+// car was announced within the cooldown.
+func (a *Announcer) Add(e events.Event, now time.Time) error {
+	key := fmt.Sprintf("%s/%s/%d", e.Kind, e.Rule, e.SlotID)
+	if t, ok := a.recent[key]; ok && now.Sub(t) < a.Cooldown {
+		return nil
+	}
+	tmpl, ok := a.templates[e.Kind]
+	if !ok {
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, e); err != nil {
+		return fmt.Errorf("template %s: %w", e.Kind, err)
+	}
+	a.recent[key] = now
+	prio := kindPriority[e.Kind]
+	if e.Kind == events.Alert {
+		prio = ParsePriority(e.Priority)
+	}
+	a.queue = append(a.queue, Announcement{
+		Priority: prio,
+		Text:     strings.TrimSpace(buf.String()),
+		Kind:     e.Kind,
+		Queued:   now,
+	})
+	return nil
+}
+
+// AddText queues a ready-made message, e.g. from a plugin.
+func (a *Announcer) AddText(text string, p Priority, now time.Time) {
+	a.queue = append(a.queue, Announcement{Priority: p, Text: text, Queued: now})
+}
+
+// ParsePriority maps a priority name to a Priority, defaulting to Normal.
+func ParsePriority(s string) Priority {
+	switch strings.ToLower(s) {
+	case "low":
+		return Low
+	case "high":
+		return High
+	case "critical":
+		return Critical
+	}
+	return Normal
+}
+
+// Next returns the most important announcement that is still fresh, if the
+// minimum gap since the last one has passed. Critical announcements ignore
+// the gap.
+func (a *Announcer) Next(now time.Time) (Announcement, bool) {
+	fresh := a.queue[:0]
+	for _, m := range a.queue {
+		if now.Sub(m.Queued) <= ttl[m.Priority] {
+			fresh = append(fresh, m)
+		}
+	}
+	a.queue = fresh
+	if len(a.queue) == 0 {
+		return Announcement{}, false
+	}
+	sort.SliceStable(a.queue, func(i, j int) bool { return a.queue[i].Priority > a.queue[j].Priority })
+	if a.queue[0].Priority < Critical && now.Sub(a.lastSaid) < a.MinGap {
+		return Announcement{}, false
+	}
+	m := a.queue[0]
+	a.queue = a.queue[1:]
+	a.lastSaid = now
+	return m, true
+}
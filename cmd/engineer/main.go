@@ -0,0 +1,212 @@
+// Race engineer for LMU.
+// Watches the session, detects race events (pit window, rivals pitting, rain,
+// fuel) and turns them into prioritized announcements on the terminal, in an
+// overlay text file, and optionally through a text-to-speech command.
+//
+// Usage (in cmd/): go run ./engineer [-base http://localhost:6397] [-say "espeak -v en"] [-overlay engineer.txt]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/events"
+	"github.com/snipem/go-lmu-api/lib/locale"
+	"github.com/snipem/go-lmu-api/lib/plugin"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+func main() {
+	baseURL := flag.String("base", "http://localhost:6397", "Base URL of the API")
+	interval := flag.Duration("interval", 1*time.Second, "Poll interval")
+	adaptive := flag.Bool("adaptive", false, run.AdaptiveUsage)
+	cf := config.AddFlags(flag.CommandLine)
+	say := flag.String("say", "", "Text-to-speech command; the message is appended as the last argument")
+	overlay := flag.String("overlay", "", "Write the latest message to this file")
+	eventsLog := events.AddLogFlag(flag.CommandLine)
+	var pluginCmds stringList
+	flag.Var(&pluginCmds, "plugin", "Run a plugin command (repeatable); sinks receive events, notifications are announced")
+	flag.Parse()
+
+	cfg, prof, err := cf.Load(flag.CommandLine)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	loc, err := locale.Get(cfg.Language)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	ann, err := NewAnnouncer(loc, cfg.Engineer.Templates)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if cfg.Engineer.MinGap > 0 {
+		ann.MinGap = time.Duration(cfg.Engineer.MinGap)
+	}
+	if cfg.Engineer.Cooldown > 0 {
+		ann.Cooldown = time.Duration(cfg.Engineer.Cooldown)
+	}
+
+	speakers := multiSpeaker{logSpeaker{os.Stdout}}
+	if *overlay != "" {
+		speakers = append(speakers, overlaySpeaker{*overlay})
+	}
+	if *say != "" {
+		speakers = append(speakers, newCommandSpeaker(*say))
+	}
+
+	alerts, err := events.NewAlerts(cfg.Alerts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	client := lib.NewClient(*baseURL)
+	client.HTTPClient = prof.HTTPClient()
+	det := events.NewDetector()
+	countdown := events.NewCountdown(cfg.Countdowns)
+	start := events.NewStart()
+	limits := events.NewTrackLimits(cfg.TrackLimits)
+	temps := events.NewTemps(cfg.Temperatures)
+	override, _ := client.PitSpeedLimit()
+	pitLane := events.NewPitLane(cfg.PitLane, override)
+	crossover := events.NewCrossover()
+	if limits.Allowed == 0 {
+		limits.Allowed, _ = client.CutsAllowed()
+	}
+	loop := run.New(*interval)
+	if *adaptive {
+		loop.Pace = &watch.DefaultPace
+	}
+	ctx := loop.Context()
+	brk := watch.NewBreaker()
+	brk.OnChange = func(h watch.Health) {
+		if h.Connected {
+			fmt.Fprintf(os.Stderr, "Connected to %s\n", *baseURL)
+		} else {
+			fmt.Fprintf(os.Stderr, "Disconnected: %v (retrying every %s)\n", h.Err, brk.Probe)
+		}
+	}
+
+	var plugins []*plugin.Plugin
+	for _, c := range pluginCmds {
+		p, err := plugin.Start(ctx, c, "engineer")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		loop.Defer(p.Close)
+		plugins = append(plugins, p)
+	}
+
+	elog, err := events.OpenLog(*eventsLog, "engineer")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	loop.Defer(elog.Close)
+
+	col := events.NewCollector(client)
+	elog.Sync(col.Clock())
+	var failing map[string]error
+
+	err = loop.Poll(func(now time.Time) error {
+		if !brk.Allow(now) {
+			return nil
+		}
+		snap, err := col.Collect(now)
+		brk.Record(now, err)
+		if err != nil {
+			if !watch.IsConnError(err) {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			return nil
+		}
+		// A section that fails is reported when it starts failing
+		for name, err := range snap.Errors {
+			if failing[name] == nil {
+				fmt.Fprintf(os.Stderr, "Error: %s: %v\n", name, err)
+			}
+		}
+		failing = snap.Errors
+		loop.Adapt(watch.Classify(snap.Session, snap.Standings))
+
+		evs := det.Process(snap)
+		fired, err := alerts.Process(snap)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		evs = append(evs, fired...)
+		evs = append(evs, start.Process(snap)...)
+		evs = append(evs, countdown.Process(snap)...)
+		evs = append(evs, temps.Process(snap)...)
+		evs = append(evs, pitLane.Process(snap)...)
+		evs = append(evs, crossover.Process(snap)...)
+		for _, e := range limits.Process(snap) {
+			if p, ok := player(snap); ok && e.SlotID == int(p.SlotID) {
+				evs = append(evs, e)
+			}
+		}
+		if err := elog.Write(evs...); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		for _, e := range evs {
+			if err := ann.Add(e, now); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+		}
+		for _, p := range plugins {
+			p.Snapshot(plugin.Snapshot{Standings: snap.Standings, Session: snap.Session, Meta: &snap.Meta})
+			for _, e := range evs {
+				p.Event(e)
+			}
+		drain:
+			for {
+				select {
+				case n := <-p.Notify:
+					ann.AddText(n.Text, ParsePriority(n.Priority), now)
+				default:
+					break drain
+				}
+			}
+		}
+		for {
+			a, ok := ann.Next(time.Now())
+			if !ok {
+				break
+			}
+			if err := speakers.Speak(ctx, a); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: speak: %v\n", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (l *stringList) String() string     { return strings.Join(*l, ", ") }
+func (l *stringList) Set(v string) error { *l = append(*l, v); return nil }
+
+func player(snap events.Snapshot) (lib.RestWatchStandingsResponseItem, bool) {
+	for _, s := range snap.Standings {
+		if s.Player {
+			return s, true
+		}
+	}
+	return lib.RestWatchStandingsResponseItem{}, false
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Speaker delivers an announcement: to a log, an overlay file, a TTS engine.
+type Speaker interface {
+	Speak(ctx context.Context, a Announcement) error
+}
+
+// logSpeaker writes timestamped lines.
+type logSpeaker struct {
+	w io.Writer
+}
+
+func (s logSpeaker) Speak(_ context.Context, a Announcement) error {
+	_, err := fmt.Fprintf(s.w, "%s  %-8s %s\n", time.Now().Format("15:04:05"), priorityName(a.Priority), a.Text)
+	return err
+}
+
+// overlaySpeaker replaces a text file with the latest announcement, for
+// stream overlays that watch a file (OBS text source).
+type overlaySpeaker struct {
+	path string
+}
+
+func (s overlaySpeaker) Speak(_ context.Context, a Announcement) error {
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(a.Text+"\n"), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+// commandSpeaker runs an external TTS program with the text as its last
+// argument, e.g. "espeak -v en" or "say".
+type commandSpeaker struct {
+	argv []string
+}
+
+func newCommandSpeaker(cmdline string) commandSpeaker {
+	return commandSpeaker{argv: strings.Fields(cmdline)}
+}
+
+func (s commandSpeaker) Speak(ctx context.Context, a Announcement) error {
+	args := append(append([]string(nil), s.argv[1:]...), a.Text)
+	return exec.CommandContext(ctx, s.argv[0], args...).Run()
+}
+
+// multiSpeaker fans out to several speakers and reports the first error.
+type multiSpeaker []Speaker
+
+func (m multiSpeaker) Speak(ctx context.Context, a Announcement) error {
+	var first error
+	for _, s := range m {
+		if err := s.Speak(ctx, a); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func priorityName(p Priority) string {
+	switch p {
+	case Critical:
+		return "CRITICAL"
+	case High:
+		return "HIGH"
+	case Normal:
+		return "INFO"
+	default:
+		return "LOW"
+	}
+}
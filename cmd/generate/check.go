@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// snapshot holds the Go files of the output directory as they were before
+// generation, so a run whose output does not compile can put them back.
+type snapshot map[string][]byte
+
+func takeSnapshot(outDir string) snapshot {
+	s := snapshot{}
+	files, _ := filepath.Glob(filepath.Join(outDir, "*.go"))
+	for _, f := range files {
+		if data, err := os.ReadFile(f); err == nil {
+			s[f] = data
+		}
+	}
+	return s
+}
+
+// restore removes the Go files written since the snapshot and rewrites the
+// ones it holds.
+func (s snapshot) restore(outDir string) {
+	files, _ := filepath.Glob(filepath.Join(outDir, "*.go"))
+	for _, f := range files {
+		if _, ok := s[f]; !ok {
+			os.Remove(f)
+		}
+	}
+	for f, data := range s {
+		if err := os.WriteFile(f, data, 0o644); err != nil {
+			log.Printf("Warning: restoring %s: %v", f, err)
+		}
+	}
+}
+
+// compileError is one compiler message about the generated code.
+type compileError struct {
+	File string
+	Line int
+	Msg  string
+	Decl string // the type or function the line is in, when found
+}
+
+func (e compileError) String() string {
+	s := fmt.Sprintf("%s:%d: %s", filepath.Base(e.File), e.Line, e.Msg)
+	if e.Decl != "" {
+		s += " (in " + e.Decl + ")"
+	}
+	return s
+}
+
+var compilerLine = regexp.MustCompile(`^(\S+\.go):(\d+):(?:\d+:)? (.*)$`)
+
+// compileCheck builds the output directory's package with the go tool. It
+// returns false without errors when there is no go tool or the directory
+// is not inside a module, in which case nothing can be checked.
+func compileCheck(outDir string) (bool, []compileError, error) {
+	if _, err := exec.LookPath("go"); err != nil {
+		return false, nil, nil
+	}
+	env := exec.Command("go", "env", "GOMOD")
+	env.Dir = outDir
+	gomod, err := env.Output()
+	if err != nil {
+		return false, nil, err
+	}
+	if mod := strings.TrimSpace(string(gomod)); mod == "" || mod == os.DevNull {
+		return false, nil, nil
+	}
+	var out bytes.Buffer
+	cmd := exec.Command("go", "build", "-o", os.DevNull, ".")
+	cmd.Dir = outDir
+	cmd.Stdout, cmd.Stderr = &out, &out
+	if cmd.Run() == nil {
+		return true, nil, nil
+	}
+	var errs []compileError
+	for _, line := range strings.Split(out.String(), "\n") {
+		m := compilerLine.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		n, _ := strconv.Atoi(m[2])
+		file := m[1]
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(outDir, file)
+		}
+		errs = append(errs, compileError{File: file, Line: n, Msg: m[3], Decl: declAt(file, n)})
+	}
+	if len(errs) == 0 {
+		return true, nil, fmt.Errorf("go build: %s", strings.TrimSpace(out.String()))
+	}
+	return true, errs, nil
+}
+
+// declAt names the top-level type or function declared around a line of a
+// Go file, e.g. "type RestWatchStandingsResponseItem".
+func declAt(path string, line int) string {
+	fset := token.NewFileSet()
+	// A file with syntax errors still yields the declarations before them
+	f, _ := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+	if f == nil {
+		return ""
+	}
+	for _, d := range f.Decls {
+		if fset.Position(d.Pos()).Line > line || fset.Position(d.End()).Line < line {
+			continue
+		}
+		switch d := d.(type) {
+		case *ast.FuncDecl:
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				return fmt.Sprintf("method %s of %s", d.Name.Name, exprString(d.Recv.List[0].Type))
+			}
+			return "func " + d.Name.Name
+		case *ast.GenDecl:
+			for _, s := range d.Specs {
+				if ts, ok := s.(*ast.TypeSpec); ok && fset.Position(ts.Pos()).Line <= line && fset.Position(ts.End()).Line >= line {
+					return "type " + ts.Name.Name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func exprString(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.StarExpr:
+		return exprString(e.X)
+	case *ast.Ident:
+		return e.Name
+	}
+	return "?"
+}
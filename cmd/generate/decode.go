@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// fastDecode names the models decoded often and in bulk: a standings car,
+// polled several times a second for every car on the grid, and a lap of
+// the standings history, which grows with every lap of every car. They
+// get UnmarshalJSON methods that decode without reflection (decode.go.tmpl),
+// and so do the structs nested in them.
+var fastDecode = map[string]bool{
+	"RestWatchStandingsResponseItem":            true,
+	"RestWatchStandingsHistoryResponseItemItem": true,
+}
+
+// decoders returns the generated decoders for the fastDecode models among
+// structs and the structs nested in them, in name order. Structs with
+// renamed fields keep their own UnmarshalJSON; a model with one is left
+// to encoding/json and one nested in a model is decoded with it.
+func decoders(structs map[string]Struct) []Decoder {
+	want := make(map[string]bool)
+	var visit func(name string)
+	visit = func(name string) {
+		st, ok := structs[name]
+		if !ok || want[name] || len(st.Compat()) > 0 {
+			return
+		}
+		want[name] = true
+		for _, f := range st.Fields {
+			visit(f.Type)
+		}
+	}
+	for name := range fastDecode {
+		if st, ok := structs[name]; ok && len(st.Compat()) > 0 {
+			log.Printf("Warning: %s has renamed fields, decoded with encoding/json", name)
+			continue
+		}
+		visit(name)
+	}
+
+	names := make([]string, 0, len(want))
+	for n := range want {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	var out []Decoder
+	for _, n := range names {
+		d := Decoder{Name: n, Root: fastDecode[n], KeysVar: strings.ToLower(n[:1]) + n[1:] + "Keys"}
+		for _, f := range structs[n].Fields {
+			d.Fields = append(d.Fields, DecodeField{Key: f.Key, Code: decodeCode(f, want)})
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+// decodeCode is the statement decoding field f of s; types without a
+// lexer method are left to encoding/json.
+func decodeCode(f Field, generated map[string]bool) string {
+	switch {
+	case f.Type == "float64":
+		return fmt.Sprintf("l.decodeFloat(&s.%s)", f.Name)
+	case f.Type == "string":
+		return fmt.Sprintf("l.decodeString(&s.%s)", f.Name)
+	case f.Type == "bool":
+		return fmt.Sprintf("l.decodeBool(&s.%s)", f.Name)
+	case f.Type == "json.Number":
+		return fmt.Sprintf("l.decodeNumber(&s.%s)", f.Name)
+	case generated[f.Type]:
+		return fmt.Sprintf("s.%s.decodeFast(l)", f.Name)
+	default:
+		return fmt.Sprintf("l.decodeValue(&s.%s)", f.Name)
+	}
+}
+
+// containerDecoder returns the generic decoder in decode.go.tmpl for a
+// response of fastDecode models, or "" for other responses.
+func containerDecoder(result string, decs []Decoder) string {
+	for _, d := range decs {
+		switch {
+		case !d.Root:
+		case result == "[]"+d.Name:
+			return "decodeSlice"
+		case result == "map[string][]"+d.Name:
+			return "decodeSliceMap"
+		}
+	}
+	return ""
+}
+
+// generateDecoders writes <group>_decode.go for the group's fastDecode
+// models, or drops a stale one when the group has none.
+func (g *generator) generateDecoders(group string, decs []Decoder) {
+	name := groupFile(group, "decode")
+	data := fileData{Group: group, Decoders: decs}
+	if len(data.Decoders) == 0 {
+		if src, err := os.ReadFile(filepath.Join(g.outDir, name)); err == nil && strings.HasPrefix(string(src), generatedHeader) {
+			os.Remove(filepath.Join(g.outDir, name))
+		}
+		return
+	}
+	g.write(name, "group_decode.go.tmpl", data)
+	log.Printf("Generated %s with %d decoders", name, len(data.Decoders))
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ExtraEndpoint is an endpoint the Swagger schema does not list, such as
+// the ones cmd/proxy -discover finds the game's UI calling. Response is an
+// example body the response type is inferred from, or ResponseFile a file
+// holding one (relative to the extra file); without either a parameterless
+// GET is called like any other.
+type ExtraEndpoint struct {
+	Path         string          `json:"path"`
+	Method       string          `json:"method"`
+	Params       []SwaggerParam  `json:"params,omitempty"`
+	Response     json.RawMessage `json:"response,omitempty"`
+	ResponseFile string          `json:"responseFile,omitempty"`
+}
+
+// extraFile is the -extra input; cmd/proxy writes the same shape as JSON,
+// extra-endpoints.yaml is the hand-written YAML form.
+type extraFile struct {
+	Endpoints []ExtraEndpoint `json:"endpoints"`
+}
+
+// defaultExtra is merged when present and -extra is not given; the
+// generator runs in cmd/, so it is the file in the repository root.
+const defaultExtra = "../extra-endpoints.yaml"
+
+func loadExtra(path string) ([]ExtraEndpoint, error) {
+	var f extraFile
+	if err := readConfig(path, &f); err != nil {
+		return nil, err
+	}
+	for i := range f.Endpoints {
+		ep := &f.Endpoints[i]
+		if !strings.HasPrefix(ep.Path, "/") || ep.Method == "" {
+			return nil, fmt.Errorf("%s: endpoint %d needs a path starting with / and a method", path, i+1)
+		}
+		if ep.ResponseFile == "" {
+			continue
+		}
+		if len(ep.Response) > 0 {
+			return nil, fmt.Errorf("%s: %s %s has both response and responseFile", path, ep.Method, ep.Path)
+		}
+		file := ep.ResponseFile
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(filepath.Dir(path), file)
+		}
+		sample, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s %s: %w", path, ep.Method, ep.Path, err)
+		}
+		if !json.Valid(sample) {
+			return nil, fmt.Errorf("%s: %s %s: %s is not JSON", path, ep.Method, ep.Path, ep.ResponseFile)
+		}
+		ep.Response = sample
+	}
+	return f.Endpoints, nil
+}
+
+// mergeExtra adds the extra endpoints to the schema's paths and returns
+// their example responses by sampleKey. An endpoint the schema already has
+// is left as documented, but its example is still used.
+func mergeExtra(schema *SwaggerSchema, extra []ExtraEndpoint) map[string][]byte {
+	if schema.Paths == nil {
+		schema.Paths = map[string]map[string]SwaggerOp{}
+	}
+	samples := make(map[string][]byte)
+	added := 0
+	for _, ep := range extra {
+		method := strings.ToLower(ep.Method)
+		if schema.Paths[ep.Path] == nil {
+			schema.Paths[ep.Path] = map[string]SwaggerOp{}
+		}
+		if _, ok := schema.Paths[ep.Path][method]; !ok {
+			schema.Paths[ep.Path][method] = SwaggerOp{Parameters: ep.Params}
+			added++
+		}
+		if len(ep.Response) > 0 && string(ep.Response) != "null" {
+			samples[sampleKey(method, ep.Path)] = ep.Response
+		}
+	}
+	log.Printf("Merged %d extra endpoints (%d with example responses)", added, len(samples))
+	return samples
+}
+
+// sampleKey identifies an endpoint's example response: "GET /rest/x".
+func sampleKey(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// readConfig decodes a generator input file, YAML (see parseYAML) or JSON
+// by its extension, into v with the JSON field names.
+func readConfig(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if ext := strings.ToLower(filepath.Ext(path)); ext == ".yaml" || ext == ".yml" {
+		doc, err := parseYAML(data)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", path, err)
+		}
+		if data, err = json.Marshal(doc); err != nil {
+			return err
+		}
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Hook customises generation without patching main.go. A fork adds a file
+// to this package that registers its hook:
+//
+//	func init() { registerHook(myHook{}) }
+//
+// and embeds BaseHook to implement only the methods it needs. Hooks run in
+// registration order.
+type Hook interface {
+	// Name identifies the hook in log output.
+	Name() string
+	// Endpoint runs once per endpoint before any code is generated. It may
+	// rename the endpoint (FuncName also names its response types) or drop
+	// it by returning false.
+	Endpoint(ep *Endpoint) bool
+	// File may rewrite a generated file before it is formatted and written.
+	// name is the file name within the output directory.
+	File(name string, src string) (string, error)
+	// Extra returns additional files (name → source) to write next to the
+	// generated ones; endpoints are those being generated, so only the -only
+	// groups on a partial run. Typical uses are extra methods or interfaces.
+	// Sources should start with the generated header so full runs clean
+	// them up.
+	Extra(endpoints []Endpoint) (map[string]string, error)
+}
+
+// BaseHook implements Hook with no-ops.
+type BaseHook struct{}
+
+func (BaseHook) Name() string                                { return "base" }
+func (BaseHook) Endpoint(*Endpoint) bool                     { return true }
+func (BaseHook) File(name, src string) (string, error)       { return src, nil }
+func (BaseHook) Extra([]Endpoint) (map[string]string, error) { return nil, nil }
+
+var hooks []Hook
+
+func registerHook(h Hook) {
+	hooks = append(hooks, h)
+}
+
+// applyEndpointHooks runs every hook's Endpoint over the list.
+func applyEndpointHooks(endpoints []Endpoint) []Endpoint {
+	if len(hooks) == 0 {
+		return endpoints
+	}
+	out := endpoints[:0]
+	for _, ep := range endpoints {
+		keep := true
+		for _, h := range hooks {
+			if !h.Endpoint(&ep) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			out = append(out, ep)
+		}
+	}
+	return out
+}
+
+// applyFileHooks runs every hook's File over a generated source.
+func applyFileHooks(path, code string) string {
+	for _, h := range hooks {
+		var err error
+		code, err = h.File(filepath.Base(path), code)
+		if err != nil {
+			log.Fatalf("Hook %s failed on %s: %v", h.Name(), filepath.Base(path), err)
+		}
+	}
+	return code
+}
+
+// writeExtraFiles writes the files hooks contribute, in name order.
+func writeExtraFiles(outDir string, endpoints []Endpoint) {
+	for _, h := range hooks {
+		files, err := h.Extra(endpoints)
+		if err != nil {
+			log.Fatalf("Hook %s failed: %v", h.Name(), err)
+		}
+		names := make([]string, 0, len(files))
+		for n := range files {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		for _, n := range names {
+			writeFormatted(filepath.Join(outDir, n), files[n])
+			log.Printf("Generated %s (hook %s)", n, h.Name())
+		}
+	}
+}
+
+// runPost runs the -post command with the output directory as its last
+// argument, for post-processing in any language (goimports, sed, ...).
+func runPost(command, outDir string) error {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil
+	}
+	cmd := exec.Command(args[0], append(args[1:], outDir)...)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("post-processing %q: %w", command, err)
+	}
+	return nil
+}
@@ -2,7 +2,7 @@
 // Fetches the Swagger schema, generates client stubs, calls every parameterless
 // GET endpoint to capture live JSON, and infers Go structs from the responses.
 //
-// Usage: go run ./cmd/generate -base http://localhost:6397
+// Usage (in cmd/): go run ./generate -base http://localhost:6397
 package main
 
 import (
@@ -10,14 +10,15 @@ import (
 	"flag"
 	"fmt"
 	"go/format"
-	"io"
 	"log"
 	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
 	"unicode"
 )
@@ -25,9 +26,9 @@ import (
 // ── Swagger schema types ────────────────────────────────────────────────────
 
 type SwaggerSchema struct {
-	Info        SwaggerInfo                       `json:"info"`
-	Definitions map[string]json.RawMessage        `json:"definitions"`
-	Paths       map[string]map[string]SwaggerOp   `json:"paths"`
+	Info        SwaggerInfo                     `json:"info"`
+	Definitions map[string]json.RawMessage      `json:"definitions"`
+	Paths       map[string]map[string]SwaggerOp `json:"paths"`
 }
 
 type SwaggerInfo struct {
@@ -36,7 +37,7 @@ type SwaggerInfo struct {
 }
 
 type SwaggerOp struct {
-	Parameters []SwaggerParam   `json:"parameters"`
+	Parameters []SwaggerParam             `json:"parameters"`
 	Responses  map[string]json.RawMessage `json:"responses"`
 }
 
@@ -49,17 +50,17 @@ type SwaggerParam struct {
 // ── Endpoint descriptor ─────────────────────────────────────────────────────
 
 type Endpoint struct {
-	Path       string
-	Method     string // GET, POST, PUT, DELETE
-	Params     []SwaggerParam
-	Group      string // e.g. "navigation", "garage", "race"
-	FuncName   string // Go-safe function name
-	HasPathP   bool   // has path parameters or regex
+	Path     string
+	Method   string // GET, POST, PUT, DELETE
+	Params   []SwaggerParam
+	Group    string // e.g. "navigation", "garage", "race"
+	FuncName string // Go-safe function name
+	HasPathP bool   // has path parameters or regex
 }
 
 // ── JSON-to-Go struct inference ─────────────────────────────────────────────
 
-func jsonToGoType(name string, v interface{}, structs map[string]string) string {
+func jsonToGoType(name string, v interface{}, structs map[string]Struct) string {
 	switch val := v.(type) {
 	case nil:
 		return "interface{}"
@@ -73,7 +74,13 @@ func jsonToGoType(name string, v interface{}, structs map[string]string) string
 		if len(val) == 0 {
 			return "[]interface{}"
 		}
-		elemType := jsonToGoType(name+"Item", val[0], structs)
+		// Infer from all elements, not just the first, so the type does not
+		// depend on which element happens to come first in the sample
+		elem := val[0]
+		for _, e := range val[1:] {
+			elem = mergeSamples(elem, e)
+		}
+		elemType := jsonToGoType(name+"Item", elem, structs)
 		return "[]" + elemType
 	case map[string]interface{}:
 		return jsonObjectToStruct(name, val, structs)
@@ -82,7 +89,60 @@ func jsonToGoType(name string, v interface{}, structs map[string]string) string
 	}
 }
 
-func jsonObjectToStruct(name string, obj map[string]interface{}, structs map[string]string) string {
+// mixed marks a value whose samples disagree on the JSON type.
+type mixed struct{}
+
+// mergeSamples combines two samples of the same value into one that has
+// every object key seen in either. null yields to the other side; samples
+// of different JSON types become mixed and are typed interface{}.
+func mergeSamples(a, b interface{}) interface{} {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			return mixed{}
+		}
+		out := make(map[string]interface{}, len(av)+len(bv))
+		for k, v := range av {
+			out[k] = v
+		}
+		for k, v := range bv {
+			if cur, ok := out[k]; ok {
+				out[k] = mergeSamples(cur, v)
+			} else {
+				out[k] = v
+			}
+		}
+		return out
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok {
+			return mixed{}
+		}
+		return append(append([]interface{}{}, av...), bv...)
+	case mixed:
+		return a
+	}
+	if fmt.Sprintf("%T", a) != fmt.Sprintf("%T", b) {
+		return mixed{}
+	}
+	return a
+}
+
+// exactNumbers are keys of 64-bit IDs sent as JSON numbers. A float64
+// only holds integers up to 2^53 exactly, below a 17-digit Steam ID, so
+// these are typed json.Number and keep the digits as sent.
+var exactNumbers = map[string]bool{
+	"steamID": true,
+}
+
+func jsonObjectToStruct(name string, obj map[string]interface{}, structs map[string]Struct) string {
 	if len(obj) == 0 {
 		return "map[string]interface{}"
 	}
@@ -107,34 +167,41 @@ func jsonObjectToStruct(name string, obj map[string]interface{}, structs map[str
 			break
 		}
 	}
-	if allNumeric && len(keys) > 1 {
-		// Use first value to determine the element type
-		elemType := jsonToGoType(name+"Item", obj[keys[0]], structs)
+	if allNumeric {
+		// Infer the element type from every value
+		elem := obj[keys[0]]
+		for _, k := range keys[1:] {
+			elem = mergeSamples(elem, obj[k])
+		}
+		elemType := jsonToGoType(name+"Item", elem, structs)
 		return "map[string]" + elemType
 	}
 
-	var fields []string
-	usedNames := make(map[string]int)
+	// Reserve the name before the nested types are named after it, and
+	// number it when another path through the sample already produced it
+	// (a field "aItem" next to an array "a")
+	for base, n := name, 2; structs[name].Name != ""; n++ {
+		name = fmt.Sprintf("%s%d", base, n)
+	}
+	structs[name] = Struct{Name: name}
+
+	var fields []Field
+	// UnmarshalJSON is the method structs with renamed fields get
+	usedNames := map[string]bool{"UnmarshalJSON": true}
 	for _, k := range keys {
-		fieldName := toExportedName(k)
-		// Ensure field name doesn't start with a digit
-		if len(fieldName) > 0 && fieldName[0] >= '0' && fieldName[0] <= '9' {
-			fieldName = "N" + fieldName
-		}
-		// Deduplicate field names within the same struct
-		if count, exists := usedNames[fieldName]; exists {
-			usedNames[fieldName] = count + 1
-			fieldName = fmt.Sprintf("%s%d", fieldName, count+1)
-		} else {
-			usedNames[fieldName] = 1
+		if !validTagKey(k) {
+			log.Printf("Warning: %s: key %q cannot be written as a struct tag, field skipped", name, k)
+			continue
 		}
+		fieldName := goName(k, usedNames)
 		fieldType := jsonToGoType(name+fieldName, obj[k], structs)
-		jsonTag := fmt.Sprintf("`json:\"%s\"`", k)
-		fields = append(fields, fmt.Sprintf("\t%s %s %s", fieldName, fieldType, jsonTag))
+		if fieldType == "float64" && exactNumbers[k] {
+			fieldType = "json.Number"
+		}
+		fields = append(fields, Field{Name: fieldName, Type: fieldType, Key: k})
 	}
 
-	structDef := fmt.Sprintf("type %s struct {\n%s\n}", name, strings.Join(fields, "\n"))
-	structs[name] = structDef
+	structs[name] = Struct{Name: name, Fields: fields}
 	return name
 }
 
@@ -167,6 +234,40 @@ func toExportedName(s string) string {
 	return result
 }
 
+// goName turns a JSON key into an exported Go name that is not in used yet
+// and adds it. Keys that only differ in characters Go names cannot hold
+// (emoji, punctuation, case of short words) get numbered suffixes.
+func goName(key string, used map[string]bool) string {
+	base := toExportedName(key)
+	if base[0] >= '0' && base[0] <= '9' {
+		base = "N" + base
+	}
+	name := base
+	for n := 2; used[name]; n++ {
+		name = fmt.Sprintf("%s%d", base, n)
+	}
+	used[name] = true
+	return name
+}
+
+// validTagKey reports whether encoding/json can match a key given in a
+// struct tag: not empty, not "-", and only the characters it accepts in tag
+// names, which also keeps backquotes, quotes and commas out of the tag.
+func validTagKey(key string) bool {
+	if key == "" || key == "-" {
+		return false
+	}
+	for _, r := range key {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", r):
+		case unicode.IsLetter(r), unicode.IsDigit(r):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 func endpointToFuncName(method, path string) string {
 	// Remove regex groups and path param placeholders for naming
 	clean := regexPathPart.ReplaceAllString(path, "")
@@ -214,26 +315,62 @@ func hasPathParams(path string, params []SwaggerParam) bool {
 
 func main() {
 	baseURL := flag.String("base", "http://localhost:6397", "Base URL of the API")
-	outDir := flag.String("out", "lib", "Output directory for generated code")
+	outDir := flag.String("out", "../lib", "Output directory for generated code")
+	fixtures := flag.String("fixtures", "", "Generate offline from recorded responses in this directory")
+	record := flag.String("record", "", "Save the schema and responses to this directory while generating")
+	post := flag.String("post", "", "Command to run on the output directory after generation")
+	only := flag.String("only", "", "Comma separated endpoint groups to regenerate (e.g. watch,garage); others are left untouched")
+	templates := flag.String("templates", "", "Directory of *.tmpl files overriding the built-in templates")
+	pkg := flag.String("package", "lib", "Package name of the generated code")
+	renames := flag.String("renames", "", "Rename map of JSON keys changed by game patches, YAML or JSON (default: "+defaultRenames+" if present)")
+	check := flag.Bool("check", true, "Build the output after generation and restore the previous files if it does not compile")
+	extra := flag.String("extra", "", "Merge endpoints missing from the schema from this YAML or JSON file, e.g. cmd/proxy -discover output (default: "+defaultExtra+" if present)")
 	flag.Parse()
 
 	log.SetFlags(0)
 
+	tmpl, err := loadTemplates(*templates)
+	if err != nil {
+		log.Fatalf("Failed to load templates: %v", err)
+	}
+	gen := &generator{tmpl: tmpl, pkg: *pkg, outDir: *outDir}
+
+	var src source = liveSource{baseURL: *baseURL}
+	if *fixtures != "" {
+		src = fixtureSource{dir: *fixtures}
+	}
+	if *record != "" {
+		if err := os.MkdirAll(*record, 0o755); err != nil {
+			log.Fatalf("Failed to create %s: %v", *record, err)
+		}
+		src = recorder{source: src, dir: *record}
+	}
+
 	// 1. Fetch swagger schema
 	log.Println("Fetching swagger schema...")
-	schemaURL := *baseURL + "/swagger-schema.json"
-	resp, err := http.Get(schemaURL)
+	body, err := src.Schema()
 	if err != nil {
 		log.Fatalf("Failed to fetch schema: %v", err)
 	}
-	defer resp.Body.Close()
-	body, _ := io.ReadAll(resp.Body)
 
 	var schema SwaggerSchema
 	if err := json.Unmarshal(body, &schema); err != nil {
 		log.Fatalf("Failed to parse schema: %v", err)
 	}
 	log.Printf("Parsed schema: %s v%s — %d paths", schema.Info.Title, schema.Info.Version, len(schema.Paths))
+	var samples map[string][]byte
+	if *extra == "" {
+		if _, err := os.Stat(defaultExtra); err == nil {
+			*extra = defaultExtra
+		}
+	}
+	if *extra != "" {
+		eps, err := loadExtra(*extra)
+		if err != nil {
+			log.Fatalf("Failed to load extra endpoints: %v", err)
+		}
+		samples = mergeExtra(&schema, eps)
+	}
 
 	// 2. Build endpoint list
 	var endpoints []Endpoint
@@ -250,17 +387,30 @@ func main() {
 			endpoints = append(endpoints, ep)
 		}
 	}
+	// Paths with several methods come out of a map; sort by method too so
+	// the order, and the names deduplicated by it, are stable across runs
 	sort.Slice(endpoints, func(i, j int) bool {
 		if endpoints[i].Group != endpoints[j].Group {
 			return endpoints[i].Group < endpoints[j].Group
 		}
-		return endpoints[i].Path < endpoints[j].Path
+		if endpoints[i].Path != endpoints[j].Path {
+			return endpoints[i].Path < endpoints[j].Path
+		}
+		return endpoints[i].Method < endpoints[j].Method
 	})
 	log.Printf("Found %d endpoints", len(endpoints))
 
-	// 3. For parameterless GET endpoints, call them and infer types
-	inferredStructs := make(map[string]string)     // struct name -> struct definition
-	endpointResponseType := make(map[string]string) // funcName -> response type
+	endpoints = applyEndpointHooks(endpoints)
+
+	if *only != "" {
+		endpoints = filterGroups(endpoints, *only)
+		log.Printf("Regenerating %d endpoints in %s", len(endpoints), *only)
+	}
+
+	// 3. For parameterless GET endpoints, call them and infer types; extra
+	// endpoints with an example response are typed from it instead
+	inferredStructs := make(map[string]map[string]Struct) // group -> struct name -> struct
+	endpointResponseType := make(map[string]string)       // funcName -> response type
 
 	totalGetCalls := 0
 	successCalls := 0
@@ -273,14 +423,17 @@ func main() {
 	log.Printf("%-55s %6s %10s  %s", strings.Repeat("─", 55), "──────", "──────────", "────────")
 
 	for _, ep := range endpoints {
-		if ep.Method != "GET" || ep.HasPathP {
+		sample, hasSample := samples[sampleKey(ep.Method, ep.Path)]
+		if !hasSample && (ep.Method != "GET" || ep.HasPathP) {
 			continue
 		}
 		totalGetCalls++
-		url := *baseURL + ep.Path
 		start := time.Now()
 
-		resp, err := http.Get(url)
+		status, respBody, err := http.StatusOK, sample, error(nil)
+		if !hasSample {
+			status, respBody, err = src.Get(ep.Path)
+		}
 		elapsed := time.Since(start)
 		totalCallTime += elapsed
 
@@ -289,19 +442,17 @@ func main() {
 			skippedCalls++
 			continue
 		}
-		respBody, _ := io.ReadAll(resp.Body)
-		resp.Body.Close()
 		bodyLen := len(respBody)
 		totalBytes += bodyLen
 
-		if resp.StatusCode != 200 {
-			log.Printf("%-55s %6d %10s  %8s  SKIP", ep.Path, resp.StatusCode, formatBytes(bodyLen), elapsed.Round(time.Millisecond))
+		if status != 200 {
+			log.Printf("%-55s %6d %10s  %8s  SKIP", ep.Path, status, formatBytes(bodyLen), elapsed.Round(time.Millisecond))
 			skippedCalls++
 			continue
 		}
 
 		if bodyLen == 0 {
-			log.Printf("%-55s %6d %10s  %8s  SKIP (empty)", ep.Path, resp.StatusCode, "0 B", elapsed.Round(time.Millisecond))
+			log.Printf("%-55s %6d %10s  %8s  SKIP (empty)", ep.Path, status, "0 B", elapsed.Round(time.Millisecond))
 			skippedCalls++
 			continue
 		}
@@ -309,30 +460,94 @@ func main() {
 		// Try to parse as JSON
 		var parsed interface{}
 		if err := json.Unmarshal(respBody, &parsed); err != nil {
-			log.Printf("%-55s %6d %10s  %8s  SKIP (not JSON)", ep.Path, resp.StatusCode, formatBytes(bodyLen), elapsed.Round(time.Millisecond))
+			log.Printf("%-55s %6d %10s  %8s  SKIP (not JSON)", ep.Path, status, formatBytes(bodyLen), elapsed.Round(time.Millisecond))
 			skippedCalls++
 			continue
 		}
 
 		typeName := ep.FuncName + "Response"
-		goType := jsonToGoType(typeName, parsed, inferredStructs)
+		if inferredStructs[ep.Group] == nil {
+			inferredStructs[ep.Group] = make(map[string]Struct)
+		}
+		goType := jsonToGoType(typeName, parsed, inferredStructs[ep.Group])
 		endpointResponseType[ep.FuncName] = goType
 		successCalls++
-		log.Printf("%-55s %6d %10s  %8s  -> %s", ep.Path, resp.StatusCode, formatBytes(bodyLen), elapsed.Round(time.Millisecond), goType)
+		log.Printf("%-55s %6d %10s  %8s  -> %s", ep.Path, status, formatBytes(bodyLen), elapsed.Round(time.Millisecond), goType)
+	}
+
+	if *renames == "" {
+		if _, err := os.Stat(defaultRenames); err == nil {
+			*renames = defaultRenames
+		}
+	}
+	if *renames != "" {
+		list, err := loadRenames(*renames, time.Now())
+		if err != nil {
+			log.Fatalf("Failed to load renames: %v", err)
+		}
+		applyRenames(inferredStructs, list)
 	}
 
 	log.Println()
 	log.Printf("GET summary: %d called, %d inferred, %d skipped | %s total data | %s total time",
 		totalGetCalls, successCalls, skippedCalls, formatBytes(totalBytes), totalCallTime.Round(time.Millisecond))
 
-	// 4. Generate code
+	// 4. Generate code: client.go holds the HTTP client, each endpoint group
+	// gets <group>_client.go and <group>_models.go so a partial run only
+	// rewrites the groups it covers
 	os.MkdirAll(*outDir, 0o755)
+	before := takeSnapshot(*outDir)
+	if *only == "" {
+		removeGenerated(*outDir)
+	}
 
-	// 4a. Generate models.go — all inferred structs
-	generateModels(*outDir, inferredStructs)
-
-	// 4b. Generate client.go — the HTTP client + all stubs
-	generateClient(*outDir, endpoints, endpointResponseType)
+	byGroup := make(map[string][]Endpoint)
+	var groups []string
+	for _, ep := range endpoints {
+		if byGroup[ep.Group] == nil {
+			groups = append(groups, ep.Group)
+		}
+		byGroup[ep.Group] = append(byGroup[ep.Group], ep)
+	}
+	if *only == "" || gen.coreMissing() {
+		gen.generateCore(groups)
+	}
+	for _, g := range groups {
+		// 4a. <group>_models.go — the structs inferred for the group
+		gen.generateModels(g, inferredStructs[g])
+		// 4b. <group>_decode.go — decoders of the group's fastDecode models
+		decs := decoders(inferredStructs[g])
+		gen.generateDecoders(g, decs)
+		// 4c. <group>_client.go — the group's endpoint stubs
+		gen.generateClient(g, byGroup[g], endpointResponseType, decs)
+	}
+	writeExtraFiles(*outDir, endpoints)
+
+	if err := runPost(*post, *outDir); err != nil {
+		log.Fatal(err)
+	}
+
+	// 5. Make sure the output compiles before leaving it in place
+	if *check {
+		log.Println()
+		checked, errs, err := compileCheck(*outDir)
+		switch {
+		case err != nil || len(errs) > 0:
+			log.Printf("Generated code in %s does not compile:", *outDir)
+			if err != nil {
+				log.Printf("  %v", err)
+			}
+			for _, e := range errs {
+				log.Printf("  %s", e)
+			}
+			before.restore(*outDir)
+			log.Fatalf("Restored the previous files. Fix the cause (a rename, hook or template) or pass -check=false to keep the output for inspection.")
+		case checked:
+			log.Println("Compile check passed")
+		default:
+			log.Printf("Compile check skipped: no go tool, or %s is not inside a module", *outDir)
+		}
+	}
 
 	log.Println()
 	log.Println("Done! Generated code in:", *outDir)
@@ -351,221 +566,292 @@ func formatBytes(b int) string {
 
 // ── Code generation ─────────────────────────────────────────────────────────
 
-func generateModels(outDir string, structs map[string]string) {
-	var buf strings.Builder
-	buf.WriteString("// Code generated by cmd/generate. DO NOT EDIT.\n")
-	buf.WriteString("package lib\n\n")
+// groupFile names a generated file for an endpoint group. The group comes
+// first so a group named like a GOOS or "test" cannot turn into a build
+// constraint.
+func groupFile(group, kind string) string {
+	return strings.Trim(nonAlpha.ReplaceAllString(strings.ToLower(group), "_"), "_") + "_" + kind + ".go"
+}
 
-	// Sort for deterministic output
-	names := make([]string, 0, len(structs))
-	for n := range structs {
-		names = append(names, n)
+// filterGroups keeps the endpoints of the comma separated groups and exits
+// on a group the schema does not have.
+func filterGroups(endpoints []Endpoint, only string) []Endpoint {
+	want := make(map[string]bool)
+	for _, g := range strings.Split(only, ",") {
+		if g = strings.TrimSpace(g); g != "" {
+			want[g] = true
+		}
 	}
-	sort.Strings(names)
+	have := make(map[string]bool)
+	var out []Endpoint
+	for _, ep := range endpoints {
+		have[ep.Group] = true
+		if want[ep.Group] {
+			out = append(out, ep)
+		}
+	}
+	for g := range want {
+		if !have[g] {
+			groups := make([]string, 0, len(have))
+			for h := range have {
+				groups = append(groups, h)
+			}
+			sort.Strings(groups)
+			log.Fatalf("Unknown group %q (groups: %s)", g, strings.Join(groups, ", "))
+		}
+	}
+	return out
+}
 
-	for _, n := range names {
-		buf.WriteString(structs[n])
-		buf.WriteString("\n\n")
-	}
-
-	writeFormatted(filepath.Join(outDir, "models.go"), buf.String())
-	log.Printf("Generated models.go with %d structs", len(structs))
-}
-
-func generateClient(outDir string, endpoints []Endpoint, responseTypes map[string]string) {
-	var buf strings.Builder
-	buf.WriteString("// Code generated by cmd/generate. DO NOT EDIT.\n")
-	buf.WriteString("package lib\n\n")
-	buf.WriteString("import (\n")
-	buf.WriteString("\t\"bytes\"\n")
-	buf.WriteString("\t\"encoding/json\"\n")
-	buf.WriteString("\t\"fmt\"\n")
-	buf.WriteString("\t\"io\"\n")
-	buf.WriteString("\t\"net/http\"\n")
-	buf.WriteString(")\n\n")
-
-	// Client struct
-	buf.WriteString("type Client struct {\n")
-	buf.WriteString("\tBaseURL    string\n")
-	buf.WriteString("\tHTTPClient *http.Client\n")
-	buf.WriteString("}\n\n")
-
-	buf.WriteString("func NewClient(baseURL string) *Client {\n")
-	buf.WriteString("\treturn &Client{BaseURL: baseURL, HTTPClient: http.DefaultClient}\n")
-	buf.WriteString("}\n\n")
-
-	// Helper methods
-	buf.WriteString(`func (c *Client) doRequest(method, path string, body interface{}) ([]byte, error) {
-	var reqBody io.Reader
-	if body != nil {
-		b, err := json.Marshal(body)
-		if err != nil {
-			return nil, fmt.Errorf("marshal request body: %w", err)
+// removeGenerated deletes previously generated files before a full run, so
+// groups that disappeared from the API do not linger.
+func removeGenerated(outDir string) {
+	files, _ := filepath.Glob(filepath.Join(outDir, "*.go"))
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err == nil && strings.HasPrefix(string(data), generatedHeader) {
+			os.Remove(f)
 		}
-		reqBody = bytes.NewReader(b)
 	}
-	req, err := http.NewRequest(method, c.BaseURL+path, reqBody)
+}
+
+const generatedHeader = "// Code generated by cmd/generate. DO NOT EDIT.\n"
+
+// generator renders the output files from the templates.
+type generator struct {
+	tmpl   *template.Template
+	pkg    string
+	outDir string
+}
+
+func (g *generator) write(name, tmpl string, data fileData) {
+	data.Package = g.pkg
+	code, err := render(g.tmpl, tmpl, data)
 	if err != nil {
-		return nil, err
+		log.Fatal(err)
 	}
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	writeFormatted(filepath.Join(g.outDir, name), code)
+}
+
+func (g *generator) generateModels(group string, structs map[string]Struct) {
+	name := groupFile(group, "models")
+	if len(structs) == 0 {
+		// nothing inferred, e.g. only POST endpoints; drop a stale file
+		if data, err := os.ReadFile(filepath.Join(g.outDir, name)); err == nil && strings.HasPrefix(string(data), generatedHeader) {
+			os.Remove(filepath.Join(g.outDir, name))
+		}
+		return
 	}
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
+
+	// Sort for deterministic output
+	names := make([]string, 0, len(structs))
+	for n := range structs {
+		names = append(names, n)
 	}
-	defer resp.Body.Close()
-	data, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
+	sort.Strings(names)
+	data := fileData{Group: group}
+	for _, n := range names {
+		data.Structs = append(data.Structs, structs[n])
+		if len(data.Imports) == 0 && (len(structs[n].Compat()) > 0 || structs[n].hasNumber()) {
+			data.Imports = []string{"encoding/json"}
+		}
 	}
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return data, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(data))
+
+	g.write(name, "group_models.go.tmpl", data)
+	log.Printf("Generated %s with %d structs", name, len(structs))
+}
+
+// generateCore writes client.go, metrics.go and decode.go. ClientInterface embeds the
+// interfaces of groups; a partial run keeps the existing files, so a group
+// that is new to the API appears in it on the next full run.
+func (g *generator) generateCore(groups []string) {
+	data := fileData{}
+	for _, grp := range groups {
+		data.Interfaces = append(data.Interfaces, interfaceName(grp))
+	}
+	g.write("client.go", "client.go.tmpl", data)
+	g.write("metrics.go", "metrics.go.tmpl", fileData{})
+	g.write("decode.go", "decode.go.tmpl", fileData{})
+	g.write("decode_std.go", "decode_std.go.tmpl", fileData{})
+}
+
+// coreMissing reports whether a core file has to be written on a partial run.
+func (g *generator) coreMissing() bool {
+	for _, name := range []string{"client.go", "metrics.go", "decode.go", "decode_std.go"} {
+		if _, err := os.Stat(filepath.Join(g.outDir, name)); err != nil {
+			return true
+		}
 	}
-	return data, nil
+	return false
 }
-`)
-	buf.WriteString("\n")
 
+// interfaceName names a group's interface: watch → WatchAPI.
+func interfaceName(group string) string {
+	return toExportedName(group) + "API"
+}
+
+func (g *generator) generateClient(group string, endpoints []Endpoint, responseTypes map[string]string, decs []Decoder) {
+	data := fileData{Group: group, Interface: interfaceName(group)}
 	// Track seen func names to avoid duplicates
 	seen := make(map[string]bool)
+	var usesFmt, usesURL bool
 
 	for _, ep := range endpoints {
-		funcName := ep.FuncName
-		if seen[funcName] {
-			funcName = funcName + ep.Method
+		m := Method{Name: ep.FuncName, Endpoint: ep, HTTPMethod: ep.Method, Body: "nil"}
+		if seen[m.Name] {
+			m.Name += ep.Method
 		}
-		seen[funcName] = true
+		seen[m.Name] = true
 
-		// Build function signature
+		// Build function signature: declared path params, then query params
 		var sigParams []string
-		var pathBuild string
-
-		// Collect path params
-		pathExpr := ep.Path
 		for _, p := range ep.Params {
 			if p.In == "path" {
-				goParamType := swaggerTypeToGo(p.Type)
-				sigParams = append(sigParams, fmt.Sprintf("%s %s", toLowerCamel(p.Name), goParamType))
+				sigParams = append(sigParams, fmt.Sprintf("%s %s", toLowerCamel(p.Name), swaggerTypeToGo(p.Type)))
 			}
 		}
-
-		// Collect query params
-		var queryParams []SwaggerParam
 		for _, p := range ep.Params {
 			if p.In == "query" {
-				goParamType := swaggerTypeToGo(p.Type)
-				sigParams = append(sigParams, fmt.Sprintf("%s %s", toLowerCamel(p.Name), goParamType))
-				queryParams = append(queryParams, p)
+				sigParams = append(sigParams, fmt.Sprintf("%s %s", toLowerCamel(p.Name), swaggerTypeToGo(p.Type)))
 			}
 		}
-
-		// Check for body param
-		hasBody := false
 		for _, p := range ep.Params {
 			if p.In == "body" {
-				hasBody = true
+				sigParams = append(sigParams, "body interface{}")
+				m.Body = "body"
 				break
 			}
 		}
-		if hasBody {
-			sigParams = append(sigParams, "body interface{}")
-		}
-
-		// Replace path placeholders: {name} -> %v, and regex groups -> %v
-		pathExpr = regexp.MustCompile(`\{(\w+)\}`).ReplaceAllString(pathExpr, "%v")
-		pathExpr = regexPathPart.ReplaceAllString(pathExpr, "%v")
+		m.Params = strings.Join(sigParams, ", ")
 
-		// Count format verbs to build fmt.Sprintf args
-		pathParamNames := []string{}
-		for _, p := range ep.Params {
-			if p.In == "path" {
-				pathParamNames = append(pathParamNames, toLowerCamel(p.Name))
-			}
-		}
-
-		if len(pathParamNames) > 0 {
-			pathBuild = fmt.Sprintf("fmt.Sprintf(\"%s\", %s)", pathExpr, strings.Join(pathParamNames, ", "))
+		pathExpr, pathArgs, queryParams := buildPath(ep.Path, ep.Params)
+		if len(pathArgs) > 0 {
+			m.Path = fmt.Sprintf("fmt.Sprintf(%q, %s)", pathExpr, strings.Join(pathArgs, ", "))
+			usesFmt = true
 		} else {
-			pathBuild = fmt.Sprintf("%q", ep.Path)
+			m.Path = fmt.Sprintf("%q", pathExpr)
+		}
+		if len(queryParams) > 0 {
+			m.Path += ` + "?" + q.Encode()`
+			usesURL = true
+		}
+		for _, p := range queryParams {
+			qp := QueryParam{Key: p.Name, Var: toLowerCamel(p.Name), String: swaggerTypeToGo(p.Type) == "string"}
+			usesFmt = usesFmt || !qp.String
+			m.Query = append(m.Query, qp)
 		}
 
-		// Determine return type
+		// Determine return type: objects are returned by pointer, other
+		// inferred types by value, endpoints without a sample as raw JSON
 		retType := responseTypes[ep.FuncName]
-		hasTypedResponse := retType != "" && !strings.HasPrefix(retType, "[]") && retType != "string" && retType != "bool" && retType != "int64" && retType != "float64" && retType != "interface{}" && retType != "map[string]interface{}"
-
-		if retType == "" {
-			retType = "json.RawMessage"
+		switch {
+		case retType == "":
+			m.Return, m.Raw = "json.RawMessage", true
+		case strings.HasPrefix(retType, "[]") || retType == "string" || retType == "bool" || retType == "int64" || retType == "float64" || retType == "interface{}" || retType == "map[string]interface{}":
+			m.Return, m.Result = retType, retType
+		default:
+			m.Return, m.Result, m.Pointer = "*"+retType, retType, true
 		}
+		m.Decode = containerDecoder(m.Result, decs)
+		// A generated decoder is faster than decoding while reading
+		m.Stream = !m.Raw && m.Decode == "" && streamEndpoints[ep.Path]
+		m.Zero = zeroValue(m.Return)
+		data.Methods = append(data.Methods, m)
+	}
 
-		// Write function
-		sig := strings.Join(sigParams, ", ")
-		if retType == "json.RawMessage" || !hasTypedResponse {
-			// Raw return
-			buf.WriteString(fmt.Sprintf("func (c *Client) %s(%s) (%s, error) {\n", funcName, sig, retType))
-		} else {
-			buf.WriteString(fmt.Sprintf("func (c *Client) %s(%s) (*%s, error) {\n", funcName, sig, retType))
-		}
+	// Import only what the group's methods use
+	if slices.ContainsFunc(data.Methods, func(m Method) bool { return m.Raw || !m.Stream && m.Decode == "" }) {
+		data.Imports = append(data.Imports, "encoding/json")
+	}
+	if usesFmt {
+		data.Imports = append(data.Imports, "fmt")
+	}
+	if usesURL {
+		data.Imports = append(data.Imports, "net/url")
+	}
 
-		// Body arg for doRequest
-		bodyArg := "nil"
-		if hasBody {
-			bodyArg = "body"
-		}
+	name := groupFile(group, "client")
+	g.write(name, "group_client.go.tmpl", data)
+	log.Printf("Generated %s with %d methods", name, len(endpoints))
+}
 
-		buf.WriteString(fmt.Sprintf("\tdata, err := c.doRequest(%q, %s, %s)\n", ep.Method, pathBuild, bodyArg))
-		buf.WriteString("\tif err != nil {\n")
-		if hasTypedResponse {
-			buf.WriteString("\t\treturn nil, err\n")
-		} else {
-			writeZeroReturn(&buf, retType)
-		}
-		buf.WriteString("\t}\n")
+// streamEndpoints return payloads large enough that their methods decode
+// the response while reading it rather than buffering the body first:
+// the standings history grows with every lap of every car, and the track
+// map is a long list of points.
+var streamEndpoints = map[string]bool{
+	"/rest/watch/standings/history": true,
+	"/rest/watch/trackmap":          true,
+}
 
-		// Add query params if any
-		if len(queryParams) > 0 {
-			// We need to adjust — actually query params should go into the URL.
-			// Let me add them before the doRequest call. I'll restructure.
-			// For simplicity, embed them in the path build.
-		}
-
-		// Unmarshal if typed
-		if hasTypedResponse {
-			buf.WriteString(fmt.Sprintf("\tvar result %s\n", retType))
-			buf.WriteString("\tif err := json.Unmarshal(data, &result); err != nil {\n")
-			buf.WriteString("\t\treturn nil, err\n")
-			buf.WriteString("\t}\n")
-			buf.WriteString("\treturn &result, nil\n")
-		} else if retType == "json.RawMessage" {
-			buf.WriteString("\treturn data, nil\n")
+var placeholder = regexp.MustCompile(`\{(\w+)\}|\(.*?\)`)
+
+// buildPath turns a schema path into a fmt format string and the argument
+// names filling it. {name} placeholders take the path parameter of that
+// name, regex groups take the remaining path parameters in order. Path
+// parameters without a placeholder (the schema declares some that way) are
+// returned with the query parameters and sent in the query string.
+func buildPath(path string, params []SwaggerParam) (expr string, args []string, query []SwaggerParam) {
+	var declared []SwaggerParam
+	for _, p := range params {
+		if p.In == "path" {
+			declared = append(declared, p)
+		}
+	}
+	used := make([]bool, len(declared))
+	named := make(map[string]bool)
+	for _, m := range placeholder.FindAllStringSubmatch(path, -1) {
+		if m[1] != "" {
+			named[m[1]] = true
+		}
+	}
+	expr = placeholder.ReplaceAllStringFunc(path, func(m string) string {
+		idx := -1
+		if strings.HasPrefix(m, "{") {
+			for i, p := range declared {
+				if !used[i] && p.Name == m[1:len(m)-1] {
+					idx = i
+					break
+				}
+			}
 		} else {
-			// primitive types or slices
-			buf.WriteString(fmt.Sprintf("\tvar result %s\n", retType))
-			buf.WriteString("\tif err := json.Unmarshal(data, &result); err != nil {\n")
-			writeZeroReturn(&buf, retType)
-			buf.WriteString("\t}\n")
-			buf.WriteString("\treturn result, nil\n")
+			for i, p := range declared {
+				if !used[i] && !named[p.Name] {
+					idx = i
+					break
+				}
+			}
+		}
+		if idx < 0 {
+			return m
+		}
+		used[idx] = true
+		args = append(args, toLowerCamel(declared[idx].Name))
+		return "%v"
+	})
+	for i, p := range declared {
+		if !used[i] {
+			query = append(query, p)
 		}
-		buf.WriteString("}\n\n")
 	}
-
-	writeFormatted(filepath.Join(outDir, "client.go"), buf.String())
-	log.Printf("Generated client.go with %d methods", len(endpoints))
+	for _, p := range params {
+		if p.In == "query" {
+			query = append(query, p)
+		}
+	}
+	return expr, args, query
 }
 
-func writeZeroReturn(buf *strings.Builder, retType string) {
-	switch {
-	case retType == "string":
-		buf.WriteString("\t\treturn \"\", err\n")
-	case retType == "bool":
-		buf.WriteString("\t\treturn false, err\n")
-	case retType == "int64" || retType == "float64":
-		buf.WriteString("\t\treturn 0, err\n")
-	case strings.HasPrefix(retType, "[]") || strings.HasPrefix(retType, "map"):
-		buf.WriteString("\t\treturn nil, err\n")
+func zeroValue(t string) string {
+	switch t {
+	case "string":
+		return `""`
+	case "bool":
+		return "false"
+	case "int64", "float64":
+		return "0"
 	default:
-		buf.WriteString("\t\treturn nil, err\n")
+		return "nil"
 	}
 }
 
@@ -608,6 +894,7 @@ func toLowerCamel(s string) string {
 }
 
 func writeFormatted(path string, code string) {
+	code = applyFileHooks(path, code)
 	formatted, err := format.Source([]byte(code))
 	if err != nil {
 		log.Printf("Warning: gofmt failed for %s: %v — writing unformatted", path, err)
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// The fuzz targets render the models inferred from hostile samples (emoji,
+// keys starting with digits, keys that only differ in what the naming drops)
+// and type-check them, so no sample can make the generator write code that
+// does not compile. Run one with e.g.
+//
+//	go test ./generate -fuzz FuzzJSONObjectToStruct
+
+func init() {
+	// The generator warns about every skipped key
+	log.SetOutput(io.Discard)
+}
+
+// hostileKeys are newline-separated key sets.
+var hostileKeys = []string{
+	"🏁\n🚗\nflag",
+	"1st\n2nd\n1_st\nN1st",
+	"a_b\naB\nA-B\na.b\nab",
+	"id\nID\nId\n_id",
+	"UnmarshalJSON\nunmarshal_json",
+	"\n-\n\"\n`\na,b\nok",
+	"type\nfunc\nmap\nrange",
+	"x\nxItem\nX_item",
+	"é\nÉ\nñandú\n世界",
+}
+
+var validIdent = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+
+func FuzzToExportedName(f *testing.F) {
+	for _, keys := range hostileKeys {
+		for _, k := range strings.Split(keys, "\n") {
+			f.Add(k)
+		}
+	}
+	f.Fuzz(func(t *testing.T, key string) {
+		base := toExportedName(key)
+		if !validIdent.MatchString(base) {
+			t.Fatalf("toExportedName(%q) = %q, want only ASCII letters and digits", key, base)
+		}
+		used := map[string]bool{}
+		a, b := goName(key, used), goName(key, used)
+		for _, name := range []string{a, b} {
+			if !token.IsIdentifier(name) || !token.IsExported(name) {
+				t.Fatalf("goName(%q) = %q, not an exported identifier", key, name)
+			}
+		}
+		if a == b {
+			t.Fatalf("goName(%q) gave %q twice", key, a)
+		}
+	})
+}
+
+func FuzzJSONObjectToStruct(f *testing.F) {
+	for _, keys := range hostileKeys {
+		f.Add(keys)
+	}
+	f.Fuzz(func(t *testing.T, keys string) {
+		obj := map[string]interface{}{}
+		for i, k := range strings.Split(keys, "\n") {
+			// Vary the values so some keys get nested types named after them
+			switch i % 4 {
+			case 0:
+				obj[k] = 1.5
+			case 1:
+				obj[k] = "s"
+			case 2:
+				obj[k] = map[string]interface{}{k: true}
+			default:
+				obj[k] = []interface{}{map[string]interface{}{"v": 1.0}}
+			}
+		}
+		structs := map[string]Struct{}
+		typ := jsonObjectToStruct("Root", obj, structs)
+		checkModels(t, typ, structs)
+		for _, st := range structs {
+			for _, fl := range st.Fields {
+				if !validTagKey(fl.Key) {
+					t.Fatalf("%s.%s has key %q, which cannot be a struct tag", st.Name, fl.Name, fl.Key)
+				}
+			}
+		}
+		// Every key that can be a struct tag gets a field
+		if st, ok := structs[typ]; ok {
+			n := 0
+			for k := range obj {
+				if validTagKey(k) {
+					n++
+				}
+			}
+			if len(st.Fields) != n {
+				t.Fatalf("%d fields for %d usable keys", len(st.Fields), n)
+			}
+		}
+	})
+}
+
+func FuzzJSONToGoType(f *testing.F) {
+	f.Add([]byte(`{"🏁": 1, "1st": {"2nd": [1, 2]}, "a_b": "x", "aB": true}`))
+	f.Add([]byte(`{"a": [{"b": 1}, {"c": "x"}], "aItem": {"b": null}}`))
+	f.Add([]byte(`{"1": {"x": 1}, "2": {"y": "s"}}`))
+	f.Add([]byte(`[[{"-": 1, "ok": {"ok": {"ok": 1}}}]]`))
+	f.Add([]byte(`{"x": {"y": 1}, "xY": {"z": 2}, "x_y": [3]}`))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v interface{}
+		if json.Unmarshal(data, &v) != nil {
+			return
+		}
+		structs := map[string]Struct{}
+		checkModels(t, jsonToGoType("Root", v, structs), structs)
+	})
+}
+
+// checkModels renders structs as generateModels does and type-checks them
+// together with a variable of type typ, the type inferred for the sample.
+func checkModels(t *testing.T, typ string, structs map[string]Struct) {
+	t.Helper()
+	tmpl, err := loadTemplates("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(structs))
+	for n := range structs {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	data := fileData{Package: "lib", Group: "fuzz"}
+	for _, n := range names {
+		if structs[n].Name != n {
+			t.Fatalf("struct %q is stored as %q", structs[n].Name, n)
+		}
+		data.Structs = append(data.Structs, structs[n])
+	}
+	code, err := render(tmpl, "group_models.go.tmpl", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code += "\nvar _ " + typ + "\n"
+	src, err := format.Source([]byte(code))
+	if err != nil {
+		t.Fatalf("rendered models do not parse: %v\n%s", err, code)
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "fuzz_models.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := types.Config{Error: func(err error) { t.Errorf("%v", err) }}
+	if _, err := conf.Check("lib", fset, []*ast.File{file}, nil); err != nil {
+		t.Fatalf("rendered models do not type-check:\n%s", src)
+	}
+}
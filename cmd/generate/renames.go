@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+)
+
+// Rename records a JSON key the game renamed in a patch. Fields generated
+// with either key get an UnmarshalJSON that also accepts the other one, so
+// a client built against one game version keeps decoding the other during
+// the transition.
+type Rename struct {
+	Old   string `json:"old"`
+	New   string `json:"new"`
+	Type  string `json:"type,omitempty"`  // only in this struct; all when empty
+	Until string `json:"until,omitempty"` // YYYY-MM-DD after which the shim is dropped
+}
+
+type renamesFile struct {
+	Renames []Rename `json:"renames"`
+}
+
+// defaultRenames is read when present and -renames is not given, from the
+// repository root like defaultExtra.
+const defaultRenames = "../field-renames.yaml"
+
+func loadRenames(path string, now time.Time) ([]Rename, error) {
+	var f renamesFile
+	if err := readConfig(path, &f); err != nil {
+		return nil, err
+	}
+	var out []Rename
+	for i, r := range f.Renames {
+		if r.Old == "" || r.New == "" || r.Old == r.New {
+			return nil, fmt.Errorf("%s: rename %d needs different old and new keys", path, i+1)
+		}
+		if !validTagKey(r.Old) || !validTagKey(r.New) {
+			return nil, fmt.Errorf("%s: rename %s → %s: keys must be usable in a struct tag", path, r.Old, r.New)
+		}
+		if r.Until != "" {
+			until, err := time.Parse("2006-01-02", r.Until)
+			if err != nil {
+				return nil, fmt.Errorf("%s: rename %s → %s: until: %w", path, r.Old, r.New, err)
+			}
+			if now.After(until.AddDate(0, 0, 1)) {
+				log.Printf("Rename %s → %s expired on %s, not generating its shim", r.Old, r.New, r.Until)
+				continue
+			}
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// applyRenames adds the other key of every matching rename to the fields
+// of the inferred structs, unless the struct also has a field with that key.
+func applyRenames(structs map[string]map[string]Struct, renames []Rename) {
+	used := make([]bool, len(renames))
+	for _, group := range structs {
+		for name, st := range group {
+			keys := make(map[string]bool, len(st.Fields))
+			for _, f := range st.Fields {
+				keys[f.Key] = true
+			}
+			changed := false
+			for fi := range st.Fields {
+				f := &st.Fields[fi]
+				for ri, r := range renames {
+					if r.Type != "" && r.Type != name {
+						continue
+					}
+					other := ""
+					switch f.Key {
+					case r.New:
+						other = r.Old
+					case r.Old:
+						other = r.New
+					}
+					if other == "" || keys[other] || hasAlias(f.Aliases, other) {
+						continue
+					}
+					used[ri] = true
+					f.Aliases = append(f.Aliases, Alias{Key: other})
+					changed = true
+				}
+			}
+			if changed {
+				nameAliases(&st)
+				group[name] = st
+			}
+		}
+	}
+	for i, r := range renames {
+		if !used[i] {
+			log.Printf("Rename %s → %s matches no field", r.Old, r.New)
+		}
+	}
+}
+
+func hasAlias(aliases []Alias, key string) bool {
+	for _, a := range aliases {
+		if a.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// nameAliases gives the aliases of a struct distinct Go names for the
+// decoding struct of its UnmarshalJSON.
+func nameAliases(st *Struct) {
+	used := make(map[string]bool)
+	for fi := range st.Fields {
+		for ai := range st.Fields[fi].Aliases {
+			a := &st.Fields[fi].Aliases[ai]
+			a.Var = goName(a.Key, used)
+		}
+		sort.Slice(st.Fields[fi].Aliases, func(i, j int) bool { return st.Fields[fi].Aliases[i].Key < st.Fields[fi].Aliases[j].Key })
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// source provides the swagger schema and endpoint responses, either from the
+// running game or from a directory of recorded fixtures.
+type source interface {
+	Schema() ([]byte, error)
+	Get(path string) (status int, body []byte, err error)
+}
+
+// liveSource queries the game's API.
+type liveSource struct {
+	baseURL string
+}
+
+func (s liveSource) Schema() ([]byte, error) {
+	resp, err := http.Get(s.baseURL + "/swagger-schema.json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+func (s liveSource) Get(path string) (int, []byte, error) {
+	resp, err := http.Get(s.baseURL + path)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return resp.StatusCode, body, err
+}
+
+// fixtureSource reads swagger-schema.json and one <name>.json per endpoint
+// from a directory; endpoints without a file answer 404.
+type fixtureSource struct {
+	dir string
+}
+
+func (s fixtureSource) Schema() ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.dir, "swagger-schema.json"))
+}
+
+func (s fixtureSource) Get(path string) (int, []byte, error) {
+	body, err := os.ReadFile(filepath.Join(s.dir, fixtureName(path)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return http.StatusNotFound, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, body, nil
+}
+
+// recorder passes through to another source and saves successful responses
+// as fixtures, so a generation run against the game can be replayed offline.
+type recorder struct {
+	source
+	dir string
+}
+
+func (r recorder) Schema() ([]byte, error) {
+	body, err := r.source.Schema()
+	if err == nil {
+		err = os.WriteFile(filepath.Join(r.dir, "swagger-schema.json"), body, 0o644)
+	}
+	return body, err
+}
+
+func (r recorder) Get(path string) (int, []byte, error) {
+	status, body, err := r.source.Get(path)
+	if err == nil && status == http.StatusOK && len(body) > 0 {
+		err = os.WriteFile(filepath.Join(r.dir, fixtureName(path)), body, 0o644)
+	}
+	return status, body, err
+}
+
+// fixtureName maps an endpoint path to its fixture file name:
+// /rest/watch/standings → rest_watch_standings.json.
+func fixtureName(path string) string {
+	return strings.Trim(nonAlpha.ReplaceAllString(path, "_"), "_") + ".json"
+}
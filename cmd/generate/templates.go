@@ -0,0 +1,162 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// The generated files are rendered from text/template templates:
+//
+//	client.go.tmpl        the Client type, ClientInterface and doRequest (fileData with Interfaces)
+//	metrics.go.tmpl       the optional per-endpoint request metrics (fileData)
+//	group_client.go.tmpl  one group's interface and methods (fileData with Methods)
+//	group_models.go.tmpl  one group's inferred structs (fileData with Structs), with
+//	                      UnmarshalJSON for renamed fields
+//	decode.go.tmpl        the JSON lexer of the generated decoders (fileData)
+//	decode_std.go.tmpl    what replaces it with -tags lmu_stdjson (fileData)
+//	group_decode.go.tmpl  one group's decoders for fastDecode models (fileData with Decoders)
+//
+// -templates names a directory whose *.tmpl files replace the built-in ones
+// of the same name, or add {{define}} blocks they use, so a fork can change
+// the client's shape (contexts, sub-clients, error types) without touching
+// the generator. The generated header is added by the generator, not the
+// templates, so full runs always recognise and clean up their files.
+//
+//go:embed templates/*.tmpl
+var builtinTemplates embed.FS
+
+// fileData is what every template is executed with.
+type fileData struct {
+	Package    string
+	Group      string   // empty for client.go
+	Interface  string   // the group's interface, e.g. WatchAPI
+	Interfaces []string // every group interface, for client.go
+	Imports    []string
+	Methods    []Method
+	Structs    []Struct
+	Decoders   []Decoder
+}
+
+// Method describes one generated endpoint method.
+type Method struct {
+	Name       string
+	Endpoint   Endpoint
+	Params     string       // parameter list, e.g. "id int, body interface{}"
+	Query      []QueryParam // parameters sent in the query string
+	HTTPMethod string
+	Path       string // Go expression for the request path
+	Body       string // "body" or "nil"
+	Return     string // declared return type
+	Result     string // type unmarshalled into; empty for Raw
+	Raw        bool   // returns the response bytes as json.RawMessage
+	Stream     bool   // decoded while read (see streamEndpoints)
+	Decode     string // generated decoder of Result, e.g. decodeSlice; empty for json.Unmarshal
+	Pointer    bool   // returns &result
+	Zero       string // zero value of Return
+}
+
+// QueryParam is a parameter set with url.Values.
+type QueryParam struct {
+	Key    string // name in the query string
+	Var    string // Go parameter name
+	String bool   // strings are only set when non-empty
+}
+
+// Struct is an inferred response type.
+type Struct struct {
+	Name   string
+	Fields []Field
+}
+
+// Field is a struct field; Key is its JSON name. Aliases are keys it is
+// also decoded from (see Rename).
+type Field struct {
+	Name    string
+	Type    string
+	Key     string
+	Aliases []Alias
+}
+
+// Alias is another JSON key of a field; Var names it in the decoding struct.
+type Alias struct {
+	Key string
+	Var string
+}
+
+// hasNumber reports whether a field is a json.Number (see exactNumbers).
+func (s Struct) hasNumber() bool {
+	for _, f := range s.Fields {
+		if f.Type == "json.Number" {
+			return true
+		}
+	}
+	return false
+}
+
+// Compat returns the fields with aliases; the struct gets an UnmarshalJSON
+// when there are any.
+func (s Struct) Compat() []Field {
+	var out []Field
+	for _, f := range s.Fields {
+		if len(f.Aliases) > 0 {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Decoder is a generated decoder of a struct.
+type Decoder struct {
+	Name    string
+	Root    bool   // a fastDecode model, which gets UnmarshalJSON
+	KeysVar string // names the keys, for case-insensitive matches
+	Fields  []DecodeField
+}
+
+// DecodeField is the statement decoding the value of a key.
+type DecodeField struct {
+	Key  string
+	Code string
+}
+
+// loadTemplates parses the built-in templates and then those in dir, if any.
+func loadTemplates(dir string) (*template.Template, error) {
+	t, err := template.ParseFS(builtinTemplates, "templates/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	if dir == "" {
+		return t, nil
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no *.tmpl files in %s", dir)
+	}
+	for _, f := range files {
+		src, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := t.New(filepath.Base(f)).Parse(string(src)); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// render executes the named template and prefixes the generated header.
+func render(t *template.Template, name string, data fileData) (string, error) {
+	var buf strings.Builder
+	buf.WriteString(generatedHeader)
+	if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", fmt.Errorf("template %s: %w", name, err)
+	}
+	return buf.String(), nil
+}
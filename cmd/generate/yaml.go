@@ -0,0 +1,217 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseYAML reads the YAML subset extra-endpoints.yaml needs, without a
+// dependency: block mappings and sequences (including "- key: value"
+// items), plain and quoted scalars, empty flow collections and comments.
+// Scalars come back as strings and null or empty values as nil; anchors,
+// block scalars and multiple documents are rejected.
+func parseYAML(data []byte) (interface{}, error) {
+	var lines []yamlLine
+	for i, text := range strings.Split(string(data), "\n") {
+		text = strings.TrimRight(stripComment(text), " \t\r")
+		if strings.TrimSpace(text) == "" || text == "---" {
+			continue
+		}
+		trimmed := strings.TrimLeft(text, " ")
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("line %d: tabs are not allowed for indentation", i+1)
+		}
+		lines = append(lines, yamlLine{no: i + 1, indent: len(text) - len(trimmed), text: trimmed})
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+	p := &yamlParser{lines: lines}
+	v, err := p.block(lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(lines) {
+		return nil, fmt.Errorf("line %d: unexpected indentation", lines[p.pos].no)
+	}
+	return v, nil
+}
+
+type yamlLine struct {
+	no     int
+	indent int
+	text   string
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// block parses the mapping or sequence starting at the current line.
+func (p *yamlParser) block(indent int) (interface{}, error) {
+	if isSeqItem(p.lines[p.pos].text) {
+		return p.sequence(indent)
+	}
+	return p.mapping(indent)
+}
+
+func (p *yamlParser) sequence(indent int) (interface{}, error) {
+	list := []interface{}{}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent != indent || !isSeqItem(l.text) {
+			break
+		}
+		rest := strings.TrimLeft(strings.TrimPrefix(l.text, "-"), " ")
+		if rest == "" {
+			p.pos++
+			v, err := p.nested(indent)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+			continue
+		}
+		if _, _, ok := splitKey(rest); ok || isSeqItem(rest) {
+			// "- key: value" starts a mapping indented like its first key
+			p.lines[p.pos] = yamlLine{no: l.no, indent: indent + len(l.text) - len(rest), text: rest}
+			v, err := p.block(p.lines[p.pos].indent)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+			continue
+		}
+		v, err := scalar(rest, l.no)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+		p.pos++
+	}
+	return list, nil
+}
+
+func (p *yamlParser) mapping(indent int) (interface{}, error) {
+	m := map[string]interface{}{}
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", l.no)
+		}
+		if isSeqItem(l.text) {
+			return nil, fmt.Errorf("line %d: list item in a mapping", l.no)
+		}
+		key, value, ok := splitKey(l.text)
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key: value", l.no)
+		}
+		k, err := scalar(key, l.no)
+		if err != nil {
+			return nil, err
+		}
+		name, _ := k.(string)
+		if _, dup := m[name]; dup {
+			return nil, fmt.Errorf("line %d: duplicate key %q", l.no, name)
+		}
+		p.pos++
+		if value != "" {
+			if m[name], err = scalar(value, l.no); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		// A sequence may sit at the key's own indentation
+		if p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isSeqItem(p.lines[p.pos].text) {
+			if m[name], err = p.sequence(indent); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if m[name], err = p.nested(indent); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// nested parses the block under a key or dash, nil when there is none.
+func (p *yamlParser) nested(parent int) (interface{}, error) {
+	if p.pos >= len(p.lines) || p.lines[p.pos].indent <= parent {
+		return nil, nil
+	}
+	return p.block(p.lines[p.pos].indent)
+}
+
+func isSeqItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// splitKey splits "key: value" at the first colon outside quotes that is
+// followed by a space or ends the line.
+func splitKey(text string) (key, value string, ok bool) {
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case (c == '"' || c == '\'') && i == 0:
+			quote = c
+		case c == ':' && (i+1 == len(text) || text[i+1] == ' '):
+			return strings.TrimSpace(text[:i]), strings.TrimSpace(text[i+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+// stripComment drops a # comment that is not inside quotes.
+func stripComment(text string) string {
+	var quote byte
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || text[i-1] == ' ' || text[i-1] == '\t'):
+			return text[:i]
+		}
+	}
+	return text
+}
+
+func scalar(text string, line int) (interface{}, error) {
+	switch {
+	case text == "" || text == "~" || text == "null":
+		return nil, nil
+	case text == "[]":
+		return []interface{}{}, nil
+	case text == "{}":
+		return map[string]interface{}{}, nil
+	case strings.HasPrefix(text, `"`):
+		s, err := strconv.Unquote(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: bad quoted string %s", line, text)
+		}
+		return s, nil
+	case strings.HasPrefix(text, "'"):
+		if len(text) < 2 || !strings.HasSuffix(text, "'") {
+			return nil, fmt.Errorf("line %d: bad quoted string %s", line, text)
+		}
+		return strings.ReplaceAll(text[1:len(text)-1], "''", "'"), nil
+	case strings.ContainsAny(text[:1], "[{&*!|>%@`"):
+		return nil, fmt.Errorf("line %d: unsupported YAML %q; quote the value", line, text)
+	}
+	return text, nil
+}
@@ -0,0 +1,269 @@
+// Package export writes recorded sessions in the formats of other sims'
+// tools, so community software built for those formats can read LMU
+// results.
+package export
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib/store"
+)
+
+// noTime is what ACC writes for a lap or split that was not set.
+const noTime = math.MaxInt32
+
+// ACCResults is the layout of the result files an ACC dedicated server
+// writes to results/*.json. Times are in milliseconds.
+type ACCResults struct {
+	SessionType       string           `json:"sessionType"` // "FP", "Q" or "R"
+	TrackName         string           `json:"trackName"`
+	SessionIndex      int              `json:"sessionIndex"`
+	RaceWeekendIndex  int              `json:"raceWeekendIndex"`
+	MetaData          string           `json:"metaData"`
+	ServerName        string           `json:"serverName"`
+	SessionResult     ACCSessionResult `json:"sessionResult"`
+	Laps              []ACCLap         `json:"laps"`
+	Penalties         []ACCPenalty     `json:"penalties"`
+	PostRacePenalties []ACCPenalty     `json:"post_race_penalties"`
+}
+
+type ACCSessionResult struct {
+	BestLap          int                  `json:"bestlap"`
+	BestSplits       []int                `json:"bestSplits"`
+	IsWetSession     int                  `json:"isWetSession"`
+	LeaderBoardLines []ACCLeaderBoardLine `json:"leaderBoardLines"`
+}
+
+type ACCLeaderBoardLine struct {
+	Car                     ACCCar    `json:"car"`
+	CurrentDriver           ACCDriver `json:"currentDriver"`
+	CurrentDriverIndex      int       `json:"currentDriverIndex"`
+	Timing                  ACCTiming `json:"timing"`
+	MissingMandatoryPitstop int       `json:"missingMandatoryPitstop"`
+	DriverTotalTimes        []float64 `json:"driverTotalTimes"`
+}
+
+type ACCCar struct {
+	CarID       int         `json:"carId"`
+	RaceNumber  int         `json:"raceNumber"`
+	CarModel    int         `json:"carModel"`
+	CupCategory int         `json:"cupCategory"`
+	CarGroup    string      `json:"carGroup"`
+	TeamName    string      `json:"teamName"`
+	Nationality int         `json:"nationality"`
+	CarGUID     int         `json:"carGuid"`
+	TeamGUID    int         `json:"teamGuid"`
+	Drivers     []ACCDriver `json:"drivers"`
+}
+
+type ACCDriver struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	ShortName string `json:"shortName"`
+	PlayerID  string `json:"playerId"`
+}
+
+type ACCTiming struct {
+	LastLap     int   `json:"lastLap"`
+	LastSplits  []int `json:"lastSplits"`
+	BestLap     int   `json:"bestLap"`
+	BestSplits  []int `json:"bestSplits"`
+	TotalTime   int   `json:"totalTime"`
+	LapCount    int   `json:"lapCount"`
+	LastSplitID int   `json:"lastSplitId"`
+}
+
+type ACCLap struct {
+	CarID          int   `json:"carId"`
+	DriverIndex    int   `json:"driverIndex"`
+	LapTime        int   `json:"laptime"`
+	IsValidForBest bool  `json:"isValidForBest"`
+	Splits         []int `json:"splits"`
+}
+
+type ACCPenalty struct {
+	CarID          int    `json:"carId"`
+	DriverIndex    int    `json:"driverIndex"`
+	Reason         string `json:"reason"`
+	Penalty        string `json:"penalty"`
+	PenaltyValue   int    `json:"penaltyValue"`
+	ViolationInLap int    `json:"violationInLap"`
+	ClearedInLap   int    `json:"clearedInLap"`
+}
+
+// ACC converts a recorded session into the ACC results layout. Cars are
+// identified by carId 1000 + SlotID; the drivers of a car are everyone who
+// completed a lap in it, in order of their first lap. LMU has no equivalent
+// of ACC's carModel, cupCategory and nationality enums, so they are 0;
+// carGroup is the LMU class name and playerId the lib/identity driver id.
+func ACC(sess store.Session, results []store.Result, laps []store.Lap) ACCResults {
+	out := ACCResults{
+		SessionType: sessionType(sess.Session),
+		TrackName:   sess.Track,
+		MetaData:    sess.Session,
+		ServerName:  sess.Server,
+		SessionResult: ACCSessionResult{
+			BestLap:          noTime,
+			BestSplits:       []int{noTime, noTime, noTime},
+			LeaderBoardLines: []ACCLeaderBoardLine{},
+		},
+		Laps:              []ACCLap{},
+		Penalties:         []ACCPenalty{},
+		PostRacePenalties: []ACCPenalty{},
+	}
+
+	type car struct {
+		drivers []string // driver ids in order
+		names   map[string]string
+		totals  map[string]float64
+		laps    []store.Lap
+	}
+	cars := make(map[int]*car)
+	for _, l := range laps {
+		c, ok := cars[l.SlotID]
+		if !ok {
+			c = &car{names: map[string]string{}, totals: map[string]float64{}}
+			cars[l.SlotID] = c
+		}
+		if _, ok := c.names[l.DriverID]; !ok {
+			c.drivers = append(c.drivers, l.DriverID)
+			c.names[l.DriverID] = l.Driver
+		}
+		c.totals[l.DriverID] += l.LapTime
+		c.laps = append(c.laps, l)
+	}
+
+	best := &out.SessionResult
+	for _, l := range laps {
+		c := cars[l.SlotID]
+		valid := l.LapTime > 0 && !l.Pit && l.Invalid == ""
+		splits := []int{ms(l.S1), ms(l.S2), ms(l.S3)}
+		out.Laps = append(out.Laps, ACCLap{
+			CarID:          carID(l.SlotID),
+			DriverIndex:    indexOf(c.drivers, l.DriverID),
+			LapTime:        ms(l.LapTime),
+			IsValidForBest: valid,
+			Splits:         splits,
+		})
+		if valid {
+			best.BestLap = min(best.BestLap, ms(l.LapTime))
+			for i, s := range splits {
+				if s > 0 {
+					best.BestSplits[i] = min(best.BestSplits[i], s)
+				}
+			}
+		}
+	}
+
+	for _, r := range results {
+		c := cars[r.SlotID]
+		if c == nil {
+			c = &car{drivers: []string{r.DriverID}, names: map[string]string{r.DriverID: r.Driver}, totals: map[string]float64{}}
+		}
+		if _, ok := c.names[r.DriverID]; !ok {
+			c.drivers = append(c.drivers, r.DriverID)
+			c.names[r.DriverID] = r.Driver
+		}
+		line := ACCLeaderBoardLine{
+			Car: ACCCar{
+				CarID:      carID(r.SlotID),
+				RaceNumber: raceNumber(r.CarNumber),
+				CarGroup:   r.CarClass,
+				TeamName:   r.Car,
+				CarGUID:    -1,
+				TeamGUID:   -1,
+			},
+			CurrentDriverIndex: indexOf(c.drivers, r.DriverID),
+			Timing: ACCTiming{
+				LastLap:    noTime,
+				LastSplits: []int{},
+				BestLap:    noTime,
+				BestSplits: []int{noTime, noTime, noTime},
+				LapCount:   r.Laps,
+			},
+		}
+		for _, id := range c.drivers {
+			line.Car.Drivers = append(line.Car.Drivers, driver(c.names[id], id))
+			line.DriverTotalTimes = append(line.DriverTotalTimes, c.totals[id]*1000)
+		}
+		line.CurrentDriver = line.Car.Drivers[line.CurrentDriverIndex]
+		t := &line.Timing
+		for _, l := range c.laps {
+			t.TotalTime += ms(l.LapTime)
+			if l.LapTime > 0 && !l.Pit && l.Invalid == "" && ms(l.LapTime) < t.BestLap {
+				t.BestLap = ms(l.LapTime)
+				t.BestSplits = []int{ms(l.S1), ms(l.S2), ms(l.S3)}
+			}
+		}
+		if n := len(c.laps); n > 0 {
+			l := c.laps[n-1]
+			t.LastLap = ms(l.LapTime)
+			t.LastSplits = []int{ms(l.S1), ms(l.S2), ms(l.S3)}
+		}
+		if t.BestLap == noTime && r.BestLap > 0 {
+			t.BestLap = ms(r.BestLap)
+		}
+		best.LeaderBoardLines = append(best.LeaderBoardLines, line)
+	}
+	return out
+}
+
+// FileName is the name an ACC server would give the session's results
+// file, e.g. 250614_200000_R.json.
+func FileName(sess store.Session) string {
+	return sess.StartedAt.Format("060102_150405") + "_" + sessionType(sess.Session) + ".json"
+}
+
+func sessionType(session string) string {
+	s := strings.ToUpper(session)
+	switch {
+	case strings.Contains(s, "RACE"):
+		return "R"
+	case strings.Contains(s, "QUALIFY"):
+		return "Q"
+	}
+	return "FP"
+}
+
+func carID(slot int) int { return 1000 + slot }
+
+// raceNumber returns the digits of a car number, 0 when it has none.
+func raceNumber(number string) int {
+	n, _ := strconv.Atoi(strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, number))
+	return n
+}
+
+func driver(name, id string) ACCDriver {
+	first, last := "", strings.TrimSpace(name)
+	if i := strings.LastIndex(last, " "); i > 0 {
+		first, last = last[:i], last[i+1:]
+	}
+	short := strings.ToUpper(last)
+	if r := []rune(short); len(r) > 3 {
+		short = string(r[:3])
+	}
+	return ACCDriver{FirstName: first, LastName: last, ShortName: short, PlayerID: id}
+}
+
+func indexOf(ids []string, id string) int {
+	for i, v := range ids {
+		if v == id {
+			return i
+		}
+	}
+	return 0
+}
+
+func ms(sec float64) int {
+	if sec <= 0 {
+		return 0
+	}
+	return int(math.Round(sec * 1000))
+}
@@ -0,0 +1,129 @@
+// Package pit turns short action names from hotkeys, button boxes and
+// control surfaces ("fuel+10", "tyres") into pit menu changes, shared by
+// lmu pit and cmd/deck.
+package pit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Usage describes the actions, for help texts.
+const Usage = `  fuel+N, fuel-N      add or take away N litres of fuel
+  energy+N, energy-N  add or take away N percent of virtual energy
+  tyres               toggle the tyre change on and off
+  damage              toggle the damage repair on and off
+  request             not available: the game has no REST call for it
+`
+
+// ErrNoRequest is returned for the request action.
+var ErrNoRequest = errors.New("the game's REST API cannot request a pit stop; bind the game's Request Pit control on the button box instead")
+
+// Control applies actions one at a time to the menu the game has at the
+// moment. It is safe for concurrent use.
+type Control struct {
+	mu     sync.Mutex
+	client *lib.Client
+	// lastTyres and lastFix are the settings a toggle switches back on,
+	// -1 before the first toggle.
+	lastTyres, lastFix int
+}
+
+// New returns a Control for the game behind client.
+func New(client *lib.Client) *Control {
+	return &Control{client: client, lastTyres: -1, lastFix: -1}
+}
+
+// Menu returns the pit menu.
+func (c *Control) Menu() (lib.PitMenu, error) {
+	return c.client.PitMenu()
+}
+
+// Do applies one action and returns the menu line it changed, as the game
+// now has it.
+func (c *Control) Do(action string) (lib.PitMenuItem, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	action = strings.ToLower(strings.TrimSpace(action))
+	if action == "request" {
+		return lib.PitMenuItem{}, ErrNoRequest
+	}
+	menu, err := c.client.PitMenu()
+	if err != nil {
+		return lib.PitMenuItem{}, err
+	}
+
+	var it *lib.PitMenuItem
+	switch name, amount, sign := split(action); {
+	case name == "fuel" || name == "energy":
+		if sign == 0 {
+			return lib.PitMenuItem{}, fmt.Errorf("%s: want %s+N or %s-N", action, name, name)
+		}
+		if name == "energy" {
+			name = "virtual energy"
+		}
+		if it = menu.Item(name); it == nil {
+			return lib.PitMenuItem{}, fmt.Errorf("the pit menu has no %s", strings.ToUpper(name))
+		}
+		it.Add(sign * amount)
+	case action == "tyres" || action == "tires":
+		if it = menu.Item("tires"); it == nil {
+			return lib.PitMenuItem{}, fmt.Errorf("the pit menu has no TIRES")
+		}
+		toggle(it, &c.lastTyres)
+	case action == "damage":
+		if it = menu.Item("damage"); it == nil {
+			return lib.PitMenuItem{}, fmt.Errorf("the pit menu has no DAMAGE")
+		}
+		toggle(it, &c.lastFix)
+	default:
+		return lib.PitMenuItem{}, fmt.Errorf("unknown pit action %q", action)
+	}
+	if err := c.client.SetPitMenu(menu); err != nil {
+		return lib.PitMenuItem{}, err
+	}
+	return *it, nil
+}
+
+// split splits "fuel+10" into fuel, 10 and +1; sign is 0 without an amount.
+func split(action string) (name string, amount, sign float64) {
+	i := strings.IndexAny(action, "+-")
+	if i < 0 {
+		return action, 0, 0
+	}
+	v, err := strconv.ParseFloat(action[i+1:], 64)
+	if err != nil {
+		return action, 0, 0
+	}
+	sign = 1
+	if action[i] == '-' {
+		sign = -1
+	}
+	return action[:i], v, sign
+}
+
+// toggle switches a setting between "No Change" and the setting it had
+// before, or the first other one.
+func toggle(it *lib.PitMenuItem, last *int) {
+	if len(it.Settings) < 2 {
+		return
+	}
+	off := it.NoChange()
+	if off < 0 {
+		off = 0
+	}
+	if it.CurrentSetting != off {
+		*last, it.CurrentSetting = it.CurrentSetting, off
+		return
+	}
+	on := *last
+	if on == off || on < 0 || on >= len(it.Settings) {
+		on = (off + 1) % len(it.Settings)
+	}
+	it.CurrentSetting = on
+}
@@ -0,0 +1,100 @@
+package run
+
+import (
+	"crypto/subtle"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Access guards a server a command exposes beyond the machine it runs on,
+// e.g. on a LAN for spectators. With a Token every request must carry it,
+// as "Authorization: Bearer <token>" or as ?token=, which also sets a
+// cookie so a browser keeps access to a page and what the page loads.
+// With ReadOnly only GET, HEAD and OPTIONS requests pass, so spectators can
+// watch but not change the pit menu, the camera or anything else in the
+// game. /healthz and /readyz stay open for supervisors.
+type Access struct {
+	Token    string
+	ReadOnly bool
+}
+
+// ErrReadOnly is the answer to a request a read-only server refuses.
+var ErrReadOnly = errors.New("read-only: this server does not change anything in the game")
+
+const accessCookie = "lmu_token"
+
+// AddAccessFlags adds -token and -read-only to fs.
+func AddAccessFlags(fs *flag.FlagSet) *Access {
+	a := &Access{}
+	fs.StringVar(&a.Token, "token", os.Getenv("LMU_ACCESS_TOKEN"), "Token clients must send, as a bearer token or ?token= (default $LMU_ACCESS_TOKEN)")
+	fs.BoolVar(&a.ReadOnly, "read-only", false, "Refuse requests that would change anything in the game, such as pit menu and camera actions")
+	return a
+}
+
+// Guard makes the servers Mux starts from now on check a.
+func (l *Loop) Guard(a *Access) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.access = a
+}
+
+// Handler returns h behind the checks, or h itself when there is nothing
+// to check.
+func (a *Access) Handler(h http.Handler) http.Handler {
+	if a == nil || a.Token == "" && !a.ReadOnly {
+		return h
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" || r.URL.Path == "/readyz" {
+			h.ServeHTTP(w, r)
+			return
+		}
+		if a.Token != "" {
+			ok, fromQuery := a.authorized(r)
+			if !ok {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="lmu"`)
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			if fromQuery {
+				http.SetCookie(w, &http.Cookie{Name: accessCookie, Value: a.Token, Path: "/", HttpOnly: true, SameSite: http.SameSiteStrictMode})
+			}
+			// Requests passed on, e.g. to the game or a log, do not carry it
+			r = r.Clone(r.Context())
+			r.Header.Del("Authorization")
+			q := r.URL.Query()
+			if q.Has("token") {
+				q.Del("token")
+				r.URL.RawQuery = q.Encode()
+			}
+		}
+		if a.ReadOnly && !safeMethod(r.Method) {
+			http.Error(w, ErrReadOnly.Error(), http.StatusForbidden)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// authorized reports whether r carries the token, and whether it came as
+// the query parameter. Tokens are compared in constant time.
+func (a *Access) authorized(r *http.Request) (ok, fromQuery bool) {
+	match := func(s string) bool { return subtle.ConstantTimeCompare([]byte(s), []byte(a.Token)) == 1 }
+	if s, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); found && match(s) {
+		return true, false
+	}
+	if c, err := r.Cookie(accessCookie); err == nil && match(c.Value) {
+		return true, false
+	}
+	if s := r.URL.Query().Get("token"); s != "" && match(s) {
+		return true, true
+	}
+	return false, false
+}
+
+func safeMethod(m string) bool {
+	return m == http.MethodGet || m == http.MethodHead || m == http.MethodOptions
+}
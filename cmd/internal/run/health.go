@@ -0,0 +1,69 @@
+package run
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// Mux returns the handler of the HTTP server at addr, starting the server
+// with Serve on first use, so several features (metrics, health) can share
+// one address. The server checks the Access set with Guard.
+func (l *Loop) Mux(addr string) *http.ServeMux {
+	l.mu.Lock()
+	access := l.access
+	mux, ok := l.muxes[addr]
+	if !ok {
+		if l.muxes == nil {
+			l.muxes = make(map[string]*http.ServeMux)
+		}
+		mux = http.NewServeMux()
+		l.muxes[addr] = mux
+	}
+	l.mu.Unlock()
+	if !ok {
+		l.Serve(&http.Server{Addr: addr, Handler: access.Handler(mux)})
+	}
+	return mux
+}
+
+// Health adds the endpoints a supervisor (systemd, Docker, Kubernetes)
+// checks to mux. /healthz answers 200 while the process runs. /readyz
+// answers 200 while brk is connected to the game and a poll succeeded
+// within maxAge, and 503 with the reason otherwise.
+func Health(mux *http.ServeMux, brk *watch.Breaker, maxAge time.Duration) {
+	mux.HandleFunc("/healthz", alive)
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		if err := ready(brk.Health(), maxAge, time.Now()); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, "ok")
+	})
+}
+
+// Alive adds /healthz and /readyz to mux for a server that does not
+// depend on the game, e.g. the setup sync server: both answer 200 while the
+// process runs.
+func Alive(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", alive)
+	mux.HandleFunc("/readyz", alive)
+}
+
+func alive(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprintln(w, "ok")
+}
+
+func ready(h watch.Health, maxAge time.Duration, now time.Time) error {
+	switch {
+	case !h.Connected:
+		return fmt.Errorf("disconnected since %s: %v", h.Since.Format(time.RFC3339), h.Err)
+	case h.LastOK.IsZero():
+		return fmt.Errorf("no data yet")
+	case now.Sub(h.LastOK) > maxAge:
+		return fmt.Errorf("no fresh data for %s", now.Sub(h.LastOK).Round(time.Second))
+	}
+	return nil
+}
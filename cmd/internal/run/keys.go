@@ -0,0 +1,88 @@
+package run
+
+import (
+	"os"
+	"unicode/utf8"
+)
+
+// Key is a key pressed in the terminal: a character as itself, or one of
+// the named keys below.
+type Key string
+
+const (
+	KeyUp    Key = "up"
+	KeyDown  Key = "down"
+	KeyEnter Key = "enter"
+	KeyEsc   Key = "esc"
+)
+
+// Keys switches the terminal to reading single key presses without echo
+// and returns them, restoring the terminal when the loop stops. Every key
+// press wakes Poll (see Wake), so the display can follow it at once. Ctrl+C
+// still stops the loop. Keys returns nil when stdin is not a terminal.
+func (l *Loop) Keys() <-chan Key {
+	restore, err := rawInput()
+	if err != nil {
+		return nil
+	}
+	l.Defer(restore)
+	ch := make(chan Key, 16)
+	go func() {
+		buf := make([]byte, 64)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if err != nil {
+				return
+			}
+			for _, k := range decodeKeys(buf[:n]) {
+				select {
+				case ch <- k:
+				default:
+					// nobody reads fast enough to keep up; drop it
+				}
+			}
+			l.Wake()
+		}
+	}()
+	return ch
+}
+
+// decodeKeys splits one read from the terminal into keys. An escape
+// sequence arrives in one read, a lone escape is the Esc key; sequences
+// other than the arrows are dropped.
+func decodeKeys(b []byte) []Key {
+	var out []Key
+	for len(b) > 0 {
+		switch {
+		case b[0] == 0x1b && len(b) >= 3 && (b[1] == '[' || b[1] == 'O'):
+			// CSI: parameter and intermediate bytes, then the final byte
+			i := 2
+			for i < len(b) && b[i] >= 0x20 && b[i] <= 0x3f {
+				i++
+			}
+			if i < len(b) {
+				switch b[i] {
+				case 'A':
+					out = append(out, KeyUp)
+				case 'B':
+					out = append(out, KeyDown)
+				}
+				i++
+			}
+			b = b[i:]
+		case b[0] == 0x1b:
+			out = append(out, KeyEsc)
+			b = b[1:]
+		case b[0] == '\r' || b[0] == '\n':
+			out = append(out, KeyEnter)
+			b = b[1:]
+		default:
+			r, n := utf8.DecodeRune(b)
+			if r != utf8.RuneError && r >= 0x20 {
+				out = append(out, Key(string(r)))
+			}
+			b = b[n:]
+		}
+	}
+	return out
+}
@@ -0,0 +1,32 @@
+//go:build !windows
+
+package run
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// rawInput turns off line buffering and echo with stty, leaving signals on.
+func rawInput() (restore func() error, err error) {
+	saved, err := stty("-g")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stty("-icanon", "-echo", "min", "1"); err != nil {
+		return nil, err
+	}
+	return func() error {
+		_, err := stty(strings.TrimSpace(saved))
+		return err
+	}, nil
+}
+
+// stty runs stty on the terminal at stdin.
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return string(out), err
+}
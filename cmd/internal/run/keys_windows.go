@@ -0,0 +1,22 @@
+package run
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// rawInput turns off line input and echo on the console, leaving Ctrl+C
+// on, and has it send the arrow keys as escape sequences.
+func rawInput() (restore func() error, err error) {
+	h := windows.Handle(os.Stdin.Fd())
+	var mode uint32
+	if err := windows.GetConsoleMode(h, &mode); err != nil {
+		return nil, err
+	}
+	raw := mode&^(windows.ENABLE_LINE_INPUT|windows.ENABLE_ECHO_INPUT) | windows.ENABLE_VIRTUAL_TERMINAL_INPUT
+	if err := windows.SetConsoleMode(h, raw); err != nil {
+		return nil, err
+	}
+	return func() error { return windows.SetConsoleMode(h, mode) }, nil
+}
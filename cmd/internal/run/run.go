@@ -0,0 +1,180 @@
+// Package run is the run loop shared by the long-running commands. It polls
+// on an interval until SIGINT or SIGTERM and then runs the command's
+// cleanups (flushing files, closing sockets, restoring the terminal) before
+// returning, so Ctrl+C, systemd and docker stop all leave the same state
+// behind. A second signal exits at once.
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// AdaptiveUsage is the help text of the -adaptive flag of the commands that
+// set a Pace.
+const AdaptiveUsage = "Poll every 250ms with cars on track, every second in a waiting session and every 5s in the menus instead of every -interval"
+
+// Loop runs a command until it is stopped.
+type Loop struct {
+	Interval time.Duration
+	// Pace, when set, replaces Interval with the interval for the game's
+	// activity reported to Adapt.
+	Pace *watch.Pace
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	mu       sync.Mutex
+	cleanups []func() error
+	err      error // from a server started with Serve
+	muxes    map[string]*http.ServeMux
+	access   *Access // checked by the servers Mux starts
+	wake     chan struct{}
+}
+
+// New returns a loop polling every interval, stopped by the first SIGINT or
+// SIGTERM.
+func New(interval time.Duration) *Loop {
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &Loop{Interval: interval, ctx: ctx, cancel: cancel, wake: make(chan struct{}, 1)}
+	sig := make(chan os.Signal, 2)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		cancel()
+		<-sig
+		l.restore()
+		os.Exit(130)
+	}()
+	return l
+}
+
+// Context is cancelled when the loop stops; pass it to work that should end
+// with the command.
+func (l *Loop) Context() context.Context { return l.ctx }
+
+// Stop ends the loop as a signal would.
+func (l *Loop) Stop() { l.cancel() }
+
+// Defer registers a cleanup. Cleanups run once the loop stops, in reverse
+// order like defer, and their errors are returned by Poll and Wait.
+func (l *Loop) Defer(f func() error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cleanups = append(l.cleanups, f)
+}
+
+// Terminal clears the screen and hides the cursor for a full-screen
+// display, and shows the cursor again when the loop stops.
+func (l *Loop) Terminal() {
+	fmt.Print("\033[2J\033[?25l")
+	l.Defer(func() error {
+		fmt.Print("\033[?25h\n")
+		return nil
+	})
+}
+
+// Wake makes Poll call its function now rather than at the next tick,
+// e.g. to redraw after a key press. The tick stays where it was; a function
+// that should not poll the game on every wake-up tells them apart by the
+// time since its last poll.
+func (l *Loop) Wake() {
+	select {
+	case l.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Serve runs srv in the background and shuts it down gracefully when the
+// loop stops, letting requests in flight finish for up to five seconds.
+// A server that fails, e.g. because its address is taken, stops the loop
+// and its error is returned by Poll or Wait. A server with certificates in
+// its TLSConfig serves HTTPS.
+func (l *Loop) Serve(srv *http.Server) {
+	go func() {
+		var err error
+		if srv.TLSConfig != nil && len(srv.TLSConfig.Certificates) > 0 {
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			l.mu.Lock()
+			l.err = errors.Join(l.err, fmt.Errorf("%s: %w", srv.Addr, err))
+			l.mu.Unlock()
+			l.Stop()
+		}
+	}()
+	l.Defer(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(ctx)
+	})
+}
+
+// Adapt sets the interval for the game's activity when the loop has a Pace;
+// call it from the poll function with each frame's activity.
+func (l *Loop) Adapt(a watch.Activity) {
+	if l.Pace != nil {
+		l.Interval = l.Pace.Interval(a)
+	}
+}
+
+// Poll calls fn right away and then every Interval, measured from the start
+// of each call, and in between when woken, until the loop stops or fn
+// returns an error. It then runs the cleanups. Stopping is not an error.
+func (l *Loop) Poll(fn func(now time.Time) error) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	var err error
+	for err == nil {
+		select {
+		case <-l.ctx.Done():
+			return l.finish(nil)
+		case now := <-timer.C:
+			err = fn(now)
+			timer.Reset(max(0, l.Interval-time.Since(now)))
+		case <-l.wake:
+			err = fn(time.Now())
+		}
+	}
+	return l.finish(err)
+}
+
+// Wait blocks until the loop stops and runs the cleanups, for commands that
+// serve rather than poll.
+func (l *Loop) Wait() error {
+	<-l.ctx.Done()
+	return l.finish(nil)
+}
+
+func (l *Loop) finish(err error) error {
+	l.cancel()
+	err = errors.Join(err, l.restore())
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return errors.Join(l.err, err)
+}
+
+// restore runs the cleanups not run yet.
+func (l *Loop) restore() error {
+	l.mu.Lock()
+	list := l.cleanups
+	l.cleanups = nil
+	l.mu.Unlock()
+	var errs []error
+	for i := len(list) - 1; i >= 0; i-- {
+		if err := list[i](); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
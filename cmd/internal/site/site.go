@@ -0,0 +1,358 @@
+// Package site turns archived session results into a static website: one
+// page per session, an index and an Atom feed, so a league can publish its
+// race summaries from the results directory without a server.
+//
+// The archive is a directory of ACC server results files (see
+// cmd/internal/export), as written by lmu export or by tools reading the
+// same layout.
+package site
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/export"
+	"github.com/snipem/go-lmu-api/lib/carcolor"
+)
+
+// Session is one archived results file.
+type Session struct {
+	// Name is the file name without extension; the session's page is
+	// Name.html.
+	Name    string
+	Date    time.Time
+	Results export.ACCResults
+}
+
+// Title reads e.g. "Circuit de la Sarthe – Race".
+func (s Session) Title() string {
+	kind := map[string]string{"R": "Race", "Q": "Qualifying"}[s.Results.SessionType]
+	if kind == "" {
+		kind = "Practice"
+	}
+	return s.Results.TrackName + " – " + kind
+}
+
+// accName matches the names ACC servers give their results files,
+// YYMMDD_HHMMSS_R.json, which carry the session's date.
+const accName = "060102_150405"
+
+// Load reads every results file in dir, newest first. The date comes from
+// the file name when it follows the ACC server's pattern, else from the
+// file's modification time.
+func Load(dir string) ([]Session, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	var out []Session
+	for _, path := range names {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		s := Session{Name: strings.TrimSuffix(filepath.Base(path), ".json")}
+		if err := json.Unmarshal(data, &s.Results); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if len(s.Name) >= len(accName) {
+			s.Date, _ = time.ParseInLocation(accName, s.Name[:len(accName)], time.Local)
+		}
+		if s.Date.IsZero() {
+			fi, err := os.Stat(path)
+			if err != nil {
+				return nil, err
+			}
+			s.Date = fi.ModTime()
+		}
+		out = append(out, s)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Date.After(out[j].Date) })
+	return out, nil
+}
+
+// Options are the site's settings.
+type Options struct {
+	Title string
+	// BaseURL is where the site is published, e.g.
+	// "https://league.example/results/". Feed readers need absolute links;
+	// without it the feed links are relative.
+	BaseURL string
+	// Colors marks each car number with the car's color (see lib/carcolor);
+	// nil leaves them plain.
+	Colors *carcolor.Book
+}
+
+// Write writes index.html, feed.xml and a page per session to dir.
+func Write(dir string, sessions []Session, opts Options) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, s := range sessions {
+		if err := writeTemplate(filepath.Join(dir, s.Name+".html"), sessionPage, pageData{Site: opts, Session: s, Rows: rows(s.Results, opts.Colors)}); err != nil {
+			return err
+		}
+	}
+	if err := writeTemplate(filepath.Join(dir, "index.html"), indexPage, pageData{Site: opts, Sessions: sessions}); err != nil {
+		return err
+	}
+	data, err := xml.MarshalIndent(feed(sessions, opts), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "feed.xml"), append([]byte(xml.Header), data...), 0o644)
+}
+
+func writeTemplate(path string, t *template.Template, data pageData) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := t.Execute(f, data); err != nil {
+		f.Close()
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return f.Close()
+}
+
+// Summary is a one-line account of a session for the index and the feed,
+// e.g. "Won by #7 Toyota (Kamui Kobayashi / Mike Conway), 36 cars. Fastest
+// lap 3:24.512 by #50 Ferrari."
+func (s Session) Summary() string {
+	lines := s.Results.SessionResult.LeaderBoardLines
+	if len(lines) == 0 {
+		return "No classification."
+	}
+	verb := "Won by"
+	if s.Results.SessionType != "R" {
+		verb = "Fastest:"
+	}
+	out := fmt.Sprintf("%s %s, %d cars.", verb, carName(lines[0]), len(lines))
+	if s.Results.SessionType == "R" {
+		best := -1
+		for i, l := range lines {
+			if b := l.Timing.BestLap; b > 0 && b < noTime && (best < 0 || b < lines[best].Timing.BestLap) {
+				best = i
+			}
+		}
+		if best >= 0 {
+			out += fmt.Sprintf(" Fastest lap %s by #%d %s.", lapTime(lines[best].Timing.BestLap), lines[best].Car.RaceNumber, lines[best].Car.TeamName)
+		}
+	}
+	return out
+}
+
+func carName(l export.ACCLeaderBoardLine) string {
+	return fmt.Sprintf("#%d %s (%s)", l.Car.RaceNumber, l.Car.TeamName, drivers(l))
+}
+
+func drivers(l export.ACCLeaderBoardLine) string {
+	names := make([]string, 0, len(l.Car.Drivers))
+	for _, d := range l.Car.Drivers {
+		names = append(names, strings.TrimSpace(d.FirstName+" "+d.LastName))
+	}
+	return strings.Join(names, " / ")
+}
+
+// row is a line of a session page's classification.
+type row struct {
+	Pos, ClassPos int
+	Number        int
+	Class, Car    string
+	Drivers       string
+	Laps          int
+	Best, Gap     string
+	Fastest       bool   // best lap of the class
+	Color         string // the car's color, empty without Options.Colors
+}
+
+// noTime is ACC's "not set" for lap times.
+const noTime = 1<<31 - 1
+
+func rows(r export.ACCResults, colors *carcolor.Book) []row {
+	lines := r.SessionResult.LeaderBoardLines
+	classPos := map[string]int{}
+	classBest := map[string]int{}
+	for _, l := range lines {
+		if b := l.Timing.BestLap; b > 0 && b < noTime && (classBest[l.Car.CarGroup] == 0 || b < classBest[l.Car.CarGroup]) {
+			classBest[l.Car.CarGroup] = b
+		}
+	}
+	out := make([]row, 0, len(lines))
+	for i, l := range lines {
+		classPos[l.Car.CarGroup]++
+		rw := row{
+			Pos:      i + 1,
+			ClassPos: classPos[l.Car.CarGroup],
+			Number:   l.Car.RaceNumber,
+			Class:    l.Car.CarGroup,
+			Car:      l.Car.TeamName,
+			Drivers:  drivers(l),
+			Laps:     l.Timing.LapCount,
+			Best:     lapTime(l.Timing.BestLap),
+			Fastest:  l.Timing.BestLap > 0 && l.Timing.BestLap == classBest[l.Car.CarGroup],
+		}
+		if colors != nil {
+			rw.Color = colors.Get(strconv.Itoa(l.Car.RaceNumber)).Hex
+		}
+		if i > 0 {
+			rw.Gap = gap(r.SessionType, lines[0].Timing, l.Timing)
+		}
+		out = append(out, rw)
+	}
+	return out
+}
+
+// gap is the difference to the winner: laps down or time in races, best
+// lap otherwise.
+func gap(sessionType string, winner, t export.ACCTiming) string {
+	if sessionType != "R" {
+		if t.BestLap <= 0 || t.BestLap >= noTime || winner.BestLap >= noTime {
+			return ""
+		}
+		return "+" + seconds(t.BestLap-winner.BestLap)
+	}
+	if d := winner.LapCount - t.LapCount; d > 0 {
+		if d == 1 {
+			return "+1 lap"
+		}
+		return fmt.Sprintf("+%d laps", d)
+	}
+	if t.TotalTime <= 0 || winner.TotalTime <= 0 {
+		return ""
+	}
+	return "+" + seconds(t.TotalTime-winner.TotalTime)
+}
+
+// lapTime formats milliseconds as m:ss.mmm.
+func lapTime(ms int) string {
+	if ms <= 0 || ms >= noTime {
+		return ""
+	}
+	return fmt.Sprintf("%d:%02d.%03d", ms/60000, ms/1000%60, ms%1000)
+}
+
+func seconds(ms int) string {
+	if ms >= 60000 {
+		return lapTime(ms)
+	}
+	return fmt.Sprintf("%d.%03d", ms/1000, ms%1000)
+}
+
+type pageData struct {
+	Site     Options
+	Session  Session
+	Sessions []Session
+	Rows     []row
+}
+
+const style = `<style>
+body { font-family: system-ui, sans-serif; margin: 2em auto; max-width: 60em; padding: 0 1em; color: #222; }
+table { border-collapse: collapse; width: 100%; }
+th, td { padding: .3em .6em; text-align: left; border-bottom: 1px solid #ddd; }
+td.n { text-align: right; font-variant-numeric: tabular-nums; }
+.fastest { color: #8a2be2; font-weight: bold; }
+.meta { color: #666; }
+.car { display: inline-block; width: .7em; height: .7em; margin-right: .4em; border-radius: 2px; }
+</style>`
+
+var indexPage = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Site.Title}}</title>
+<link rel="alternate" type="application/atom+xml" title="{{.Site.Title}}" href="feed.xml">
+` + style + `
+</head>
+<body>
+<h1>{{.Site.Title}}</h1>
+<ul>
+{{range .Sessions}}<li><a href="{{.Name}}.html">{{.Title}}</a> <span class="meta">{{.Date.Format "2 Jan 2006 15:04"}}</span><br>{{.Summary}}</li>
+{{end}}</ul>
+</body>
+</html>
+`))
+
+var sessionPage = template.Must(template.New("session").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Session.Title}} – {{.Site.Title}}</title>
+` + style + `
+</head>
+<body>
+<p><a href="index.html">{{.Site.Title}}</a></p>
+<h1>{{.Session.Title}}</h1>
+<p class="meta">{{.Session.Date.Format "Monday, 2 January 2006 15:04"}}{{with .Session.Results.ServerName}} · {{.}}{{end}}</p>
+<p>{{.Session.Summary}}</p>
+<table>
+<tr><th>Pos</th><th>Class</th><th>#</th><th>Car</th><th>Drivers</th><th>Laps</th><th>Best</th><th>Gap</th></tr>
+{{range .Rows}}<tr><td class="n">{{.Pos}}</td><td>{{.Class}} {{.ClassPos}}</td><td class="n">{{with .Color}}<span class="car" style="background: {{.}}"></span>{{end}}{{.Number}}</td><td>{{.Car}}</td><td>{{.Drivers}}</td><td class="n">{{.Laps}}</td><td class="n{{if .Fastest}} fastest{{end}}">{{.Best}}</td><td class="n">{{.Gap}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	Title   string   `xml:"title"`
+	ID      string   `xml:"id"`
+	Updated string   `xml:"updated"`
+	Link    atomLink `xml:"link"`
+	Summary string   `xml:"summary"`
+}
+
+func feed(sessions []Session, opts Options) atomFeed {
+	base := opts.BaseURL
+	if base != "" && !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+	f := atomFeed{
+		Title:  opts.Title,
+		ID:     base + "feed.xml",
+		Author: atomAuthor{Name: opts.Title},
+		Links:  []atomLink{{Rel: "self", Href: base + "feed.xml"}, {Href: base + "index.html"}},
+	}
+	if len(sessions) > 0 {
+		f.Updated = sessions[0].Date.UTC().Format(time.RFC3339)
+	} else {
+		f.Updated = time.Now().UTC().Format(time.RFC3339)
+	}
+	for _, s := range sessions {
+		url := base + s.Name + ".html"
+		f.Entries = append(f.Entries, atomEntry{
+			Title:   s.Title(),
+			ID:      url,
+			Updated: s.Date.UTC().Format(time.RFC3339),
+			Link:    atomLink{Href: url},
+			Summary: s.Summary(),
+		})
+	}
+	return f
+}
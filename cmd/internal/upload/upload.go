@@ -0,0 +1,256 @@
+// Package upload sends results files to a league's results API: one HTTP
+// POST per file, with headers rendered from templates so a bearer token,
+// basic auth or a signature of the body can come from the config or the
+// environment. Files wait in a queue directory until the server took them,
+// so results of a session raced offline go out on the next run.
+//
+// Header templates are text/template strings executed with a Request and
+// these functions:
+//
+//	env NAME          the environment variable NAME
+//	base64 TEXT       TEXT in standard base64, e.g. for basic auth
+//	hmac KEY TEXT     the hex HMAC-SHA256 of TEXT with KEY
+//
+// For example {"Authorization": "Bearer {{env \"LEAGUE_TOKEN\"}}"} or
+// {"X-Signature": "{{hmac (env \"LEAGUE_SECRET\") .Body}}"}.
+package upload
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib/config"
+)
+
+// Request is what header templates are executed with.
+type Request struct {
+	Name string // the file name, e.g. 250614_200000_R.json
+	Body string
+}
+
+// Backoff bounds the wait between attempts while the server cannot be
+// reached.
+const (
+	minBackoff = 30 * time.Second
+	maxBackoff = 10 * time.Minute
+)
+
+// rejectedDir is the queue's subdirectory for files the server refused.
+const rejectedDir = "rejected"
+
+// Uploader sends the files in its queue directory. It is safe for
+// concurrent use.
+type Uploader struct {
+	URL    string
+	Dir    string
+	Client *http.Client
+
+	headers map[string]*template.Template
+
+	mu      sync.Mutex // one flush at a time
+	retryAt time.Time
+	backoff time.Duration
+	kick    chan struct{}
+}
+
+var funcs = template.FuncMap{
+	"env":    os.Getenv,
+	"base64": func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+	"hmac": func(key, text string) string {
+		m := hmac.New(sha256.New, []byte(key))
+		m.Write([]byte(text))
+		return hex.EncodeToString(m.Sum(nil))
+	},
+}
+
+// New returns an uploader for the config. defaultDir is the queue when the
+// config names none.
+func New(cfg config.Upload, defaultDir string) (*Uploader, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("upload needs a URL, set upload.url in the config")
+	}
+	u := &Uploader{
+		URL:     cfg.URL,
+		Dir:     cfg.Queue,
+		Client:  &http.Client{Timeout: 30 * time.Second},
+		headers: map[string]*template.Template{},
+		kick:    make(chan struct{}, 1),
+	}
+	if u.Dir == "" {
+		u.Dir = defaultDir
+	}
+	for k, v := range cfg.Headers {
+		t, err := template.New(k).Funcs(funcs).Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("upload header %s: %w", k, err)
+		}
+		u.headers[k] = t
+	}
+	return u, nil
+}
+
+// Add queues a file for upload under name, replacing a queued file of the
+// same name.
+func (u *Uploader) Add(name string, data []byte) error {
+	if err := os.MkdirAll(u.Dir, 0o755); err != nil {
+		return err
+	}
+	tmp := filepath.Join(u.Dir, "."+name+".tmp")
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filepath.Join(u.Dir, name)); err != nil {
+		return err
+	}
+	u.Kick()
+	return nil
+}
+
+// Pending returns the names of the queued files, oldest name first.
+func (u *Uploader) Pending() ([]string, error) {
+	entries, err := os.ReadDir(u.Dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, e := range entries {
+		if e.Type().IsRegular() && !strings.HasPrefix(e.Name(), ".") {
+			out = append(out, e.Name())
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// Flush sends the queued files in order and returns how many the server
+// took. It stops at the first one that could not be delivered and waits
+// before trying again, from 30 seconds up to 10 minutes; until then Flush
+// returns at once. Files the server refuses with a client error other
+// than 408 or 429 are moved to the rejected subdirectory, as sending them
+// again would not help, and the next ones are still sent.
+func (u *Uploader) Flush(now time.Time) (int, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if now.Before(u.retryAt) {
+		return 0, nil
+	}
+	names, err := u.Pending()
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	var errs []error
+	for _, name := range names {
+		path := filepath.Join(u.Dir, name)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return sent, err
+		}
+		if err := u.send(name, data); err != nil {
+			if rejected(err) {
+				os.MkdirAll(filepath.Join(u.Dir, rejectedDir), 0o755)
+				os.Rename(path, filepath.Join(u.Dir, rejectedDir, name))
+				errs = append(errs, fmt.Errorf("upload %s: rejected, moved to %s: %w", name, rejectedDir, err))
+				continue
+			}
+			u.backoff = min(max(2*u.backoff, minBackoff), maxBackoff)
+			u.retryAt = now.Add(u.backoff)
+			errs = append(errs, fmt.Errorf("upload %s: %w (retrying in %s)", name, err, u.backoff))
+			return sent, errors.Join(errs...)
+		}
+		if err := os.Remove(path); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	u.backoff, u.retryAt = 0, time.Time{}
+	return sent, errors.Join(errs...)
+}
+
+// Kick makes Run flush now rather than at its next tick.
+func (u *Uploader) Kick() {
+	select {
+	case u.kick <- struct{}{}:
+	default:
+	}
+}
+
+// Run flushes the queue every interval and when kicked until ctx is done,
+// reporting what happened to report.
+func (u *Uploader) Run(ctx context.Context, every time.Duration, report func(sent int, err error)) {
+	t := time.NewTicker(every)
+	defer t.Stop()
+	for {
+		if sent, err := u.Flush(time.Now()); sent > 0 || err != nil {
+			report(sent, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		case <-u.kick:
+		}
+	}
+}
+
+// statusError is a response other than 2xx.
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	if e.body == "" {
+		return fmt.Sprintf("HTTP %d", e.code)
+	}
+	return fmt.Sprintf("HTTP %d: %s", e.code, e.body)
+}
+
+func rejected(err error) bool {
+	se, ok := err.(*statusError)
+	return ok && se.code >= 400 && se.code < 500 && se.code != http.StatusRequestTimeout && se.code != http.StatusTooManyRequests
+}
+
+func (u *Uploader) send(name string, data []byte) error {
+	req, err := http.NewRequest(http.MethodPost, u.URL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	r := Request{Name: name, Body: string(data)}
+	for k, t := range u.headers {
+		var b strings.Builder
+		if err := t.Execute(&b, r); err != nil {
+			return fmt.Errorf("header %s: %w", k, err)
+		}
+		req.Header.Set(k, b.String())
+	}
+	resp, err := u.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 200))
+		return &statusError{code: resp.StatusCode, body: strings.TrimSpace(string(body))}
+	}
+	return nil
+}
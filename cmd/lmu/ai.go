@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/roster"
+)
+
+func runAI(args []string) error {
+	fs := flag.NewFlagSet("ai", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	file := fs.String("file", "", "Apply this roster file")
+	opponents := fs.Int("opponents", -1, "Number of AI opponents")
+	strength := fs.Float64("strength", -1, "AI strength setting value")
+	aggression := fs.Float64("aggression", -1, "AI aggression setting value")
+	groups := fs.String("groups", "", "Comma separated opponent groups (classes/cars) for the field")
+	fs.Parse(args)
+
+	client, _, err := api.client()
+	if err != nil {
+		return err
+	}
+	r := &roster.Roster{}
+	if *file != "" {
+		var err error
+		if r, err = roster.Load(*file); err != nil {
+			return err
+		}
+	}
+	if *opponents >= 0 {
+		r.Opponents = opponents
+	}
+	if *strength >= 0 {
+		r.Strength = strength
+	}
+	if *aggression >= 0 {
+		r.Aggression = aggression
+	}
+	if *groups != "" {
+		r.Groups = strings.Split(*groups, ",")
+		for i := range r.Groups {
+			r.Groups[i] = strings.TrimSpace(r.Groups[i])
+		}
+	}
+
+	if r.Opponents != nil || r.Strength != nil || r.Aggression != nil || len(r.Groups) > 0 {
+		missing, extra, err := r.Apply(client)
+		if err != nil {
+			return err
+		}
+		if len(missing) > 0 || len(extra) > 0 {
+			fmt.Println("Opponent groups cannot be set through the API, change them in the menu:")
+			for _, g := range missing {
+				fmt.Printf("  + %s\n", g)
+			}
+			for _, g := range extra {
+				fmt.Printf("  - %s\n", g)
+			}
+			fmt.Println()
+		}
+	}
+
+	st, err := roster.Current(client)
+	if err != nil {
+		return err
+	}
+	for _, k := range []struct{ key, name string }{
+		{lib.SettingNumOpponents, "Opponents"},
+		{lib.SettingAIStrength, "Strength"},
+		{lib.SettingAIAggression, "Aggression"},
+	} {
+		s, ok := st.Settings[k.key]
+		if !ok {
+			continue
+		}
+		fmt.Printf("%-11s %-8s (value %g of 0..%g)\n", k.name, s.StringValue, s.CurrentValue, s.NumStepsTotal-1)
+	}
+	fmt.Printf("%-11s %s\n", "Groups", strings.Join(st.Selected, ", "))
+	return nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// apiFlags are the connection flags of the subcommands that talk to the
+// game: -base, -config and -profile.
+type apiFlags struct {
+	fs   *flag.FlagSet
+	base *string
+	cfg  *config.Flags
+}
+
+func addAPIFlags(fs *flag.FlagSet) *apiFlags {
+	return &apiFlags{
+		fs:   fs,
+		base: fs.String("base", "http://localhost:6397", "Base URL of the API"),
+		cfg:  config.AddFlags(fs),
+	}
+}
+
+// client loads the config, applies the selected profile to the flag set and
+// returns a client for it. Call it after fs.Parse.
+func (a *apiFlags) client() (*lib.Client, *config.Config, error) {
+	cfg, prof, err := a.cfg.Load(a.fs)
+	if err != nil {
+		return nil, nil, err
+	}
+	client := lib.NewClient(*a.base)
+	client.HTTPClient = prof.HTTPClient()
+	return client, cfg, nil
+}
+
+// addHealthFlag adds -health to the commands meant to run as services.
+func addHealthFlag(fs *flag.FlagSet) *string {
+	return fs.String("health", "", "Serve /healthz and /readyz for supervisors at this address, e.g. :9102 (may be the -metrics address)")
+}
+
+// serveHealth serves the health endpoints at addr when set. Data counts as
+// stale after three missed polls at the loop's slowest interval.
+func serveHealth(loop *run.Loop, addr string, brk *watch.Breaker) {
+	if addr == "" {
+		return
+	}
+	interval := loop.Interval
+	if loop.Pace != nil {
+		interval = max(interval, loop.Pace.Idle)
+	}
+	run.Health(loop.Mux(addr), brk, 3*interval)
+}
+
+// adapt sets the loop's Pace for -adaptive.
+func adapt(loop *run.Loop, adaptive bool) {
+	if adaptive {
+		loop.Pace = &watch.DefaultPace
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/snipem/go-lmu-api/lib/carcolor"
+	"github.com/snipem/go-lmu-api/lib/config"
+)
+
+func runColors(args []string) error {
+	fs := flag.NewFlagSet("colors", flag.ExitOnError)
+	file := fs.String("file", carcolor.DefaultPath(), "Car colors file")
+	cfgPath := fs.String("config", config.DefaultPath(), "Config file, for pinned car colors")
+	forget := fs.String("forget", "", "Drop this car number's color, so it is assigned a new one")
+	fs.Parse(args)
+
+	cfg, err := config.Load(*cfgPath)
+	if err != nil {
+		return err
+	}
+	book, err := carcolor.Open(*file, cfg.CarColors)
+	if err != nil {
+		return err
+	}
+	if *forget != "" {
+		book.Forget(*forget)
+		return book.Save()
+	}
+	for _, a := range book.All() {
+		pinned := ""
+		if a.Pinned {
+			pinned = "  pinned in config"
+		}
+		fmt.Printf("\033[48;5;%dm    \033[0m  #%-4s %s%s\n", a.Color.ANSI, a.Number, a.Color.Hex, pinned)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib/analysis"
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/store"
+)
+
+// driverPace is a driver's pace in one session.
+type driverPace struct {
+	Name  string
+	Class string
+	Car   string
+	analysis.Pace
+}
+
+// compareSessions writes a Markdown comparison of two sessions at the same
+// track for league previews: per driver who drove in both, the change in
+// pace, consistency and top speed. With against 0 the earlier session is
+// the previous one of the same kind at the track.
+func compareSessions(db *store.Store, w io.Writer, id, against int64) error {
+	sess, err := db.Session(id)
+	if err != nil {
+		return err
+	}
+	var prev store.Session
+	if against == 0 {
+		prev, err = db.PreviousSession(sess)
+	} else {
+		prev, err = db.Session(against)
+	}
+	if err != nil {
+		return err
+	}
+	if prev.Track != sess.Track {
+		return fmt.Errorf("session %d was at %s, session %d at %s", prev.ID, prev.Track, sess.ID, sess.Track)
+	}
+	now, err := driverPaces(db, sess.ID)
+	if err != nil {
+		return err
+	}
+	before, err := driverPaces(db, prev.ID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "# %s, %s: %s against %s\n\n", sess.Track, sess.Session,
+		sess.StartedAt.Local().Format("2006-01-02"), prev.StartedAt.Local().Format("2006-01-02"))
+	fmt.Fprintf(w, "Pace is the median of each driver's green laps within 107%% of their best, consistency the standard deviation of those laps and top speed the fastest seen on them. Negative changes are faster or steadier.\n")
+
+	byClass := map[string][]string{}
+	var classes, added, gone []string
+	for id, d := range now {
+		if _, ok := before[id]; !ok {
+			added = append(added, d.Name)
+			continue
+		}
+		if byClass[d.Class] == nil {
+			classes = append(classes, d.Class)
+		}
+		byClass[d.Class] = append(byClass[d.Class], id)
+	}
+	for id, d := range before {
+		if _, ok := now[id]; !ok {
+			gone = append(gone, d.Name)
+		}
+	}
+	carclass.Default().Sort(classes)
+	for _, cls := range classes {
+		ids := byClass[cls]
+		sort.Slice(ids, func(i, j int) bool { return now[ids[i]].Median < now[ids[j]].Median })
+		fmt.Fprintf(w, "\n## %s\n\n", carclass.Lookup(cls).Name)
+		fmt.Fprintf(w, "| Driver | Pace | Δ | Consistency | Δ | Top speed | Δ | Laps |\n")
+		fmt.Fprintf(w, "| --- | ---: | ---: | ---: | ---: | ---: | ---: | ---: |\n")
+		for _, id := range ids {
+			n, b := now[id], before[id]
+			name := mdEscape(n.Name)
+			if n.Car != b.Car {
+				name += fmt.Sprintf(" (%s, was %s)", mdEscape(n.Car), mdEscape(b.Car))
+			}
+			speed, speedDelta := "–", "–"
+			if n.Vmax > 0 {
+				speed = fmt.Sprintf("%.0f", n.Vmax)
+				if b.Vmax > 0 {
+					speedDelta = fmt.Sprintf("%+.0f", n.Vmax-b.Vmax)
+				}
+			}
+			fmt.Fprintf(w, "| %s | %s | %+.3f | %.3f | %+.3f | %s | %s | %d |\n", name,
+				fmtLap(n.Median), n.Median-b.Median, n.StdDev, n.StdDev-b.StdDev, speed, speedDelta, n.Laps)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(gone)
+	if len(added) > 0 || len(gone) > 0 {
+		fmt.Fprintln(w)
+	}
+	if len(added) > 0 {
+		fmt.Fprintf(w, "New: %s.\n", mdEscape(strings.Join(added, ", ")))
+	}
+	if len(gone) > 0 {
+		fmt.Fprintf(w, "Not back: %s.\n", mdEscape(strings.Join(gone, ", ")))
+	}
+	return nil
+}
+
+// driverPaces sums up each driver's valid green laps in a session, keyed by
+// driver id. Laps recorded before lap kinds were stored are classified
+// again.
+func driverPaces(db *store.Store, sessionID int64) (map[string]driverPace, error) {
+	laps, err := db.Laps(store.Filter{}, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]driverPace{}
+	times, speeds := map[string][]float64{}, map[string][]float64{}
+	for start, end := 0, 0; start < len(laps); start = end {
+		for end < len(laps) && laps[end].SlotID == laps[start].SlotID {
+			end++
+		}
+		car := laps[start:end]
+		al := make([]analysis.Lap, len(car))
+		for i, l := range car {
+			al[i] = l.Analysis()
+		}
+		kinds := analysis.DefaultClassifier.Kinds(al)
+		for i, l := range car {
+			kind := l.Kind
+			if kind == "" {
+				kind = kinds[i]
+			}
+			if kind != analysis.Green || l.Invalid != "" {
+				continue
+			}
+			times[l.DriverID] = append(times[l.DriverID], l.LapTime)
+			speeds[l.DriverID] = append(speeds[l.DriverID], l.Vmax)
+			out[l.DriverID] = driverPace{Name: l.Driver, Class: l.CarClass, Car: l.Car}
+		}
+	}
+	for id, d := range out {
+		d.Pace, _ = analysis.PaceOf(times[id], speeds[id])
+		out[id] = d
+	}
+	return out, nil
+}
+
+// mdEscape keeps names from breaking a Markdown table.
+func mdEscape(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"html/template"
+	"math"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/analysis"
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/strategy"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// runDash is the pit display for a small screen on the rig, e.g. a
+// Raspberry Pi: flag, position, gaps and fuel, polled slowly and without
+// the standings history.
+func runDash(args []string) error {
+	fs := flag.NewFlagSet("dash", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	interval := fs.Duration("interval", 2*time.Second, "Poll interval for position, gaps and flags")
+	fuelEvery := fs.Duration("fuel-every", 10*time.Second, "Poll interval for fuel and energy")
+	plain := fs.Bool("plain", false, "No colors, for framebuffer consoles and small LCDs")
+	httpAddr := fs.String("http", "", "Also serve the display as a small web page at this address, e.g. :8080")
+	maxResponse := fs.Int64("max-response", 1<<20, "Largest API response read, in bytes (0 = no limit)")
+	access := run.AddAccessFlags(fs)
+	fs.Parse(args)
+
+	client, _, err := api.client()
+	if err != nil {
+		return err
+	}
+	client.MaxResponseSize = *maxResponse
+
+	d := &dash{}
+	loop := run.New(*interval)
+	if *httpAddr != "" {
+		loop.Guard(access)
+		mux := loop.Mux(*httpAddr)
+		mux.HandleFunc("/", d.servePage(*interval))
+		mux.HandleFunc("/status.json", d.serveJSON)
+	}
+	loop.Terminal()
+	brk := watch.NewBreaker()
+	var buf bytes.Buffer
+	var lastFuel time.Time
+	return loop.Poll(func(now time.Time) error {
+		st := dashState{At: now}
+		if brk.Allow(now) {
+			standings, err := client.RestWatchStandings()
+			brk.Record(now, err)
+			if err == nil {
+				si, _ := client.RestWatchSessionInfo()
+				st.fill(si, standings)
+				if now.Sub(lastFuel) >= *fuelEvery {
+					lastFuel = now
+					d.fuel = readFuel(client)
+				}
+				st.Fuel, st.FuelLaps = d.fuel.Fuel, d.fuel.FuelLaps
+			}
+		}
+		st.Connected = brk.Health().Connected
+		d.set(st)
+		buf.Reset()
+		st.render(&buf, !*plain)
+		os.Stdout.Write(buf.Bytes())
+		return nil
+	})
+}
+
+// dash holds the latest state for the web page.
+type dash struct {
+	mu   sync.Mutex
+	st   dashState
+	fuel dashState // Fuel and FuelLaps of the last fuel poll
+}
+
+func (d *dash) set(st dashState) {
+	d.mu.Lock()
+	d.st = st
+	d.mu.Unlock()
+}
+
+func (d *dash) get() dashState {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.st
+}
+
+// dashState is what the pit display shows.
+type dashState struct {
+	At        time.Time `json:"at"`
+	Connected bool      `json:"connected"`
+	// Flag is GREEN, YELLOW (local), FCY, RED, BLUE, CHEQUERED or empty
+	// outside a running session.
+	Flag          string  `json:"flag"`
+	Position      int     `json:"position"`
+	ClassPosition int     `json:"classPosition"`
+	Class         string  `json:"class"`
+	Cars          int     `json:"cars"`
+	Lap           int     `json:"lap"`
+	TimeLeft      float64 `json:"timeLeft"` // seconds, -1 when the session is not timed
+	// Ahead and Behind are the gaps to the cars directly ahead and behind
+	// overall, in seconds, or whole laps in AheadLaps and BehindLaps.
+	AheadCar   string  `json:"aheadCar,omitempty"`
+	Ahead      float64 `json:"ahead,omitempty"`
+	AheadLaps  int     `json:"aheadLaps,omitempty"`
+	BehindCar  string  `json:"behindCar,omitempty"`
+	Behind     float64 `json:"behind,omitempty"`
+	BehindLaps int     `json:"behindLaps,omitempty"`
+	// Fuel is the tank level as shown ("42.1 L" or "63 %"); FuelLaps the
+	// laps it lasts at the game's consumption estimate, 0 when unknown.
+	Fuel     string  `json:"fuel,omitempty"`
+	FuelLaps float64 `json:"fuelLaps,omitempty"`
+}
+
+// fill takes position, gaps and flag from a frame.
+func (st *dashState) fill(si *lib.RestWatchSessionInfoResponse, standings []lib.RestWatchStandingsResponseItem) {
+	st.Cars, st.TimeLeft = len(standings), -1
+	if si != nil && si.EndEventTime > 0 {
+		st.TimeLeft = math.Max(0, si.EndEventTime-si.CurrentEventTime)
+	}
+	var player *lib.RestWatchStandingsResponseItem
+	for i := range standings {
+		if standings[i].Player {
+			player = &standings[i]
+		}
+	}
+	if player == nil {
+		return
+	}
+	st.Position, st.Lap = int(player.Position), int(player.LapsCompleted)+1
+	st.Class = carclass.Lookup(player.CarClass).Abbrev
+	for _, s := range standings {
+		if s.CarClass == player.CarClass && s.Position <= player.Position {
+			st.ClassPosition++
+		}
+		if s.Position == player.Position+1 {
+			st.BehindCar = s.CarNumber
+			st.Behind, st.BehindLaps = s.TimeBehindNext, int(s.LapsBehindNext)
+		}
+		if s.Position == player.Position-1 {
+			st.AheadCar = s.CarNumber
+			st.Ahead, st.AheadLaps = player.TimeBehindNext, int(player.LapsBehindNext)
+		}
+	}
+	if si != nil {
+		st.Flag = dashFlag(si, *player)
+	}
+}
+
+func dashFlag(si *lib.RestWatchSessionInfoResponse, player lib.RestWatchStandingsResponseItem) string {
+	switch phase := lib.PhaseOf(si); {
+	case phase == lib.PhaseOver || player.FinishStatus == "FSTAT_FINISHED":
+		return "CHEQUERED"
+	case phase == lib.PhaseStopped:
+		return "RED"
+	case phase == lib.PhaseFullCourse || analysis.FullCourseYellow(si.YellowFlagState):
+		return "FCY"
+	case player.UnderYellow:
+		return "YELLOW"
+	case player.Flag == "BLUE":
+		return "BLUE"
+	case phase == lib.PhaseGreen:
+		return "GREEN"
+	}
+	return ""
+}
+
+// readFuel reads the tank level and how many laps it lasts. Errors leave
+// the fuel unknown rather than stopping the display.
+func readFuel(c *lib.Client) dashState {
+	var st dashState
+	e, r, err := c.Energy()
+	if err != nil {
+		return st
+	}
+	if e.UsesVirtualEnergy() {
+		st.Fuel = fmt.Sprintf("%.0f %%", e.EnergyPercent())
+	} else {
+		st.Fuel = fmt.Sprintf("%.1f L", e.Fuel)
+	}
+	if u, err := c.ExpectedEnergyUsage(); err == nil {
+		if laps := strategy.NewTank(*e, *u, *r).LapsLeft(); !math.IsInf(laps, 0) {
+			st.FuelLaps = laps
+		}
+	}
+	return st
+}
+
+// flagCodes are the SGR colors of the flag line.
+var flagCodes = map[string]string{
+	"GREEN": "1;97;42", "YELLOW": "1;30;103", "FCY": "1;30;103", "RED": "1;97;41",
+	"BLUE": "1;97;44", "CHEQUERED": "1;30;107",
+}
+
+// render draws the display in large, widely spaced lines.
+func (st dashState) render(b *bytes.Buffer, color bool) {
+	b.WriteString("\033[H")
+	line := func(format string, args ...interface{}) {
+		fmt.Fprintf(b, "  "+format+"\033[K\n\033[K\n", args...)
+	}
+	flag := st.Flag
+	switch {
+	case !st.Connected:
+		flag = "NO CONNECTION"
+	case flag == "":
+		flag = "NO SESSION"
+	}
+	if code := flagCodes[st.Flag]; color && code != "" && st.Connected {
+		fmt.Fprintf(b, "\033[%sm  %-30s\033[0m\033[K\n\033[K\n", code, flag)
+	} else {
+		line("%s", flag)
+	}
+	if st.Position > 0 {
+		line("P%d / %d   %s P%d   LAP %d   %s", st.Position, st.Cars, st.Class, st.ClassPosition, st.Lap, dashClock(st.TimeLeft))
+	}
+	if st.Position > 1 {
+		line("AHEAD   #%-4s %s", st.AheadCar, dashGap(st.Ahead, st.AheadLaps, "+"))
+	}
+	if st.Position > 0 && st.Position < st.Cars {
+		line("BEHIND  #%-4s %s", st.BehindCar, dashGap(st.Behind, st.BehindLaps, "-"))
+	}
+	if st.Fuel != "" {
+		laps := ""
+		if st.FuelLaps > 0 {
+			laps = fmt.Sprintf("   %.1f LAPS", st.FuelLaps)
+		}
+		line("FUEL    %s%s", st.Fuel, laps)
+	}
+	b.WriteString("\033[J")
+}
+
+func dashGap(sec float64, laps int, sign string) string {
+	if laps > 0 {
+		return fmt.Sprintf("%s%d L", sign, laps)
+	}
+	if sec <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%s%.1f", sign, sec)
+}
+
+func dashClock(sec float64) string {
+	if sec < 0 {
+		return ""
+	}
+	s := int(sec)
+	return fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+}
+
+func (d *dash) serveJSON(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(d.get())
+}
+
+// servePage serves the display as a page that reloads itself, so a kiosk
+// browser needs no JavaScript.
+func (d *dash) servePage(refresh time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		st := d.get()
+		var lines []string
+		if st.Position > 0 {
+			lines = append(lines, fmt.Sprintf("P%d / %d · %s P%d · Lap %d %s", st.Position, st.Cars, st.Class, st.ClassPosition, st.Lap, dashClock(st.TimeLeft)))
+		}
+		if st.Position > 1 {
+			lines = append(lines, fmt.Sprintf("Ahead #%s %s", st.AheadCar, dashGap(st.Ahead, st.AheadLaps, "+")))
+		}
+		if st.Position > 0 && st.Position < st.Cars {
+			lines = append(lines, fmt.Sprintf("Behind #%s %s", st.BehindCar, dashGap(st.Behind, st.BehindLaps, "-")))
+		}
+		if st.Fuel != "" {
+			fuel := "Fuel " + st.Fuel
+			if st.FuelLaps > 0 {
+				fuel += fmt.Sprintf(" · %.1f laps", st.FuelLaps)
+			}
+			lines = append(lines, fuel)
+		}
+		flag := st.Flag
+		if !st.Connected {
+			flag = "NO CONNECTION"
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		dashPage.Execute(w, map[string]interface{}{
+			"Refresh": int(math.Max(1, refresh.Seconds())), "Flag": flag, "Class": st.Flag, "Lines": lines,
+		})
+	}
+}
+
+var dashPage = template.Must(template.New("dash").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta http-equiv="refresh" content="{{.Refresh}}">
+<meta name="viewport" content="width=device-width">
+<title>Pit display</title>
+<style>
+body { background: #000; color: #fff; font: bold 7vw/1.4 sans-serif; margin: 0; }
+div { padding: 0 .4em; }
+.flag { text-align: center; }
+.GREEN { background: #2e7d32; } .YELLOW, .FCY { background: #fdd835; color: #000; }
+.RED { background: #c62828; } .BLUE { background: #1565c0; } .CHEQUERED { background: #fff; color: #000; }
+</style>
+</head>
+<body>
+<div class="flag {{.Class}}">{{or .Flag "NO SESSION"}}</div>
+{{range .Lines}}<div>{{.}}</div>
+{{end}}</body>
+</html>
+`))
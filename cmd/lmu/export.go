@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/export"
+	"github.com/snipem/go-lmu-api/cmd/internal/sqlite"
+	"github.com/snipem/go-lmu-api/lib/store"
+)
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	dbPath := fs.String("db", store.DefaultPath(), "History database")
+	session := fs.Int64("session", 0, "Session id (default: the latest with results)")
+	format := fs.String("format", "acc", "Output format: acc (ACC server results JSON)")
+	outPath := fs.String("o", "", "Output file (default: stdout)")
+	fs.Parse(args)
+
+	if *format != "acc" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	db, err := sqlite.Open(*dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	sess, err := db.Session(*session)
+	if err != nil {
+		return err
+	}
+	res, err := exportACC(db, sess)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if *outPath != "" {
+		f, err := os.Create(*outPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(res)
+}
+
+// exportACC converts a recorded session to ACC server results.
+func exportACC(db *store.Store, sess store.Session) (export.ACCResults, error) {
+	results, err := db.Results(sess.ID)
+	if err != nil {
+		return export.ACCResults{}, err
+	}
+	laps, err := db.Laps(store.Filter{}, sess.ID)
+	if err != nil {
+		return export.ACCResults{}, err
+	}
+	return export.ACC(sess, results, laps), nil
+}
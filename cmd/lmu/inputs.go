@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// runInputs streams the player's inputs at a high rate for stream overlays
+// drawing throttle, brake and steering traces: as JSON lines on stdout, or
+// with -http as Server-Sent Events that every overlay thins and smooths to
+// its own taste.
+func runInputs(args []string) error {
+	fs := flag.NewFlagSet("inputs", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	rate := fs.Duration("rate", watch.DefaultInputRate, "How often the inputs are sampled from the game")
+	every := fs.Duration("every", 0, "Default shortest time between samples sent (0 = every sample), ?every= per client")
+	smooth := fs.Duration("smooth", 0, "Default smoothing time constant of pedals and steering (0 = off), ?smooth= per client")
+	keep := fs.Duration("keep", 10*time.Second, "Recent inputs sent to an overlay when it connects, to draw its trace from")
+	httpAddr := fs.String("http", "", "Serve the inputs as Server-Sent Events at this address's /inputs, e.g. :8082")
+	access := run.AddAccessFlags(fs)
+	fs.Parse(args)
+
+	client, _, err := api.client()
+	if err != nil {
+		return err
+	}
+	loop := run.New(time.Second)
+	if *httpAddr == "" {
+		f := watch.InputFilter{Every: *every, Smooth: *smooth}
+		enc := json.NewEncoder(os.Stdout)
+		go watch.SampleInputs(loop.Context(), client, *rate, func(in lib.Inputs) {
+			if out, ok := f.Add(in); ok {
+				enc.Encode(compactInputs(out))
+			}
+		})
+		return loop.Wait()
+	}
+
+	h := &inputHub{keep: *keep, clients: map[chan lib.Inputs]bool{}}
+	loop.Guard(access)
+	mux := loop.Mux(*httpAddr)
+	mux.HandleFunc("/inputs", h.serve(loop, *every, *smooth))
+	go watch.SampleInputs(loop.Context(), client, *rate, h.add)
+	fmt.Fprintf(os.Stderr, "Serving inputs on %s/inputs (Ctrl+C to stop)\n", *httpAddr)
+	return loop.Wait()
+}
+
+// overlayInputs is a sample as sent to overlays: milliseconds since the
+// epoch and the axes to three decimals, to keep a 50 Hz stream small.
+type overlayInputs struct {
+	T          int64   `json:"t"`
+	Throttle   float64 `json:"throttle"`
+	Brake      float64 `json:"brake"`
+	Clutch     float64 `json:"clutch"`
+	Handbrake  float64 `json:"handbrake"`
+	Steer      float64 `json:"steer"`
+	ShiftUp    bool    `json:"shiftUp,omitempty"`
+	ShiftDown  bool    `json:"shiftDown,omitempty"`
+	TCOverride bool    `json:"tcOverride,omitempty"`
+}
+
+func compactInputs(in lib.Inputs) overlayInputs {
+	r := func(v float64) float64 { return math.Round(v*1000) / 1000 }
+	return overlayInputs{
+		T:        in.At.UnixMilli(),
+		Throttle: r(in.Throttle), Brake: r(in.Brake), Clutch: r(in.Clutch), Handbrake: r(in.Handbrake), Steer: r(in.Steer),
+		ShiftUp: in.ShiftUp, ShiftDown: in.ShiftDown, TCOverride: in.TCOverride,
+	}
+}
+
+// inputHub fans the samples out to the connected overlays and keeps the
+// recent ones for overlays connecting later.
+type inputHub struct {
+	keep time.Duration
+
+	mu      sync.Mutex
+	recent  []lib.Inputs
+	clients map[chan lib.Inputs]bool
+}
+
+// add passes a sample on. An overlay that falls behind loses samples
+// rather than holding up the others.
+func (h *inputHub) add(in lib.Inputs) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.recent = append(h.recent, in)
+	i := 0
+	for i < len(h.recent) && in.At.Sub(h.recent[i].At) > h.keep {
+		i++
+	}
+	h.recent = h.recent[i:]
+	for ch := range h.clients {
+		select {
+		case ch <- in:
+		default:
+		}
+	}
+}
+
+func (h *inputHub) subscribe() (chan lib.Inputs, []lib.Inputs) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ch := make(chan lib.Inputs, 64)
+	h.clients[ch] = true
+	return ch, append([]lib.Inputs(nil), h.recent...)
+}
+
+func (h *inputHub) unsubscribe(ch chan lib.Inputs) {
+	h.mu.Lock()
+	delete(h.clients, ch)
+	h.mu.Unlock()
+}
+
+// serve streams the samples as Server-Sent Events, one JSON object per
+// event, starting with the recent ones. ?every= and ?smooth= override the
+// defaults for the connection.
+func (h *inputHub) serve(loop *run.Loop, every, smooth time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		f := watch.InputFilter{Every: every, Smooth: smooth}
+		for name, d := range map[string]*time.Duration{"every": &f.Every, "smooth": &f.Smooth} {
+			if v := r.URL.Query().Get(name); v != "" {
+				var err error
+				if *d, err = time.ParseDuration(v); err != nil || *d < 0 {
+					http.Error(w, fmt.Sprintf("bad %s: %q", name, v), http.StatusBadRequest)
+					return
+				}
+			}
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+
+		ch, recent := h.subscribe()
+		defer h.unsubscribe(ch)
+		send := func(in lib.Inputs) {
+			if out, ok := f.Add(in); ok {
+				data, _ := json.Marshal(compactInputs(out))
+				fmt.Fprintf(w, "data: %s\n\n", data)
+			}
+		}
+		for _, in := range recent {
+			send(in)
+		}
+		flusher.Flush()
+		// Outside the car there are no samples; a comment now and then
+		// keeps proxies from closing the idle stream
+		keepalive := time.NewTicker(15 * time.Second)
+		defer keepalive.Stop()
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-loop.Context().Done():
+				return
+			case <-keepalive.C:
+				fmt.Fprint(w, ": keepalive\n\n")
+			case in := <-ch:
+				send(in)
+			}
+			flusher.Flush()
+		}
+	}
+}
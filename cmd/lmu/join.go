@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib/lobby"
+)
+
+func runJoin(args []string) error {
+	fs := flag.NewFlagSet("join", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	file := fs.String("file", lobby.DefaultPath(), "Servers file")
+	server := fs.String("server", "", "Server name or host:port (default from config)")
+	pass := fs.String("pass", "", "Server password (default from config or servers file)")
+	car := fs.String("car", "", "Car to select (default from config)")
+	livery := fs.String("livery", "", "Livery name or car number (default from config)")
+	drive := fs.Bool("drive", false, "Leave the garage once the car is selected")
+	timeout := fs.Duration("timeout", 3*time.Minute, "How long to wait for the track to load")
+	fs.Parse(args)
+
+	client, cfg, err := api.client()
+	if err != nil {
+		return err
+	}
+	j := cfg.Join
+	if *server != "" {
+		j.Server = *server
+	}
+	if *pass != "" {
+		j.Password = *pass
+	}
+	if *car != "" {
+		j.Car = *car
+	}
+	if *livery != "" {
+		j.Livery = *livery
+	}
+	j.Drive = j.Drive || *drive
+	if j.Server == "" {
+		return fmt.Errorf("no server: pass -server or set join.server in %s", api.cfg.Path)
+	}
+
+	servers, err := lobby.Load(*file)
+	if err != nil {
+		return err
+	}
+	s, ok := lobby.Find(servers, j.Server)
+	if !ok {
+		return fmt.Errorf("no server %q in %s", j.Server, *file)
+	}
+
+	a := &lobby.AutoJoin{
+		Client:   client,
+		Server:   s,
+		Password: j.Password,
+		Car:      j.Car,
+		Livery:   j.Livery,
+		Drive:    j.Drive,
+		Timeout:  *timeout,
+		Progress: func(msg string) { fmt.Println(msg) },
+	}
+	if err := a.Run(); err != nil {
+		return err
+	}
+	fmt.Println("ready")
+	return nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/feed"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+func runLapFeed(args []string) error {
+	fs := flag.NewFlagSet("lapfeed", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	interval := fs.Duration("interval", 1*time.Second, "Poll interval")
+	adaptive := fs.Bool("adaptive", false, run.AdaptiveUsage)
+	health := addHealthFlag(fs)
+	listen := fs.String("listen", "", "Serve the stream to TCP clients at this address instead of stdout, e.g. :5000")
+	backlog := fs.Bool("backlog", false, "Start with the laps already completed in the session")
+	fs.Parse(args)
+
+	client, _, err := api.client()
+	if err != nil {
+		return err
+	}
+	loop := run.New(*interval)
+	adapt(loop, *adaptive)
+	var out io.Writer = os.Stdout
+	if *listen != "" {
+		srv, err := feed.Listen(*listen)
+		if err != nil {
+			return err
+		}
+		loop.Defer(srv.Close)
+		fmt.Fprintf(os.Stderr, "Serving laps on %s\n", srv.Addr())
+		out = srv
+	}
+
+	det := feed.NewLapDetector()
+	det.Backlog = *backlog
+	enc := json.NewEncoder(out)
+	brk := watch.NewBreaker()
+	serveHealth(loop, *health, brk)
+	return loop.Poll(func(now time.Time) error {
+		if !brk.Allow(now) {
+			return nil
+		}
+		standings, err := client.RestWatchStandings()
+		brk.Record(now, err)
+		if err != nil {
+			if !watch.IsConnError(err) {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			return nil
+		}
+		standings, _ = watch.SanitizeStandings(standings)
+		si, _ := client.RestWatchSessionInfo()
+		loop.Adapt(watch.Classify(si, standings))
+		var history map[string][]lib.RestWatchStandingsHistoryResponseItemItem
+		if raw, err := client.RestWatchStandingsHistory(); err == nil && raw != nil {
+			history, _ = watch.SanitizeHistory(*raw)
+		}
+		for _, l := range det.Frame(now, si, standings, history) {
+			if err := enc.Encode(l); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
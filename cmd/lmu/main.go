@@ -0,0 +1,93 @@
+// lmu is the multi-purpose command line tool for the LMU API.
+//
+// Usage: lmu <command> [flags]
+//
+//	record   record sessions, laps, stints, results and events to the history database
+//	stats    personal racing logbook from the history database
+//	export   write a recorded session as ACC server results JSON
+//	upload   send results to a league's results API, queued while offline
+//	publish  turn a directory of exported results into HTML pages and an Atom feed
+//	colors   list the colors cars keep across sessions and tools
+//	servers  list multiplayer servers from the servers file and join one
+//	join     join a server, select the configured car and livery, optionally drive
+//	ai       show or script the AI field (count, strength, aggression, groups)
+//	replays  list saved replays, or rename new ones after their session
+//	lapfeed  stream completed laps as JSON lines on stdout or TCP
+//	ticker   live ticker lines for the session's events, for overlays and chat bots
+//	sheets   append completed laps and final results to a Google Sheet
+//	rmonitor  serve the session to club timing displays (RMonitor protocol)
+//	pitwall  merge fuel, tyres, damage and inputs from several team members' games
+//	pit      change the pit menu from hotkeys, a button box or the command line
+//	dash     low-resource pit display of flag, gaps and fuel, e.g. for a Raspberry Pi
+//	weather  rain and track wetness for the next hour, as a table or JSON for charts
+//	inputs   stream the player's throttle, brake and steering for overlays drawing input traces
+//	version  print the version
+//	selfupdate  install the latest release of lmu and the tools next to it
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+)
+
+type command struct {
+	summary string
+	run     func(args []string) error
+}
+
+var commands = map[string]command{
+	"record":     {"record sessions, laps, stints, results and events to the history database", runRecord},
+	"stats":      {"personal bests, head-to-heads, finishes and laps per car", runStats},
+	"export":     {"write a recorded session in another sim's results format", runExport},
+	"upload":     {"send results files or recorded sessions to the league's results API", runUpload},
+	"publish":    {"static results pages and an Atom feed from exported results", runPublish},
+	"colors":     {"list the car colors shared by the tools, or forget one", runColors},
+	"servers":    {"list and filter multiplayer servers, join one", runServers},
+	"join":       {"join a server and select car and livery in one go", runJoin},
+	"ai":         {"show or set the AI opponent count, strength, aggression and groups", runAI},
+	"replays":    {"list replays; -auto renames new replays after their session", runReplays},
+	"lapfeed":    {"stream completed laps as JSON lines for external timing systems", runLapFeed},
+	"ticker":     {"human-readable live ticker lines from the session's events", runTicker},
+	"sheets":     {"append completed laps and final results to a Google Sheet", runSheets},
+	"rmonitor":   {"serve the session to timing displays in the RMonitor protocol", runRMonitor},
+	"pitwall":    {"combined pit wall view from several team members' games", runPitwall},
+	"pit":        {"change fuel, energy, tyres and repairs in the pit menu, from keys or a button box", runPit},
+	"dash":       {"pit display for a small screen on the rig: flag, gaps and fuel", runDash},
+	"weather":    {"forecast timeline of rain chance and track wetness, as text or JSON", runWeather},
+	"inputs":     {"stream the player's inputs for overlays drawing input traces", runInputs},
+	"version":    {"print the version", runVersion},
+	"selfupdate": {"update lmu and the tools next to it from the latest release", runSelfUpdate},
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: lmu <command> [flags]\n\nCommands:\n")
+	names := make([]string, 0, len(commands))
+	for n := range commands {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", n, commands[n].summary)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun 'lmu <command> -h' for the command's flags.\n")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		if os.Args[1] != "-h" && os.Args[1] != "help" {
+			fmt.Fprintf(os.Stderr, "lmu: unknown command %q\n\n", os.Args[1])
+		}
+		usage()
+		os.Exit(2)
+	}
+	if err := cmd.run(os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/pit"
+)
+
+// bindList is the repeatable -bind flag.
+type bindList map[string]string
+
+func (b bindList) String() string { return fmt.Sprint(map[string]string(b)) }
+func (b bindList) Set(v string) error {
+	key, action, ok := strings.Cut(v, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("want KEY=ACTION, got %q", v)
+	}
+	b[key] = action
+	return nil
+}
+
+// runPit changes the pit menu from the command line, typed keys or a button
+// box: each action is read, applied to the menu and sent back at once.
+func runPit(args []string) error {
+	fs := flag.NewFlagSet("pit", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	binds := bindList{}
+	fs.Var(binds, "bind", "Map an input line to an action, e.g. 1=fuel+10 (repeatable)")
+	listen := fs.String("listen", "", "Also read actions from TCP connections and UDP packets at this address, e.g. :7400")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: lmu pit [flags] [action...]\n\n%s\nFlags:\n", pitActionsUsage)
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	client, _, err := api.client()
+	if err != nil {
+		return err
+	}
+	p := &pitControl{ctl: pit.New(client), binds: binds}
+	if fs.NArg() > 0 {
+		for _, a := range fs.Args() {
+			if err := p.do(a); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if *listen != "" {
+		if err := p.listen(*listen); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Reading actions on %s\n", *listen)
+	}
+	p.read(os.Stdin)
+	if *listen != "" {
+		select {}
+	}
+	return nil
+}
+
+const pitActionsUsage = `Actions:
+` + pit.Usage + `  show                print the pit menu
+
+Without actions, lines are read from stdin, and with -listen from the
+network, one action (or -bind key) per line.
+`
+
+// pitControl applies actions from the command line and the inputs.
+type pitControl struct {
+	ctl   *pit.Control
+	binds map[string]string
+}
+
+// read applies the actions in r's lines until r ends, reporting errors
+// without stopping.
+func (p *pitControl) read(r io.Reader) {
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		if line := strings.TrimSpace(sc.Text()); line != "" {
+			if err := p.do(line); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+		}
+	}
+}
+
+// listen reads actions from TCP connections, a line each, and from UDP
+// packets, an action each, at the same address.
+func (p *pitControl) listen(addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	pc, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		ln.Close()
+		return err
+	}
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				p.read(c)
+			}()
+		}
+	}()
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, _, err := pc.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			p.read(strings.NewReader(string(buf[:n])))
+		}
+	}()
+	return nil
+}
+
+// do applies one action and prints the changed line, or the menu for show.
+func (p *pitControl) do(action string) error {
+	if a, ok := p.binds[action]; ok {
+		action = a
+	}
+	if strings.EqualFold(strings.TrimSpace(action), "show") {
+		menu, err := p.ctl.Menu()
+		if err != nil {
+			return err
+		}
+		for _, it := range menu {
+			fmt.Printf("%-20s %s\n", it.Name, it.Text())
+		}
+		return nil
+	}
+	it, err := p.ctl.Do(action)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s %s\n", it.Name, it.Text())
+	return nil
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/pitwall"
+)
+
+// sourceList is the repeatable -source flag.
+type sourceList []string
+
+func (l *sourceList) String() string     { return strings.Join(*l, ", ") }
+func (l *sourceList) Set(v string) error { *l = append(*l, v); return nil }
+
+func runPitwall(args []string) error {
+	fs := flag.NewFlagSet("pitwall", flag.ExitOnError)
+	var sources sourceList
+	fs.Var(&sources, "source", "Game instance: a profile name, name=URL or a URL (repeatable)")
+	interval := fs.Duration("interval", 1*time.Second, "Poll interval")
+	maxAge := fs.Duration("max-age", 30*time.Second, "Drop cars no source reported for this long")
+	cf := config.AddFlags(fs)
+	fs.Parse(args)
+
+	cfg, _, err := cf.Load(fs)
+	if err != nil {
+		return err
+	}
+	if len(sources) == 0 {
+		return fmt.Errorf("no sources: pass -source for each team member's game")
+	}
+	var list []pitwall.Source
+	for _, s := range sources {
+		src, err := resolveSource(cfg, s)
+		if err != nil {
+			return err
+		}
+		list = append(list, src)
+	}
+
+	agg := pitwall.NewAggregator(list)
+	agg.MaxAge = *maxAge
+	loop := run.New(*interval)
+	loop.Terminal()
+	return loop.Poll(func(time.Time) error {
+		agg.Poll()
+		renderPitwall(agg.Cars(), agg.Errors())
+		return nil
+	})
+}
+
+// resolveSource turns a -source value into a source: name=URL, a bare URL,
+// or the name of a profile in the config.
+func resolveSource(cfg *config.Config, s string) (pitwall.Source, error) {
+	if name, url, ok := strings.Cut(s, "="); ok {
+		return pitwall.Source{Name: name, Client: lib.NewClient(url)}, nil
+	}
+	if strings.Contains(s, "://") {
+		return pitwall.Source{Name: s, Client: lib.NewClient(s)}, nil
+	}
+	p, err := cfg.Profile(s)
+	if err != nil {
+		return pitwall.Source{}, err
+	}
+	if p.BaseURL == "" {
+		return pitwall.Source{}, fmt.Errorf("profile %q has no baseURL", s)
+	}
+	c := lib.NewClient(p.BaseURL)
+	c.HTTPClient = p.HTTPClient()
+	return pitwall.Source{Name: s, Client: c}, nil
+}
+
+func renderPitwall(cars []pitwall.Car, errs map[string]error) {
+	var b strings.Builder
+	b.WriteString("\033[H")
+	fmt.Fprintf(&b, "  LMU Pit Wall  |  %s\033[K\n\n", time.Now().Format("15:04:05"))
+	fmt.Fprintf(&b, "  %3s %-5s %-8s %-20s %-10s %4s %11s %5s %-19s %-19s %6s %-13s\033[K\n",
+		"POS", "CAR", "CLASS", "DRIVER", "SOURCE", "LAP", "FUEL", "VE%", "TYRES", "BRAKES", "DAMAGE", "THR BRK STR")
+	for _, c := range cars {
+		driver := c.Driver
+		if !c.Driving {
+			driver += " (idle)"
+		}
+		if c.Pitting {
+			driver += " PIT"
+		}
+		fuel := "-"
+		if c.FuelCapacity > 0 {
+			fuel = fmt.Sprintf("%.1f/%.0f", c.Fuel, c.FuelCapacity)
+		}
+		energy := "-"
+		if c.Energy > 0 {
+			energy = fmt.Sprintf("%.1f", c.Energy)
+		}
+		fmt.Fprintf(&b, "  %3d %-5s %-8s %-20.20s %-10.10s %4d %11s %5s %-19s %-19s %6.2f %3.0f %3.0f %+4.0f\033[K\n",
+			c.Position, c.Number, c.Class, driver, c.Source, c.Laps, fuel, energy,
+			percents(c.Tyres), percents(c.Brakes), c.Damage, 100*c.Throttle, 100*c.Brake, 100*c.Steer)
+	}
+	if len(cars) == 0 {
+		b.WriteString("  waiting for data\033[K\n")
+	}
+	names := make([]string, 0, len(errs))
+	for n, err := range errs {
+		if err != nil {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	if len(names) > 0 {
+		b.WriteString("\033[K\n")
+	}
+	for _, n := range names {
+		fmt.Fprintf(&b, "  %s: %v\033[K\n", n, errs[n])
+	}
+	b.WriteString("\033[J")
+	os.Stdout.WriteString(b.String())
+}
+
+// percents formats per-wheel conditions (0..1) as whole percentages.
+func percents(v []float64) string {
+	if len(v) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(v))
+	for i, x := range v {
+		parts[i] = fmt.Sprintf("%.0f", 100*x)
+	}
+	return strings.Join(parts, " ")
+}
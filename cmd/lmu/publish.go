@@ -0,0 +1,48 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/site"
+	"github.com/snipem/go-lmu-api/lib/carcolor"
+	"github.com/snipem/go-lmu-api/lib/config"
+)
+
+func runPublish(args []string) error {
+	fs := flag.NewFlagSet("publish", flag.ExitOnError)
+	results := fs.String("results", "results", "Directory of ACC results JSON files, e.g. written by lmu export -o")
+	out := fs.String("out", "site", "Directory to write the HTML pages and feed.xml to")
+	title := fs.String("title", "Race results", "Site and feed title")
+	baseURL := fs.String("url", "", "URL the site is published at, for absolute links in the feed")
+	colorFile := fs.String("car-colors", carcolor.DefaultPath(), "Car colors file, to mark car numbers in the color the live tools show (empty = no colors)")
+	cfgPath := fs.String("config", config.DefaultPath(), "Config file, for pinned car colors")
+	fs.Parse(args)
+
+	opts := site.Options{Title: *title, BaseURL: *baseURL}
+	if *colorFile != "" {
+		cfg, err := config.Load(*cfgPath)
+		if err != nil {
+			return err
+		}
+		if opts.Colors, err = carcolor.Open(*colorFile, cfg.CarColors); err != nil {
+			return err
+		}
+	}
+
+	sessions, err := site.Load(*results)
+	if err != nil {
+		return err
+	}
+	if err := site.Write(*out, sessions, opts); err != nil {
+		return err
+	}
+	if opts.Colors != nil {
+		if err := opts.Colors.Save(); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %d session pages, index.html and feed.xml to %s\n", len(sessions), *out)
+	return nil
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/cmd/internal/sqlite"
+	"github.com/snipem/go-lmu-api/cmd/internal/upload"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/events"
+	"github.com/snipem/go-lmu-api/lib/store"
+	"github.com/snipem/go-lmu-api/lib/timesync"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+func runRecord(args []string) error {
+	fs := flag.NewFlagSet("record", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	interval := fs.Duration("interval", 1*time.Second, "Poll interval")
+	adaptive := fs.Bool("adaptive", false, run.AdaptiveUsage)
+	health := addHealthFlag(fs)
+	dbPath := fs.String("db", store.DefaultPath(), "History database")
+	inputs := fs.Duration("inputs", 0, "Also record the player's throttle, brake and steering at this rate, e.g. 50ms (0 = off)")
+	metricsAddr := fs.String("metrics", "", "Serve API request metrics for Prometheus at this address's /metrics, e.g. :9101")
+	eventsLog := events.AddLogFlag(fs)
+	uploadResults := fs.Bool("upload", false, "Send the results of every session that is over to the league's results API (config upload)")
+	fs.Parse(args)
+
+	client, cfg, err := api.client()
+	if err != nil {
+		return err
+	}
+	db, err := sqlite.Open(*dbPath)
+	if err != nil {
+		return err
+	}
+	loop := run.New(*interval)
+	adapt(loop, *adaptive)
+	loop.Defer(db.Close)
+	elog, err := events.OpenLog(*eventsLog, "lmu record")
+	if err != nil {
+		return err
+	}
+	loop.Defer(elog.Close)
+	clock := timesync.New()
+	elog.Sync(clock)
+
+	if *metricsAddr != "" {
+		client.Metrics = lib.NewMetrics()
+		loop.Mux(*metricsAddr).Handle("/metrics", client.Metrics)
+	}
+	rec := store.NewRecorder(db)
+	var up *upload.Uploader
+	if *uploadResults {
+		if up, err = newUploader(cfg.Upload, *dbPath); err != nil {
+			return err
+		}
+		go up.Run(loop.Context(), time.Minute, func(sent int, err error) {
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			if sent > 0 {
+				fmt.Fprintf(os.Stderr, "Uploaded %d results file(s)\n", sent)
+			}
+		})
+	}
+	brk := watch.NewBreaker()
+	serveHealth(loop, *health, brk)
+	brk.OnChange = func(h watch.Health) {
+		if h.Connected {
+			fmt.Fprintf(os.Stderr, "Connected to %s\n", client.BaseURL)
+		} else {
+			fmt.Fprintf(os.Stderr, "Disconnected: %v (retrying every %s)\n", h.Err, brk.Probe)
+		}
+	}
+	var seq watch.Sequence
+	det := events.NewDetector()
+	incidents := events.NewIncidents()
+	stewards := events.NewStewards(cfg.Countdowns.PitWindow)
+	start := events.NewStart()
+
+	var trace *watch.InputTrace
+	if *inputs > 0 {
+		// Bounded, so a stalled loop cannot grow it without limit
+		trace = &watch.InputTrace{Max: int(time.Minute / *inputs)}
+		go watch.SampleInputs(loop.Context(), client, *inputs, trace.Add)
+	}
+
+	loop.Defer(rec.Finish)
+	// Results go out once the session is over and the cars have taken the
+	// flag, or a minute later; a session left before it was over is not
+	// sent
+	var overAt time.Time
+	var uploaded int64
+	fmt.Fprintf(os.Stderr, "Recording to %s (Ctrl+C to stop)\n", *dbPath)
+	return loop.Poll(func(now time.Time) error {
+		if !brk.Allow(now) {
+			return nil
+		}
+		capture := seq.Start(now)
+		standings, err := client.RestWatchStandings()
+		capture.Observe("standings", time.Since(now))
+		brk.Record(now, err)
+		if err != nil {
+			if !watch.IsConnError(err) {
+				fmt.Fprintf(os.Stderr, "\rError: %v", err)
+			}
+			return nil
+		}
+		standings, _ = watch.SanitizeStandings(standings)
+		t := time.Now()
+		si, err := client.RestWatchSessionInfo()
+		capture.Observe("sessionInfo", time.Since(t))
+		if err != nil {
+			return nil
+		}
+		clock.ObserveRequest(t, time.Now(), si.CurrentEventTime)
+		loop.Adapt(watch.Classify(si, standings))
+		var history map[string][]lib.RestWatchStandingsHistoryResponseItemItem
+		t = time.Now()
+		if raw, err := client.RestWatchStandingsHistory(); err == nil && raw != nil {
+			history, _ = watch.SanitizeHistory(*raw)
+		}
+		capture.Observe("history", time.Since(t))
+		meta := capture.Done()
+		if err := rec.Frame(meta, si, standings, history); err != nil {
+			return err
+		}
+		if up != nil && rec.SessionID() != uploaded {
+			if lib.PhaseOf(si) != lib.PhaseOver {
+				overAt = time.Time{}
+			} else if overAt.IsZero() {
+				overAt = now
+			}
+			if !overAt.IsZero() && (allFinished(standings) || now.Sub(overAt) >= time.Minute) {
+				uploaded = rec.SessionID()
+				if err := queueResults(up, db, rec); err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				}
+			}
+		}
+		if trace != nil {
+			if err := rec.Inputs(trace.Take()); err != nil {
+				return err
+			}
+		}
+		snap := events.Snapshot{At: now, Standings: standings, Session: si, Meta: meta, Clock: clock}
+		evs := append(start.Process(snap), det.Process(snap)...)
+		evs = append(evs, incidents.Process(snap)...)
+		evs = append(evs, stewards.Process(snap)...)
+		if err := elog.Write(evs...); err != nil {
+			return err
+		}
+		for _, e := range evs {
+			if err := rec.Event(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// queueResults saves the current session's results and queues them for
+// upload.
+func queueResults(up *upload.Uploader, db *store.Store, rec *store.Recorder) error {
+	if err := rec.SaveResults(); err != nil {
+		return err
+	}
+	sess, err := db.Session(rec.SessionID())
+	if err != nil {
+		return err
+	}
+	return queueSession(up, db, sess)
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/replay"
+)
+
+func runReplays(args []string) error {
+	fs := flag.NewFlagSet("replays", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	auto := fs.Bool("auto", false, "Keep running and rename each new replay after its session")
+	interval := fs.Duration("interval", 5*time.Second, "Poll interval for -auto")
+	fs.Parse(args)
+
+	client, _, err := api.client()
+	if err != nil {
+		return err
+	}
+	if *auto {
+		return autoName(client, *interval)
+	}
+	replays, err := replay.List(client)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Date\tTrack\tSession\tSize\tName")
+	for _, r := range replays {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%.1f MB\t%s\n", r.Time.Local().Format("2006-01-02 15:04"), r.Track, r.Session, float64(r.Size)/1e6, r.Name)
+	}
+	return w.Flush()
+}
+
+// autoName remembers the last session seen and, whenever a replay appears
+// that was not listed before, renames it after that session.
+func autoName(client *lib.Client, interval time.Duration) error {
+	folder, err := replay.Folder(client)
+	if err != nil {
+		return err
+	}
+	seen := map[string]bool{}
+	if replays, err := replay.List(client); err == nil {
+		for _, r := range replays {
+			seen[r.Name] = true
+		}
+	}
+
+	var last *replay.Meta
+	loop := run.New(interval)
+	fmt.Fprintf(os.Stderr, "Watching %s for new replays (Ctrl+C to stop)\n", folder)
+	return loop.Poll(func(time.Time) error {
+		if m := sessionMeta(client); m != nil {
+			if last == nil || last.Track != m.Track || last.Session != m.Session {
+				m.Date = time.Now()
+			} else {
+				m.Date = last.Date
+			}
+			last = m
+		}
+		replays, err := replay.List(client)
+		if err != nil {
+			return nil
+		}
+		for _, r := range replays {
+			if seen[r.Name] {
+				continue
+			}
+			seen[r.Name] = true
+			if last == nil {
+				fmt.Fprintf(os.Stderr, "%s: no session seen, leaving the name\n", r.Name)
+				continue
+			}
+			path, err := r.Path(folder)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%v\n", err)
+				continue
+			}
+			to, err := replay.Rename(path, last.FileName())
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "rename %s: %v\n", path, err)
+				continue
+			}
+			// the renamed file shows up in the next listing
+			base := filepath.Base(to)
+			seen[base] = true
+			seen[strings.TrimSuffix(base, filepath.Ext(base))] = true
+			fmt.Printf("%s → %s\n", r.Name, base)
+		}
+		return nil
+	})
+}
+
+// sessionMeta describes the running session, or nil outside a session.
+func sessionMeta(client *lib.Client) *replay.Meta {
+	si, err := client.RestWatchSessionInfo()
+	if err != nil || si.TrackName == "" {
+		return nil
+	}
+	m := &replay.Meta{Track: si.TrackName, Session: si.Session}
+	if standings, err := client.RestWatchStandings(); err == nil {
+		for _, s := range standings {
+			if s.Player {
+				m.Position = int(s.Position)
+				m.Class = carclass.Lookup(s.CarClass).Abbrev
+			}
+		}
+	}
+	return m
+}
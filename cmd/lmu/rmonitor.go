@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib/feed"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+func runRMonitor(args []string) error {
+	fs := flag.NewFlagSet("rmonitor", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	interval := fs.Duration("interval", 1*time.Second, "Poll interval")
+	adaptive := fs.Bool("adaptive", false, run.AdaptiveUsage)
+	health := addHealthFlag(fs)
+	listen := fs.String("listen", ":50000", "Address timing displays connect to")
+	fs.Parse(args)
+
+	client, _, err := api.client()
+	if err != nil {
+		return err
+	}
+	srv, err := feed.Listen(*listen)
+	if err != nil {
+		return err
+	}
+	loop := run.New(*interval)
+	adapt(loop, *adaptive)
+	loop.Defer(srv.Close)
+	rm := feed.NewRMonitor()
+	srv.SetGreeting(rm.Greeting)
+	fmt.Fprintf(os.Stderr, "Serving RMonitor on %s\n", srv.Addr())
+
+	brk := watch.NewBreaker()
+	serveHealth(loop, *health, brk)
+	return loop.Poll(func(now time.Time) error {
+		if !brk.Allow(now) {
+			return nil
+		}
+		standings, err := client.RestWatchStandings()
+		brk.Record(now, err)
+		if err == nil {
+			standings, _ = watch.SanitizeStandings(standings)
+			si, _ := client.RestWatchSessionInfo()
+			loop.Adapt(watch.Classify(si, standings))
+			srv.Write(rm.Frame(now, si, standings))
+		} else if !watch.IsConnError(err) {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/lobby"
+)
+
+func runServers(args []string) error {
+	fs := flag.NewFlagSet("servers", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	file := fs.String("file", lobby.DefaultPath(), "Servers file")
+	track := fs.String("track", "", "Only servers on this track")
+	class := fs.String("class", "", "Only servers running this class")
+	region := fs.String("region", "", "Only servers in this region")
+	password := fs.String("password", "", "open or locked")
+	join := fs.String("join", "", "Join the server with this name or host:port")
+	pass := fs.String("pass", "", "Password for -join (default from the servers file)")
+	fs.Parse(args)
+
+	client, _, err := api.client()
+	if err != nil {
+		return err
+	}
+	servers, err := lobby.Load(*file)
+	if err != nil {
+		return err
+	}
+	if len(servers) == 0 {
+		return fmt.Errorf("no servers in %s", *file)
+	}
+
+	if *join != "" {
+		s, ok := lobby.Find(servers, *join)
+		if !ok {
+			return fmt.Errorf("no server %q in %s", *join, *file)
+		}
+		return joinServer(client, s, *pass)
+	}
+
+	if *password != "" && *password != "open" && *password != "locked" {
+		return fmt.Errorf("-password must be open or locked")
+	}
+	f := lobby.Filter{Track: *track, Class: *class, Region: *region, Password: *password}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Name\tTrack\tClasses\tRegion\tPw\tAddress")
+	for _, s := range f.Apply(servers) {
+		pw := ""
+		if s.Protected() {
+			pw = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", s.Name, s.Track, strings.Join(s.Classes, ","), s.Region, pw, s.Addr())
+	}
+	return w.Flush()
+}
+
+// joinServer sends the join request and prints the join state as it
+// changes, until it has not changed for five seconds or 30 seconds pass.
+func joinServer(client *lib.Client, s lobby.Server, password string) error {
+	opts := s.JoinOptions(password)
+	if s.Locked && opts.Password == "" {
+		return fmt.Errorf("%s is password protected, pass -pass", s.Name)
+	}
+	fmt.Printf("Joining %s (%s)\n", s.Name, s.Addr())
+	if err := client.Join(opts); err != nil {
+		return err
+	}
+	last, changed := "", time.Now()
+	deadline := changed.Add(30 * time.Second)
+	for time.Now().Before(deadline) && time.Since(changed) < 5*time.Second {
+		state, err := client.RestMultiplayerJoinState()
+		if err == nil && state != last {
+			fmt.Printf("  %s\n", state)
+			last, changed = state, time.Now()
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	return nil
+}
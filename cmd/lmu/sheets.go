@@ -0,0 +1,188 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/feed"
+	"github.com/snipem/go-lmu-api/lib/sheets"
+	"github.com/snipem/go-lmu-api/lib/store"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+var (
+	lapHeader    = []interface{}{"Time", "Track", "Session", "Car", "Class", "Vehicle", "Team", "Driver", "Driver ID", "Lap", "Lap time", "S1", "S2", "S3", "Pit", "Position", "Class position"}
+	resultHeader = []interface{}{"Date", "Track", "Session", "Position", "Class position", "Car", "Class", "Vehicle", "Driver", "Driver ID", "Laps", "Best lap", "Pit stops", "Status"}
+)
+
+func runSheets(args []string) error {
+	fs := flag.NewFlagSet("sheets", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	interval := fs.Duration("interval", 1*time.Second, "Poll interval")
+	adaptive := fs.Bool("adaptive", false, run.AdaptiveUsage)
+	health := addHealthFlag(fs)
+	spreadsheet := fs.String("spreadsheet", "", "Spreadsheet id from the sheet's URL (default: config sheets.spreadsheet)")
+	credentials := fs.String("credentials", "", "Service account key file (default: config sheets.credentials)")
+	header := fs.Bool("header", false, "Append a header row to both tabs first")
+	fs.Parse(args)
+
+	client, cfg, err := api.client()
+	if err != nil {
+		return err
+	}
+	sc := cfg.Sheets
+	if *spreadsheet != "" {
+		sc.Spreadsheet = *spreadsheet
+	}
+	if *credentials != "" {
+		sc.Credentials = *credentials
+	}
+	if sc.Laps == "" {
+		sc.Laps = "Laps"
+	}
+	if sc.Results == "" {
+		sc.Results = "Results"
+	}
+	if sc.Spreadsheet == "" || sc.Credentials == "" {
+		return fmt.Errorf("sheets needs -spreadsheet and -credentials, or the sheets config")
+	}
+	creds, err := sheets.LoadCredentials(sc.Credentials)
+	if err != nil {
+		return err
+	}
+	sheet, err := sheets.NewClient(creds, sc.Spreadsheet)
+	if err != nil {
+		return err
+	}
+	if *header {
+		if err := sheet.Append(sc.Laps, [][]interface{}{lapHeader}); err != nil {
+			return err
+		}
+		if err := sheet.Append(sc.Results, [][]interface{}{resultHeader}); err != nil {
+			return err
+		}
+	}
+
+	// Rows that failed to append are kept and retried with the next ones
+	// after a pause, so a network hiccup does not lose laps.
+	var pendingLaps, pendingResults [][]interface{}
+	var retryAt time.Time
+	flush := func(now time.Time) {
+		if now.Before(retryAt) {
+			return
+		}
+		failed := false
+		if err := sheet.Append(sc.Laps, pendingLaps); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			failed = true
+		} else {
+			pendingLaps = nil
+		}
+		if err := sheet.Append(sc.Results, pendingResults); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			failed = true
+		} else {
+			pendingResults = nil
+		}
+		if failed {
+			retryAt = now.Add(30 * time.Second)
+		}
+	}
+
+	det := feed.NewLapDetector()
+	var (
+		slots     watch.SlotTracker
+		last      []lib.RestWatchStandingsResponseItem
+		lastSI    *lib.RestWatchSessionInfoResponse
+		published bool // results of the current session are out
+	)
+	publish := func() {
+		if published || lastSI == nil || len(last) == 0 {
+			return
+		}
+		published = true
+		date := time.Now().Format("2006-01-02 15:04")
+		for _, r := range store.Results(last) {
+			pendingResults = append(pendingResults, []interface{}{date, lastSI.TrackName, lastSI.Session,
+				r.Position, r.ClassPosition, r.CarNumber, r.CarClass, r.Car, r.Driver, r.DriverID,
+				r.Laps, round3(r.BestLap), r.Pitstops, r.FinishStatus})
+		}
+	}
+
+	loop := run.New(*interval)
+	adapt(loop, *adaptive)
+	loop.Defer(func() error {
+		publish()
+		retryAt = time.Time{}
+		flush(time.Now())
+		if len(pendingLaps)+len(pendingResults) > 0 {
+			return fmt.Errorf("%d rows could not be appended", len(pendingLaps)+len(pendingResults))
+		}
+		return nil
+	})
+	brk := watch.NewBreaker()
+	serveHealth(loop, *health, brk)
+	fmt.Fprintf(os.Stderr, "Appending to spreadsheet %s (Ctrl+C to stop)\n", sc.Spreadsheet)
+	return loop.Poll(func(now time.Time) error {
+		if !brk.Allow(now) {
+			return nil
+		}
+		standings, err := client.RestWatchStandings()
+		brk.Record(now, err)
+		if err != nil {
+			if !watch.IsConnError(err) {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			return nil
+		}
+		standings, _ = watch.SanitizeStandings(standings)
+		si, err := client.RestWatchSessionInfo()
+		if err != nil {
+			return nil
+		}
+		loop.Adapt(watch.Classify(si, standings))
+		// The previous session's last frame is its classification
+		if c := slots.Update(standings, si); c.NewSession {
+			publish()
+			published = false
+		}
+		var history map[string][]lib.RestWatchStandingsHistoryResponseItemItem
+		if raw, err := client.RestWatchStandingsHistory(); err == nil && raw != nil {
+			history, _ = watch.SanitizeHistory(*raw)
+		}
+		for _, l := range det.Frame(now, si, standings, history) {
+			pendingLaps = append(pendingLaps, []interface{}{l.At.Local().Format("2006-01-02 15:04:05"), l.Track, l.Session,
+				l.Car.Number, l.Car.Class, l.Car.Vehicle, l.Car.Team, l.Driver.Name, l.Driver.ID, l.Lap,
+				round3(l.LapTime), round3(l.Sectors[0]), round3(l.Sectors[1]), round3(l.Sectors[2]), l.Pit, l.Position, l.ClassPosition})
+		}
+		if len(standings) > 0 {
+			last, lastSI = standings, si
+		}
+		if lib.PhaseOf(si) == lib.PhaseOver && allFinished(standings) {
+			publish()
+		}
+		if len(pendingLaps)+len(pendingResults) > 0 {
+			flush(now)
+		}
+		return nil
+	})
+}
+
+// allFinished reports whether every car has taken the flag or retired.
+func allFinished(standings []lib.RestWatchStandingsResponseItem) bool {
+	for _, s := range standings {
+		if s.FinishStatus == "" || s.FinishStatus == "FSTAT_NONE" || s.FinishStatus == "NONE" {
+			return false
+		}
+	}
+	return len(standings) > 0
+}
+
+// round3 rounds seconds to milliseconds so the sheet shows clean numbers.
+func round3(sec float64) float64 {
+	return float64(int64(sec*1000+0.5)) / 1000
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/sqlite"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/analysis"
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/rating"
+	"github.com/snipem/go-lmu-api/lib/store"
+)
+
+const statsUsage = `Usage: lmu stats <pb|h2h|finishes|cars|laps|records|ratings|compare> [flags]
+
+  pb        personal bests per track and car
+  h2h       head-to-head record against a rival (-rival name)
+  finishes  average finishing position, wins and podiums per class
+  cars      laps driven per car
+  laps      laps of a session with sectors, validity and theoretical best (-session id, -csv)
+  records   all-time lap records per track, class and car (-csv, -installed)
+  ratings   Elo ratings of every driver per class from the race results (-class, -min-races, -csv)
+  compare   pace, consistency and top speed per driver against an earlier session at the track, as Markdown (-session, -against)
+`
+
+func runStats(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, statsUsage)
+		os.Exit(2)
+	}
+	sub := args[0]
+	fs := flag.NewFlagSet("stats "+sub, flag.ExitOnError)
+	dbPath := fs.String("db", store.DefaultPath(), "History database")
+	driver := fs.String("driver", "", "Driver name or id (default: the player)")
+	rival := fs.String("rival", "", "Rival driver name or id (h2h)")
+	track := fs.String("track", "", "Only this track (pb, laps, records, ratings)")
+	car := fs.String("car", "", "Only this car (pb, laps)")
+	class := fs.String("class", "", "Only this class (ratings)")
+	minRaces := fs.Int("min-races", 1, "Leave out drivers with fewer races (ratings)")
+	k := fs.Float64("k", rating.DefaultK, "How far one race moves a rating (ratings)")
+	session := fs.Int64("session", 0, "Session id (laps, compare; default: the latest)")
+	against := fs.Int64("against", 0, "Earlier session id (compare; default: the previous one of the same kind at the track)")
+	asCSV := fs.Bool("csv", false, "Write CSV (laps, records, ratings)")
+	installed := fs.Bool("installed", false, "List every installed track, with records or not (records; asks the game at -base)")
+	base := fs.String("base", "http://localhost:6397", "Base URL of the API (records -installed)")
+	fs.Parse(args[1:])
+
+	db, err := sqlite.Open(*dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// The records, ratings and comparisons are everyone's
+	var me string
+	if sub != "records" && sub != "ratings" && sub != "compare" {
+		if me, err = resolveDriver(db, *driver); err != nil {
+			return err
+		}
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	switch sub {
+	case "pb":
+		laps, err := db.BestLaps(store.Filter{DriverID: me, Track: *track, Car: *car})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, "TRACK\tCAR\tCLASS\tBEST\tS1\tS2\tS3\tDATE")
+		for _, b := range laps {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.3f\t%.3f\t%.3f\t%s\n", b.Track, b.Car, b.CarClass,
+				fmtLap(b.LapTime), b.S1, b.S2, b.S3, b.Date.Local().Format("2006-01-02"))
+		}
+	case "h2h":
+		if *rival == "" {
+			return fmt.Errorf("h2h needs -rival")
+		}
+		them, err := db.ResolveDriver(*rival)
+		if err != nil {
+			return err
+		}
+		h, err := db.HeadToHead(me, them)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "\tYOU\tRIVAL\n")
+		fmt.Fprintf(w, "Sessions together\t%d\t%d\n", h.Sessions, h.Sessions)
+		fmt.Fprintf(w, "Finished ahead\t%d\t%d\n", h.AheadA, h.AheadB)
+		fmt.Fprintf(w, "Faster best lap\t%d\t%d\n", h.FasterLapA, h.FasterLapB)
+		fmt.Fprintf(w, "Avg position\t%.1f\t%.1f\n", h.AvgPosA, h.AvgPosB)
+	case "finishes":
+		stats, err := db.Finishes(me)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, "CLASS\tRACES\tAVG POS\tAVG CLASS POS\tWINS\tPODIUMS\tDNF")
+		for _, f := range stats {
+			fmt.Fprintf(w, "%s\t%d\t%.1f\t%.1f\t%d\t%d\t%d\n", f.CarClass, f.Races, f.AvgPosition, f.AvgClassPos, f.Wins, f.Podiums, f.DNFs)
+		}
+	case "cars":
+		cars, err := db.LapsPerCar(me)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, "CAR\tCLASS\tSESSIONS\tLAPS\tBEST")
+		for _, c := range cars {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", c.Car, c.CarClass, c.Sessions, c.Laps, fmtLap(c.Best))
+		}
+	case "laps":
+		laps, err := db.Laps(store.Filter{DriverID: me, Track: *track, Car: *car}, *session)
+		if err != nil {
+			return err
+		}
+		if *asCSV {
+			cw := csv.NewWriter(os.Stdout)
+			cw.Write([]string{"session", "driver", "car", "lap", "lap_time", "s1", "s2", "s3", "position", "pit", "fcy", "gap", "kind", "vmax", "invalid"})
+			for _, l := range laps {
+				cw.Write([]string{strconv.FormatInt(l.SessionID, 10), l.Driver, l.Car, strconv.Itoa(l.Lap),
+					fmt.Sprintf("%.3f", l.LapTime), fmt.Sprintf("%.3f", l.S1), fmt.Sprintf("%.3f", l.S2), fmt.Sprintf("%.3f", l.S3),
+					strconv.Itoa(l.Position), strconv.FormatBool(l.Pit), strconv.FormatBool(l.FCY), fmt.Sprintf("%.2f", l.Gap), string(l.Kind), fmt.Sprintf("%.1f", l.Vmax), l.Invalid})
+			}
+			cw.Flush()
+			return cw.Error()
+		}
+		fmt.Fprintln(w, "LAP\tTIME\tS1\tS2\tS3\tPOS\tVMAX\tKIND\tINVALID")
+		var car []analysis.Lap
+		for i, l := range laps {
+			fmt.Fprintf(w, "%d\t%s\t%.3f\t%.3f\t%.3f\t%d\t%.0f\t%s\t%s\n", l.Lap, fmtLap(l.LapTime), l.S1, l.S2, l.S3, l.Position, l.Vmax, l.Kind, l.Invalid)
+			car = append(car, l.Analysis())
+			if i == len(laps)-1 || laps[i+1].SlotID != l.SlotID {
+				if id, ok := analysis.DefaultSectorFilter.TheoreticalBest(car); ok {
+					fmt.Fprintf(w, "ideal\t%s\t%.3f\t%.3f\t%.3f\t\t\t\tsectors from laps %d, %d, %d\n",
+						fmtLap(id.Time), id.Sectors[0], id.Sectors[1], id.Sectors[2], id.Laps[0], id.Laps[1], id.Laps[2])
+				}
+				car = nil
+			}
+		}
+	case "records":
+		var tracks []string
+		if *installed {
+			if tracks, err = installedTracks(*base); err != nil {
+				return err
+			}
+		}
+		return trackRecords(db, w, *track, tracks, *asCSV)
+	case "ratings":
+		return driverRatings(db, w, store.Filter{Track: *track}, *class, *minRaces, *k, *asCSV)
+	case "compare":
+		return compareSessions(db, w, *session, *against)
+	default:
+		fmt.Fprint(os.Stderr, statsUsage)
+		os.Exit(2)
+	}
+	return nil
+}
+
+// driverRatings writes the Elo leaderboard of each class, or only of
+// class, rating the race results in the order they were recorded.
+func driverRatings(db *store.Store, w io.Writer, f store.Filter, class string, minRaces int, k float64, asCSV bool) error {
+	all, err := db.RaceResults(f)
+	if err != nil {
+		return err
+	}
+	var results []store.Result
+	for _, r := range all {
+		if class == "" || carclass.Lookup(r.CarClass).Name == carclass.Lookup(class).Name {
+			results = append(results, r)
+		}
+	}
+	tables := map[string]*rating.Table{}
+	var classes []string
+	var race []rating.Finisher
+	for i, r := range results {
+		race = append(race, rating.Finisher{ID: r.DriverID, Name: r.Driver, Place: r.ClassPosition})
+		if i+1 < len(results) && results[i+1].SessionID == r.SessionID && results[i+1].CarClass == r.CarClass {
+			continue
+		}
+		t := tables[r.CarClass]
+		if t == nil {
+			t = rating.New()
+			t.K = k
+			tables[r.CarClass] = t
+			classes = append(classes, r.CarClass)
+		}
+		t.Add(race)
+		race = nil
+	}
+	carclass.Default().Sort(classes)
+
+	var cw *csv.Writer
+	if asCSV {
+		cw = csv.NewWriter(os.Stdout)
+		cw.Write([]string{"class", "rank", "driver", "driver_id", "rating", "races", "wins", "peak", "last_change"})
+	}
+	for n, cls := range classes {
+		rank := 0
+		for _, r := range tables[cls].Ratings() {
+			if r.Races < minRaces {
+				continue
+			}
+			rank++
+			if cw != nil {
+				cw.Write([]string{cls, strconv.Itoa(rank), r.Name, r.ID, fmt.Sprintf("%.0f", r.Rating),
+					strconv.Itoa(r.Races), strconv.Itoa(r.Wins), fmt.Sprintf("%.0f", r.Peak), fmt.Sprintf("%+.0f", r.Change)})
+				continue
+			}
+			if rank == 1 {
+				if n > 0 {
+					fmt.Fprintln(w)
+				}
+				fmt.Fprintf(w, "%s\n#\tDRIVER\tRATING\tRACES\tWINS\tPEAK\tLAST\n", cls)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%.0f\t%d\t%d\t%.0f\t%+.0f\n", rank, r.Name, r.Rating, r.Races, r.Wins, r.Peak, r.Change)
+		}
+	}
+	if cw != nil {
+		cw.Flush()
+		return cw.Error()
+	}
+	return nil
+}
+
+// trackRecords writes the class and car records of each track; tracks
+// lists tracks to include even without records.
+func trackRecords(db *store.Store, w io.Writer, track string, tracks []string, asCSV bool) error {
+	f := store.Filter{Track: track}
+	classes, err := db.ClassRecords(f)
+	if err != nil {
+		return err
+	}
+	cars, err := db.CarRecords(f)
+	if err != nil {
+		return err
+	}
+	type record struct {
+		kind string
+		store.BestLap
+	}
+	byTrack := map[string][]record{}
+	add := func(kind string, laps []store.BestLap) {
+		for _, b := range laps {
+			k := strings.ToLower(b.Track)
+			if _, ok := byTrack[k]; !ok {
+				tracks = append(tracks, b.Track)
+			}
+			byTrack[k] = append(byTrack[k], record{kind, b})
+		}
+	}
+	add("class", classes)
+	add("car", cars)
+
+	seen := map[string]bool{}
+	var cw *csv.Writer
+	if asCSV {
+		cw = csv.NewWriter(os.Stdout)
+		cw.Write([]string{"track", "record", "class", "car", "lap_time", "s1", "s2", "s3", "driver", "session", "date"})
+	} else {
+		fmt.Fprintln(w, "TRACK\tRECORD\tCLASS\tCAR\tTIME\tDRIVER\tDATE")
+	}
+	for _, t := range tracks {
+		k := strings.ToLower(t)
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		if len(byTrack[k]) == 0 && !asCSV {
+			fmt.Fprintf(w, "%s\t-\t\t\t\tno laps recorded\t\n", t)
+		}
+		for _, r := range byTrack[k] {
+			if asCSV {
+				cw.Write([]string{r.Track, r.kind, r.CarClass, r.Car, fmt.Sprintf("%.3f", r.LapTime), fmt.Sprintf("%.3f", r.S1),
+					fmt.Sprintf("%.3f", r.S2), fmt.Sprintf("%.3f", r.S3), r.Driver, r.Session, r.Date.Format(time.RFC3339)})
+				continue
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Track, r.kind, r.CarClass, r.Car, fmtLap(r.LapTime), r.Driver, r.Date.Local().Format("2006-01-02"))
+		}
+	}
+	if cw != nil {
+		cw.Flush()
+		return cw.Error()
+	}
+	return nil
+}
+
+// installedTracks asks the game for the tracks it has installed.
+func installedTracks(base string) ([]string, error) {
+	list, err := lib.NewClient(base).RestRaceTrack()
+	if err != nil {
+		return nil, fmt.Errorf("list installed tracks: %w", err)
+	}
+	var out []string
+	for _, t := range list {
+		out = append(out, t.Name)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+func resolveDriver(db *store.Store, query string) (string, error) {
+	if query == "" {
+		return db.PlayerID()
+	}
+	return db.ResolveDriver(query)
+}
+
+func fmtLap(t float64) string {
+	if t <= 0 {
+		return "-"
+	}
+	mins := int(t) / 60
+	return fmt.Sprintf("%d:%06.3f", mins, t-float64(mins*60))
+}
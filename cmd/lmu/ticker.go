@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib/events"
+	"github.com/snipem/go-lmu-api/lib/feed"
+	"github.com/snipem/go-lmu-api/lib/locale"
+	"github.com/snipem/go-lmu-api/lib/timesync"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// tickerLine is a ticker line in -json output.
+type tickerLine struct {
+	At   time.Time   `json:"at"`
+	Kind events.Kind `json:"kind"`
+	Lap  int         `json:"lap,omitempty"`
+	Text string      `json:"text"`
+}
+
+func runTicker(args []string) error {
+	fs := flag.NewFlagSet("ticker", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	interval := fs.Duration("interval", 1*time.Second, "Poll interval")
+	adaptive := fs.Bool("adaptive", false, run.AdaptiveUsage)
+	listen := fs.String("listen", "", "Serve the ticker to TCP clients at this address instead of stdout, e.g. :5001")
+	asJSON := fs.Bool("json", false, "Write JSON lines with the event kind and lap instead of plain text")
+	eventsLog := events.AddLogFlag(fs)
+	fs.Parse(args)
+
+	client, cfg, err := api.client()
+	if err != nil {
+		return err
+	}
+	loc, err := locale.Get(cfg.Language)
+	if err != nil {
+		return err
+	}
+	ticker, err := events.NewTicker(loc, cfg.Ticker)
+	if err != nil {
+		return err
+	}
+	loop := run.New(*interval)
+	adapt(loop, *adaptive)
+	var out io.Writer = os.Stdout
+	if *listen != "" {
+		srv, err := feed.Listen(*listen)
+		if err != nil {
+			return err
+		}
+		loop.Defer(srv.Close)
+		fmt.Fprintf(os.Stderr, "Serving the ticker on %s\n", srv.Addr())
+		out = srv
+	}
+
+	elog, err := events.OpenLog(*eventsLog, "lmu ticker")
+	if err != nil {
+		return err
+	}
+	loop.Defer(elog.Close)
+	clock := timesync.New()
+	elog.Sync(clock)
+
+	det := events.NewDetector()
+	countdown := events.NewCountdown(cfg.Countdowns)
+	incidents := events.NewIncidents()
+	stewards := events.NewStewards(cfg.Countdowns.PitWindow)
+	limits := events.NewTrackLimits(cfg.TrackLimits)
+	start := events.NewStart()
+	enc := json.NewEncoder(out)
+	brk := watch.NewBreaker()
+	return loop.Poll(func(now time.Time) error {
+		if !brk.Allow(now) {
+			return nil
+		}
+		standings, err := client.RestWatchStandings()
+		brk.Record(now, err)
+		if err != nil {
+			if !watch.IsConnError(err) {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			return nil
+		}
+		standings, _ = watch.SanitizeStandings(standings)
+		t := time.Now()
+		si, _ := client.RestWatchSessionInfo()
+		if si != nil {
+			clock.ObserveRequest(t, time.Now(), si.CurrentEventTime)
+		}
+		loop.Adapt(watch.Classify(si, standings))
+		snap := events.Snapshot{At: now, Standings: standings, Session: si, Clock: clock}
+		if raw, err := client.RestWatchStandingsHistory(); err == nil && raw != nil {
+			snap.History, _ = watch.SanitizeHistory(*raw)
+		}
+		var evs []events.Event
+		evs = append(evs, det.Process(snap)...)
+		evs = append(evs, start.Process(snap)...)
+		evs = append(evs, countdown.Process(snap)...)
+		evs = append(evs, incidents.Process(snap)...)
+		evs = append(evs, stewards.Process(snap)...)
+		evs = append(evs, limits.Process(snap)...)
+		if err := elog.Write(evs...); err != nil {
+			return err
+		}
+		for _, e := range evs {
+			text, err := ticker.Line(e)
+			if err != nil {
+				return err
+			}
+			if text == "" {
+				continue
+			}
+			if *asJSON {
+				err = enc.Encode(tickerLine{At: e.At, Kind: e.Kind, Lap: e.Lap, Text: text})
+			} else {
+				_, err = fmt.Fprintln(out, text)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
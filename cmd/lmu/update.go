@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+)
+
+// version is set by release builds (make release).
+var version = "dev"
+
+const releaseRepo = "snipem/go-lmu-api"
+
+func runVersion(args []string) error {
+	fs := flag.NewFlagSet("version", flag.ExitOnError)
+	fs.Parse(args)
+	v := version
+	if v == "dev" {
+		if bi, ok := debug.ReadBuildInfo(); ok {
+			for _, s := range bi.Settings {
+				if s.Key == "vcs.revision" && len(s.Value) >= 12 {
+					v = "dev-" + s.Value[:12]
+				}
+			}
+		}
+	}
+	fmt.Printf("lmu %s %s/%s\n", v, runtime.GOOS, runtime.GOARCH)
+	return nil
+}
+
+// release is the part of GitHub's release object selfupdate needs.
+type release struct {
+	TagName string `json:"tag_name"`
+	Assets  []struct {
+		Name string `json:"name"`
+		URL  string `json:"browser_download_url"`
+	} `json:"assets"`
+}
+
+func (r *release) asset(name string) string {
+	for _, a := range r.Assets {
+		if a.Name == name {
+			return a.URL
+		}
+	}
+	return ""
+}
+
+func runSelfUpdate(args []string) error {
+	fs := flag.NewFlagSet("selfupdate", flag.ExitOnError)
+	repo := fs.String("repo", releaseRepo, "GitHub repository to update from")
+	tag := fs.String("version", "", "Install this release tag instead of the latest")
+	check := fs.Bool("check", false, "Only report whether an update is available")
+	force := fs.Bool("force", false, "Reinstall even when already up to date")
+	fs.Parse(args)
+
+	rel, err := fetchRelease(*repo, *tag)
+	if err != nil {
+		return err
+	}
+	if rel.TagName == version && !*force {
+		fmt.Printf("lmu %s is up to date\n", version)
+		return nil
+	}
+	if *check {
+		fmt.Printf("Update available: %s -> %s\n", version, rel.TagName)
+		return nil
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	if self, err = filepath.EvalSymlinks(self); err != nil {
+		return err
+	}
+	dir, ext := filepath.Dir(self), filepath.Ext(self)
+
+	sums, err := checksums(rel)
+	if err != nil {
+		return err
+	}
+	updated := 0
+	for _, tool := range releasedTools(sums) {
+		local := filepath.Join(dir, tool+ext)
+		if _, err := os.Stat(local); err != nil {
+			continue // not installed here
+		}
+		name := assetName(tool)
+		url := rel.asset(name)
+		if url == "" {
+			fmt.Fprintf(os.Stderr, "%s: no %s in release %s, skipped\n", tool, name, rel.TagName)
+			continue
+		}
+		if err := replace(local, url, sums[name]); err != nil {
+			return fmt.Errorf("%s: %w", tool, err)
+		}
+		fmt.Printf("Updated %s to %s\n", local, rel.TagName)
+		updated++
+	}
+	if updated == 0 {
+		return fmt.Errorf("no tools updated in %s", dir)
+	}
+	return nil
+}
+
+func fetchRelease(repo, tag string) (*release, error) {
+	url := "https://api.github.com/repos/" + repo + "/releases/latest"
+	if tag != "" {
+		url = "https://api.github.com/repos/" + repo + "/releases/tags/" + tag
+	}
+	data, err := download(url)
+	if err != nil {
+		return nil, err
+	}
+	var rel release
+	if err := json.Unmarshal(data, &rel); err != nil {
+		return nil, fmt.Errorf("release info: %w", err)
+	}
+	return &rel, nil
+}
+
+// checksums reads the release's checksums.txt ("<sha256>  <name>" lines).
+func checksums(rel *release) (map[string]string, error) {
+	url := rel.asset("checksums.txt")
+	if url == "" {
+		return nil, fmt.Errorf("release %s has no checksums.txt", rel.TagName)
+	}
+	data, err := download(url)
+	if err != nil {
+		return nil, err
+	}
+	sums := make(map[string]string)
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for sc.Scan() {
+		if f := strings.Fields(sc.Text()); len(f) == 2 {
+			sums[f[1]] = f[0]
+		}
+	}
+	return sums, sc.Err()
+}
+
+// assetName is the release file name of a tool for this platform, as
+// cmd/release names it.
+func assetName(tool string) string {
+	name := tool + "-" + runtime.GOOS + "-" + runtime.GOARCH
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// releasedTools returns the commands a release has binaries of for this
+// platform, by the names in its checksums.txt, so tools added in later
+// releases are updated too.
+func releasedTools(sums map[string]string) []string {
+	suffix := assetName("")
+	var out []string
+	for name := range sums {
+		if tool, ok := strings.CutSuffix(name, suffix); ok && tool != "" {
+			out = append(out, tool)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// replace downloads a binary, checks its sum and swaps it in for path. The
+// old file is renamed rather than overwritten, which Windows allows for a
+// running executable; the .old copy is removed on the next update.
+func replace(path, url, sum string) error {
+	if sum == "" {
+		return fmt.Errorf("no checksum for %s", filepath.Base(url))
+	}
+	data, err := download(url)
+	if err != nil {
+		return err
+	}
+	h := sha256.Sum256(data)
+	if got := hex.EncodeToString(h[:]); got != sum {
+		return fmt.Errorf("checksum mismatch for %s", filepath.Base(url))
+	}
+	tmp := path + ".new"
+	if err := os.WriteFile(tmp, data, 0o755); err != nil {
+		return err
+	}
+	old := path + ".old"
+	os.Remove(old)
+	if err := os.Rename(path, old); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Rename(old, path)
+		return err
+	}
+	return nil
+}
+
+var httpClient = &http.Client{Timeout: 2 * time.Minute}
+
+func download(url string) ([]byte, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/export"
+	"github.com/snipem/go-lmu-api/cmd/internal/sqlite"
+	"github.com/snipem/go-lmu-api/cmd/internal/upload"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/store"
+)
+
+// runUpload sends the queued results to the league's results API, after
+// queueing the given files or recorded session.
+func runUpload(args []string) error {
+	fs := flag.NewFlagSet("upload", flag.ExitOnError)
+	cfgPath := fs.String("config", config.DefaultPath(), "Config file, for the upload settings")
+	dbPath := fs.String("db", store.DefaultPath(), "History database")
+	session := fs.Int64("session", -1, "Queue this recorded session first (0 = the latest with results)")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: lmu upload [flags] [results.json...]\n\nQueues the files and the session, then sends everything queued.\n\n")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	cfg, err := config.Load(*cfgPath)
+	if err != nil {
+		return err
+	}
+	u, err := newUploader(cfg.Upload, *dbPath)
+	if err != nil {
+		return err
+	}
+	for _, path := range fs.Args() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := u.Add(filepath.Base(path), data); err != nil {
+			return err
+		}
+	}
+	if *session >= 0 {
+		db, err := sqlite.Open(*dbPath)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		sess, err := db.Session(*session)
+		if err != nil {
+			return err
+		}
+		if err := queueSession(u, db, sess); err != nil {
+			return err
+		}
+	}
+
+	sent, err := u.Flush(time.Now())
+	pending, _ := u.Pending()
+	fmt.Printf("Uploaded %d, %d waiting in %s\n", sent, len(pending), u.Dir)
+	return err
+}
+
+// newUploader uses the upload directory next to the history database when
+// the config names no queue.
+func newUploader(cfg config.Upload, dbPath string) (*upload.Uploader, error) {
+	return upload.New(cfg, filepath.Join(filepath.Dir(dbPath), "upload"))
+}
+
+// queueSession queues a recorded session's results in the lmu export
+// layout, named as an ACC server would.
+func queueSession(u *upload.Uploader, db *store.Store, sess store.Session) error {
+	res, err := exportACC(db, sess)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return err
+	}
+	return u.Add(export.FileName(sess), data)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// runWeather prints the forecast as an even timeline from now: rain chance
+// and where the track wetness is heading. With -http it keeps it up to
+// date as JSON for dashboards and overlays to chart.
+func runWeather(args []string) error {
+	fs := flag.NewFlagSet("weather", flag.ExitOnError)
+	api := addAPIFlags(fs)
+	span := fs.Duration("span", time.Hour, "How far ahead the timeline goes")
+	step := fs.Duration("step", 5*time.Minute, "Length of a timeline bucket")
+	asJSON := fs.Bool("json", false, "Print the timeline as JSON")
+	httpAddr := fs.String("http", "", "Serve the timeline as /weather.json at this address and keep it up to date, e.g. :8081")
+	interval := fs.Duration("interval", 30*time.Second, "Poll interval with -http")
+	fs.Parse(args)
+	if *step <= 0 || *span < *step {
+		return fmt.Errorf("-step must be positive and no longer than -span")
+	}
+
+	client, _, err := api.client()
+	if err != nil {
+		return err
+	}
+	read := func() (lib.Timeline, error) {
+		si, err := client.RestWatchSessionInfo()
+		if err != nil {
+			return lib.Timeline{}, err
+		}
+		f, err := client.Forecast()
+		if err != nil {
+			return lib.Timeline{}, err
+		}
+		return f.Timeline(si.CurrentEventTime, lib.ConditionsOf(si), *span, *step), nil
+	}
+
+	if *httpAddr == "" {
+		tl, err := read()
+		if err != nil {
+			return err
+		}
+		if *asJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(tl)
+		}
+		printTimeline(tl)
+		return nil
+	}
+
+	var mu sync.Mutex
+	var cur *lib.Timeline
+	loop := run.New(*interval)
+	mux := loop.Mux(*httpAddr)
+	mux.HandleFunc("/weather.json", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tl := cur
+		mu.Unlock()
+		if tl == nil {
+			http.Error(w, "no forecast yet", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		json.NewEncoder(w).Encode(tl)
+	})
+	brk := watch.NewBreaker()
+	return loop.Poll(func(now time.Time) error {
+		if !brk.Allow(now) {
+			return nil
+		}
+		tl, err := read()
+		brk.Record(now, err)
+		if err != nil {
+			if !watch.IsConnError(err) {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			return nil
+		}
+		mu.Lock()
+		cur = &tl
+		mu.Unlock()
+		if *asJSON {
+			json.NewEncoder(os.Stdout).Encode(tl)
+		}
+		return nil
+	})
+}
+
+// printTimeline prints one line per bucket with a bar for the rain chance.
+func printTimeline(tl lib.Timeline) {
+	fmt.Printf("Now: wetness %.0f%%, rain %.0f%%\n\n", tl.Wetness*100, tl.Raining*100)
+	fmt.Printf("%-7s %5s %-10s %7s  %s\n", "FROM", "RAIN", "", "WETNESS", "TREND")
+	for _, b := range tl.Buckets {
+		bar := strings.Repeat("#", int(b.RainChance/10+0.5))
+		fmt.Printf("+%-3.0fmin %4.0f%% %-10s %6.0f%%  %s\n", b.Minute, b.RainChance, bar, b.Wetness*100, b.Trend)
+	}
+}
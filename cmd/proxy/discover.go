@@ -0,0 +1,215 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// param and endpoint mirror the extra endpoints file cmd/generate reads
+// with -extra; keep the JSON in step with cmd/generate/extra.go.
+type param struct {
+	In   string `json:"in"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type endpoint struct {
+	Path     string          `json:"path"`
+	Method   string          `json:"method"`
+	Params   []param         `json:"params,omitempty"`
+	Request  json.RawMessage `json:"request,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Calls    int             `json:"calls"`
+	Statuses []int           `json:"statuses,omitempty"`
+}
+
+type report struct {
+	Generated time.Time  `json:"generated"`
+	Base      string     `json:"base"`
+	Endpoints []endpoint `json:"endpoints"`
+}
+
+// discovery collects the requests to paths and methods the API's Swagger
+// schema does not list and keeps a report of them, with an example request
+// and response body each, in the file it was created with.
+type discovery struct {
+	base string
+	path string
+
+	mu       sync.Mutex
+	known    []route // nil until the schema was fetched
+	found    map[string]*endpoint
+	statuses map[string]map[int]bool
+}
+
+// route is one documented path and method.
+type route struct {
+	method string
+	re     *regexp.Regexp
+}
+
+func newDiscovery(base, path string) *discovery {
+	return &discovery{base: base, path: path, found: map[string]*endpoint{}, statuses: map[string]map[int]bool{}}
+}
+
+var schemaParam = regexp.MustCompile(`\{\w+\}|\(.*?\)`)
+
+// load fetches the schema; until it succeeds requests are not classified.
+func (d *discovery) load() error {
+	resp, err := http.Get(strings.TrimSuffix(d.base, "/") + "/swagger-schema.json")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("swagger schema: %s", resp.Status)
+	}
+	var schema struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 32<<20)).Decode(&schema); err != nil {
+		return fmt.Errorf("swagger schema: %w", err)
+	}
+	var known []route
+	for path, methods := range schema.Paths {
+		re, err := pathPattern(path)
+		if err != nil {
+			continue
+		}
+		for m := range methods {
+			known = append(known, route{strings.ToUpper(m), re})
+		}
+	}
+	d.known = known
+	return nil
+}
+
+// pathPattern turns a schema path into a regexp matching request paths:
+// {name} placeholders match one segment, regex groups are kept, and a
+// trailing slash is optional.
+func pathPattern(path string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	last := 0
+	for _, loc := range schemaParam.FindAllStringIndex(path, -1) {
+		b.WriteString(regexp.QuoteMeta(path[last:loc[0]]))
+		if p := path[loc[0]:loc[1]]; strings.HasPrefix(p, "{") {
+			b.WriteString(`[^/]+`)
+		} else {
+			b.WriteString(p)
+		}
+		last = loc[1]
+	}
+	b.WriteString(regexp.QuoteMeta(strings.TrimSuffix(path[last:], "/")))
+	b.WriteString("/?$")
+	return regexp.Compile(b.String())
+}
+
+func (d *discovery) documented(method, path string) bool {
+	for _, r := range d.known {
+		if r.method == method && r.re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// observe classifies one exchange and rewrites the report when it was to
+// an undocumented endpoint.
+func (d *discovery) observe(r *http.Request, status int, reqBody, respBody []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.known == nil {
+		if err := d.load(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: discovery: %v\n", err)
+			return
+		}
+	}
+	path := r.URL.Path
+	if path == "/swagger-schema.json" || d.documented(r.Method, path) {
+		return
+	}
+
+	key := r.Method + " " + path
+	ep := d.found[key]
+	if ep == nil {
+		ep = &endpoint{Path: path, Method: r.Method}
+		d.found[key] = ep
+		d.statuses[key] = map[int]bool{}
+		fmt.Fprintf(os.Stderr, "Undocumented: %s %s\n", r.Method, path)
+	}
+	ep.Calls++
+	if !d.statuses[key][status] {
+		d.statuses[key][status] = true
+		ep.Statuses = append(ep.Statuses, status)
+		sort.Ints(ep.Statuses)
+	}
+	for name := range r.URL.Query() {
+		if !hasParam(ep.Params, "query", name) {
+			ep.Params = append(ep.Params, param{In: "query", Name: name, Type: "string"})
+		}
+	}
+	if body := raw(reqBody); body != nil {
+		if ep.Request == nil {
+			ep.Request = body
+		}
+		if !hasParam(ep.Params, "body", "body") {
+			ep.Params = append(ep.Params, param{In: "body", Name: "body", Type: "object"})
+		}
+	}
+	// The first successful JSON response is the example the generator types
+	if ep.Response == nil && status >= 200 && status < 300 && json.Valid(respBody) {
+		ep.Response = raw(respBody)
+	}
+	if err := d.write(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: discovery: %v\n", err)
+	}
+}
+
+func hasParam(params []param, in, name string) bool {
+	for _, p := range params {
+		if p.In == in && p.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// write replaces the report, endpoints sorted by path and method.
+func (d *discovery) write() error {
+	rep := report{Generated: time.Now().UTC(), Base: d.base, Endpoints: []endpoint{}}
+	for _, ep := range d.found {
+		e := *ep
+		sort.Slice(e.Params, func(i, j int) bool {
+			if e.Params[i].In != e.Params[j].In {
+				return e.Params[i].In > e.Params[j].In // query before body
+			}
+			return e.Params[i].Name < e.Params[j].Name
+		})
+		rep.Endpoints = append(rep.Endpoints, e)
+	}
+	sort.Slice(rep.Endpoints, func(i, j int) bool {
+		a, b := rep.Endpoints[i], rep.Endpoints[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Method < b.Method
+	})
+	data, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := d.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, d.path)
+}
@@ -0,0 +1,206 @@
+// Logging reverse proxy for the LMU API.
+// Sits between the game's UI (or any client) and the API, passes every
+// request through and logs it with its response to a JSON lines file, for
+// working out the undocumented endpoints the UI calls. With -fixtures it
+// also saves successful GET responses in the layout cmd/generate reads with
+// -fixtures. With -discover it keeps a report of the calls to paths and
+// methods the Swagger schema does not list, which cmd/generate merges with
+// -extra.
+//
+// Usage (in cmd/): go run ./proxy [-base http://localhost:6397] [-listen :6398] [-log proxy.jsonl] [-fixtures dir] [-discover discovery.json] [-token t] [-read-only]
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+)
+
+// exchange is one logged request and response. Bodies that are JSON are
+// kept as JSON, anything else as a string.
+type exchange struct {
+	At           time.Time       `json:"at"`
+	Method       string          `json:"method"`
+	Path         string          `json:"path"`
+	Query        string          `json:"query,omitempty"`
+	Status       int             `json:"status"`
+	DurationMs   float64         `json:"durationMs"`
+	RequestBody  json.RawMessage `json:"requestBody,omitempty"`
+	ResponseBody json.RawMessage `json:"responseBody,omitempty"`
+}
+
+func main() {
+	baseURL := flag.String("base", "http://localhost:6397", "Base URL of the API")
+	listen := flag.String("listen", ":6398", "Address to listen on; point clients here instead of the API")
+	logPath := flag.String("log", "proxy.jsonl", "Append every request and response to this file")
+	fixtures := flag.String("fixtures", "", "Also save successful GET responses as generator fixtures in this directory")
+	discover := flag.String("discover", "", "Write a report of the undocumented endpoints called to this file")
+	access := run.AddAccessFlags(flag.CommandLine)
+	flag.Parse()
+
+	if err := serve(*baseURL, *listen, *logPath, *fixtures, *discover, access); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func serve(baseURL, listen, logPath, fixtures, discover string, access *run.Access) error {
+	target, err := url.Parse(baseURL)
+	if err != nil {
+		return err
+	}
+	logFile, err := os.OpenFile(logPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	loop := run.New(0)
+	loop.Defer(logFile.Close)
+	if fixtures != "" {
+		if err := os.MkdirAll(fixtures, 0o755); err != nil {
+			return err
+		}
+	}
+
+	p := &proxy{log: json.NewEncoder(logFile), fixtures: fixtures}
+	if discover != "" {
+		p.discovery = newDiscovery(baseURL, discover)
+	}
+	rp := httputil.NewSingleHostReverseProxy(target)
+	rp.ModifyResponse = p.capture
+	rp.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		p.record(r, http.StatusBadGateway, nil)
+		fmt.Fprintf(os.Stderr, "Error: %s %s: %v\n", r.Method, r.URL.Path, err)
+		w.WriteHeader(http.StatusBadGateway)
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Keep the request body for the log and hand the proxy a fresh reader
+		var body []byte
+		if r.Body != nil {
+			body, _ = io.ReadAll(r.Body)
+			r.Body.Close()
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		r = r.WithContext(withStart(r.Context(), time.Now(), body))
+		rp.ServeHTTP(w, r)
+	})
+
+	fmt.Fprintf(os.Stderr, "Proxying %s on %s, logging to %s\n", baseURL, listen, logPath)
+	loop.Serve(&http.Server{Addr: listen, Handler: access.Handler(handler)})
+	return loop.Wait()
+}
+
+type proxy struct {
+	mu        sync.Mutex
+	log       *json.Encoder
+	fixtures  string
+	discovery *discovery // nil without -discover
+}
+
+// capture logs a response on its way back to the client.
+func (p *proxy) capture(resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	r := resp.Request
+	p.record(r, resp.StatusCode, decoded(resp, body))
+	if p.discovery != nil {
+		_, reqBody := startOf(r.Context())
+		p.discovery.observe(r, resp.StatusCode, reqBody, decoded(resp, body))
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.fixtures != "" && r.Method == http.MethodGet && resp.StatusCode == http.StatusOK && len(body) > 0 && resp.Header.Get("Content-Encoding") == "" {
+		name := fixtureName(r.URL.Path)
+		if r.URL.Path == "/swagger-schema.json" {
+			name = "swagger-schema.json"
+		}
+		if err := os.WriteFile(filepath.Join(p.fixtures, name), body, 0o644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: fixture: %v\n", err)
+		}
+	}
+	return nil
+}
+
+// record logs one exchange.
+func (p *proxy) record(r *http.Request, status int, body []byte) {
+	start, reqBody := startOf(r.Context())
+	x := exchange{
+		At:           start,
+		Method:       r.Method,
+		Path:         r.URL.Path,
+		Query:        r.URL.RawQuery,
+		Status:       status,
+		DurationMs:   float64(time.Since(start).Microseconds()) / 1000,
+		RequestBody:  raw(reqBody),
+		ResponseBody: raw(body),
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.log.Encode(x); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: log: %v\n", err)
+	}
+	fmt.Fprintf(os.Stderr, "%s %3d %-6s %s\n", start.Format("15:04:05"), x.Status, x.Method, x.Path)
+}
+
+type startKey struct{}
+
+type started struct {
+	at   time.Time
+	body []byte
+}
+
+// withStart remembers when a request arrived and its body.
+func withStart(ctx context.Context, at time.Time, body []byte) context.Context {
+	return context.WithValue(ctx, startKey{}, started{at, body})
+}
+
+func startOf(ctx context.Context) (time.Time, []byte) {
+	s, _ := ctx.Value(startKey{}).(started)
+	return s.at, s.body
+}
+
+// decoded returns the body for the log; compressed bodies are not unpacked.
+func decoded(resp *http.Response, body []byte) []byte {
+	if enc := resp.Header.Get("Content-Encoding"); enc != "" && enc != "identity" {
+		return []byte(fmt.Sprintf("<%d bytes %s>", len(body), enc))
+	}
+	return body
+}
+
+// raw keeps JSON bodies as they are and quotes anything else.
+func raw(body []byte) json.RawMessage {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return nil
+	}
+	if json.Valid(trimmed) {
+		return trimmed
+	}
+	quoted, _ := json.Marshal(string(body))
+	return quoted
+}
+
+var nonAlpha = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// fixtureName maps an endpoint path to its fixture file name the way
+// cmd/generate does: /rest/watch/standings → rest_watch_standings.json.
+func fixtureName(path string) string {
+	return strings.Trim(nonAlpha.ReplaceAllString(path, "_"), "_") + ".json"
+}
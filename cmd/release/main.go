@@ -0,0 +1,129 @@
+// Release builder for the LMU tools.
+// Cross-compiles the released commands (see released) for the release
+// platforms into one directory, named <tool>-<os>-<arch>[.exe] as lmu
+// selfupdate expects, and writes a checksums.txt with their SHA-256 sums.
+// Upload the directory's files as the assets of a GitHub release.
+//
+// Usage (in cmd/): go run ./release [-version v1.2.0] [-out dist]
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// released lists the commands shipped in a release. A new command is a
+// development tool until it is added here.
+var released = []string{"deck", "delta", "engineer", "lmu", "proxy", "report", "setup", "standings", "tunnel"}
+
+func main() {
+	version := flag.String("version", "", "Version to embed (default: git describe)")
+	out := flag.String("out", "dist", "Output directory")
+	targets := flag.String("targets", "windows/amd64,linux/amd64,linux/arm64,darwin/amd64,darwin/arm64", "Comma separated GOOS/GOARCH pairs")
+	flag.Parse()
+
+	if err := run(*version, *out, *targets); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(version, out, targets string) error {
+	if version == "" {
+		v, err := exec.Command("git", "describe", "--tags", "--always", "--dirty").Output()
+		if err != nil {
+			return fmt.Errorf("git describe: %w (pass -version)", err)
+		}
+		version = strings.TrimSpace(string(v))
+	}
+	tools, err := commands()
+	if err != nil {
+		return err
+	}
+	if out, err = filepath.Abs(out); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+
+	var built []string
+	for _, t := range strings.Split(targets, ",") {
+		goos, goarch, ok := strings.Cut(strings.TrimSpace(t), "/")
+		if !ok {
+			return fmt.Errorf("bad target %q, want GOOS/GOARCH", t)
+		}
+		for _, tool := range tools {
+			name := assetName(tool, goos, goarch)
+			fmt.Fprintf(os.Stderr, "building %s\n", name)
+			cmd := exec.Command("go", "build", "-trimpath",
+				"-ldflags", "-s -w -X main.version="+version,
+				"-o", filepath.Join(out, name), "./"+tool)
+			cmd.Dir = toolsDir()
+			cmd.Env = append(os.Environ(), "GOOS="+goos, "GOARCH="+goarch, "CGO_ENABLED=0")
+			cmd.Stdout, cmd.Stderr = os.Stderr, os.Stderr
+			if err := cmd.Run(); err != nil {
+				return fmt.Errorf("build %s: %w", name, err)
+			}
+			built = append(built, name)
+		}
+	}
+	return writeChecksums(out, built)
+}
+
+// toolsDir is the directory of the tools module: cmd when run from the
+// repository root, else the current one.
+func toolsDir() string {
+	if _, err := os.Stat(filepath.Join("cmd", "go.mod")); err == nil {
+		return "cmd"
+	}
+	return "."
+}
+
+// commands returns the released commands, checking that each still has a
+// main package in the tools module.
+func commands() ([]string, error) {
+	dir := toolsDir()
+	for _, tool := range released {
+		if _, err := os.Stat(filepath.Join(dir, tool, "main.go")); err != nil {
+			return nil, fmt.Errorf("released tool %s: %w", tool, err)
+		}
+	}
+	return released, nil
+}
+
+// assetName is the release file name of a tool for a platform.
+func assetName(tool, goos, goarch string) string {
+	name := tool + "-" + goos + "-" + goarch
+	if goos == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+func writeChecksums(dir string, names []string) error {
+	sort.Strings(names)
+	var b strings.Builder
+	for _, n := range names {
+		f, err := os.Open(filepath.Join(dir, n))
+		if err != nil {
+			return err
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&b, "%s  %s\n", hex.EncodeToString(h.Sum(nil)), n)
+	}
+	return os.WriteFile(filepath.Join(dir, "checksums.txt"), []byte(b.String()), 0o644)
+}
@@ -0,0 +1,208 @@
+// Stewarding report for LMU.
+// Writes a Markdown report of a recorded session (see lmu record) for race
+// control: incidents with the cars involved, penalties issued and served,
+// laps invalidated for track limits and pit stops outside the regulated
+// window, each with its session time.
+//
+// Usage (in cmd/): go run ./report [-db history.db] [-session id] [-o report.md] [-lang de]
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/sqlite"
+	"github.com/snipem/go-lmu-api/lib/analysis"
+	"github.com/snipem/go-lmu-api/lib/events"
+	"github.com/snipem/go-lmu-api/lib/locale"
+	"github.com/snipem/go-lmu-api/lib/store"
+)
+
+func main() {
+	dbPath := flag.String("db", store.DefaultPath(), "History database")
+	session := flag.Int64("session", 0, "Session id (default: the latest with results)")
+	outPath := flag.String("o", "", "Output file (default: stdout)")
+	lang := flag.String("lang", "en", "Report language: en, de or fr")
+	flag.Parse()
+
+	loc, err := locale.Get(*lang)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := run(*dbPath, *session, *outPath, loc); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(dbPath string, id int64, outPath string, loc locale.Locale) error {
+	db, err := sqlite.Open(dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	sess, err := db.Session(id)
+	if err != nil {
+		return err
+	}
+	results, err := db.Results(sess.ID)
+	if err != nil {
+		return err
+	}
+	laps, err := db.Laps(store.Filter{}, sess.ID)
+	if err != nil {
+		return err
+	}
+	evs, err := db.Events(sess.ID)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if outPath != "" {
+		f, err := os.Create(outPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	w := bufio.NewWriter(out)
+	write(w, loc, sess, results, laps, evs)
+	return w.Flush()
+}
+
+func write(w io.Writer, loc locale.Locale, sess store.Session, results []store.Result, laps []store.Lap, evs []store.EventRow) {
+	// Drivers by car number, for the involved cars of incidents
+	drivers := map[string]string{}
+	for _, l := range laps {
+		drivers[l.CarNumber] = l.Driver
+	}
+	for _, r := range results {
+		drivers[r.CarNumber] = r.Driver
+	}
+	car := func(number, driver string) string {
+		if driver == "" {
+			driver = drivers[number]
+		}
+		return fmt.Sprintf("#%s %s", number, driver)
+	}
+
+	var incidents, penalties, pits []store.EventRow
+	for _, e := range evs {
+		switch events.Kind(e.Kind) {
+		case events.Incident:
+			incidents = append(incidents, e)
+		case events.Penalty, events.PenaltyServed:
+			penalties = append(penalties, e)
+		case events.PitInfraction:
+			pits = append(pits, e)
+		}
+	}
+	cuts := map[string][]store.Lap{} // by car number
+	var cutCars []string
+	for _, l := range laps {
+		if !analysis.IsCut(l.Invalid) {
+			continue
+		}
+		if len(cuts[l.CarNumber]) == 0 {
+			cutCars = append(cutCars, l.CarNumber)
+		}
+		cuts[l.CarNumber] = append(cuts[l.CarNumber], l)
+	}
+	sort.Slice(cutCars, func(i, j int) bool { return len(cuts[cutCars[i]]) > len(cuts[cutCars[j]]) })
+	issued := 0
+	for _, e := range penalties {
+		if events.Kind(e.Kind) == events.Penalty {
+			issued++
+		}
+	}
+	nCuts := 0
+	for _, c := range cuts {
+		nCuts += len(c)
+	}
+
+	fmt.Fprint(w, loc.T("# Stewarding report: %s, %s\n\n", sess.Track, sess.Session))
+	fmt.Fprint(w, loc.T("Session %d, started %s", sess.ID, loc.Date(sess.StartedAt.Local())))
+	if sess.Server != "" {
+		fmt.Fprint(w, loc.T(" on %s", sess.Server))
+	}
+	fmt.Fprint(w, loc.T(". Times are session time.\n\n"))
+	fmt.Fprintf(w, "| | %s |\n|---|---:|\n", loc.T("Count"))
+	fmt.Fprintf(w, "| %s | %d |\n| %s | %d |\n| %s | %d |\n| %s | %d |\n\n",
+		loc.T("Incidents"), len(incidents), loc.T("Penalties issued"), issued, loc.T("Track limits"), nCuts, loc.T("Pit infractions"), len(pits))
+
+	none := loc.T("None recorded.")
+	fmt.Fprintf(w, "## %s\n\n", loc.T("Incidents"))
+	if len(incidents) == 0 {
+		fmt.Fprintf(w, "%s\n\n", none)
+	} else {
+		fmt.Fprintf(w, "| %s | %s | %s | %s |\n|---|---|---|---|\n", loc.T("Time"), loc.T("Car"), loc.T("What"), loc.T("Involved"))
+		for _, e := range incidents {
+			var involved []string
+			for _, n := range e.Involved {
+				involved = append(involved, car(n, ""))
+			}
+			fmt.Fprintf(w, "| %s | %s | %s | %s |\n", clock(e.SessionTime), md(car(e.CarNumber, e.Driver)), md(e.Detail), md(strings.Join(involved, ", ")))
+		}
+		fmt.Fprintf(w, "\n%s\n\n", loc.T("Incidents are inferred from sudden stops and position losses; review them before acting."))
+	}
+
+	fmt.Fprintf(w, "## %s\n\n", loc.T("Penalties"))
+	if len(penalties) == 0 {
+		fmt.Fprintf(w, "%s\n\n", none)
+	} else {
+		fmt.Fprintf(w, "| %s | %s | | %s |\n|---|---|---|---:|\n", loc.T("Time"), loc.T("Car"), loc.T("Outstanding"))
+		for _, e := range penalties {
+			what := loc.T("issued")
+			if events.Kind(e.Kind) == events.PenaltyServed {
+				what = loc.T("served")
+			}
+			fmt.Fprintf(w, "| %s | %s | %s | %.0f |\n", clock(e.SessionTime), md(car(e.CarNumber, e.Driver)), what, e.Value)
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintf(w, "## %s\n\n", loc.T("Track limits"))
+	if len(cutCars) == 0 {
+		fmt.Fprintf(w, "%s\n\n", none)
+	} else {
+		fmt.Fprintf(w, "| %s | %s | %s |\n|---|---:|---|\n", loc.T("Car"), loc.T("Laps"), loc.T("Invalidated"))
+		for _, n := range cutCars {
+			var list []string
+			for _, l := range cuts[n] {
+				list = append(list, loc.T("lap %d at %s (%s)", l.Lap, l.RecordedAt.Local().Format("15:04:05"), l.Invalid))
+			}
+			fmt.Fprintf(w, "| %s | %d | %s |\n", md(car(n, "")), len(cuts[n]), md(strings.Join(list, "; ")))
+		}
+		fmt.Fprintf(w, "\n%s\n\n", loc.T("The API does not report cuts; these laps were much faster than the car's other laps (see lib/analysis). Times are wall clock."))
+	}
+
+	fmt.Fprintf(w, "## %s\n\n", loc.T("Pit infractions"))
+	if len(pits) == 0 {
+		fmt.Fprintf(w, "%s\n", none)
+	} else {
+		fmt.Fprintf(w, "| %s | %s | %s | %s |\n|---|---|---:|---|\n", loc.T("Time"), loc.T("Car"), loc.T("Lap"), loc.T("What"))
+		for _, e := range pits {
+			fmt.Fprintf(w, "| %s | %s | %.0f | %s |\n", clock(e.SessionTime), md(car(e.CarNumber, e.Driver)), e.Value, md(e.Detail))
+		}
+	}
+}
+
+// clock formats session seconds as H:MM:SS.
+func clock(sec float64) string {
+	s := int(sec)
+	return fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+}
+
+// md escapes text for a Markdown table cell.
+func md(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
@@ -0,0 +1,187 @@
+// Setup tool for LMU.
+// Exports the current garage setup to JSON, loads an exported setup back
+// into the game and diffs two setups field by field. Sweeps one setting
+// through a range of values, timing laps with each.
+//
+// Usage:
+//
+//	setup export [-o file.json]
+//	setup import [-dry] file.json
+//	setup diff a.json [b.json]   (b defaults to the setup in the garage)
+//	setup sweep -key VM_REAR_WING [-from 4 -to 8] [-laps 3]
+//	setup serve [-addr :8740] [-dir setups] [-token t]
+//	setup push|pull|list         share named setups through a sync server
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/garage"
+)
+
+const usage = `Usage: setup [-base url] <export|import|diff> [flags] [files]
+
+  export [-o file]     write the current garage setup as JSON (stdout by default)
+  import [-dry] file   load a setup into the garage
+  diff a [b]           compare two setups; b defaults to the current garage setup
+  sweep -key k         step a setting through its values, timing laps and top speed with each
+
+  serve                run a setup sync server for the team
+  push [-f file] name  upload the garage setup (or a file) under a name
+  pull name            download a setup for the current track and car into the garage
+  list                 list the setups on the sync server
+`
+
+func main() {
+	baseURL := flag.String("base", "http://localhost:6397", "Base URL of the API")
+	cf := config.AddFlags(flag.CommandLine)
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	_, prof, err := cf.Load(flag.CommandLine)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	client := lib.NewClient(*baseURL)
+	client.HTTPClient = prof.HTTPClient()
+
+	switch sub, args := flag.Arg(0), flag.Args()[1:]; sub {
+	case "export":
+		err = runExport(client, args)
+	case "import":
+		err = runImport(client, args)
+	case "diff":
+		err = runDiff(client, args)
+	case "sweep":
+		err = runSweep(client, args)
+	case "serve":
+		err = runServe(args)
+	case "push":
+		err = runPush(client, args)
+	case "pull":
+		err = runPull(client, args)
+	case "list":
+		err = runList(args)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runExport(client *lib.Client, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	out := fs.String("o", "", "Output file (default stdout)")
+	fs.Parse(args)
+
+	s, err := garage.Current(client)
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		return s.Encode(os.Stdout)
+	}
+	if err := s.Save(*out); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%d settings of %s for %s written to %s\n", len(s.Settings), s.Car, s.Track, *out)
+	return nil
+}
+
+func runImport(client *lib.Client, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	dry := fs.Bool("dry", false, "Only show what would change")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("import needs exactly one setup file")
+	}
+	s, err := garage.Load(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if *dry {
+		cur, err := garage.Current(client)
+		if err != nil {
+			return err
+		}
+		printDiff(garage.Diff(cur, s), "garage", fs.Arg(0))
+		return nil
+	}
+	applied, err := garage.Apply(client, s)
+	for _, ch := range applied {
+		fmt.Printf("  %-28s %s → %s\n", garage.Label(ch.Key), ch.From.Text, ch.To.Text)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d settings changed\n", len(applied))
+	return nil
+}
+
+func runDiff(client *lib.Client, args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	fs.Parse(args)
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		return fmt.Errorf("diff needs one or two setup files")
+	}
+	a, err := garage.Load(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	var b *garage.Setup
+	bName := "garage"
+	if fs.NArg() == 2 {
+		bName = fs.Arg(1)
+		b, err = garage.Load(bName)
+	} else {
+		b, err = garage.Current(client)
+	}
+	if err != nil {
+		return err
+	}
+	if a.Car != "" && b.Car != "" && a.Car != b.Car {
+		fmt.Printf("note: comparing setups of different cars (%s vs %s)\n\n", a.Car, b.Car)
+	}
+	printDiff(garage.Diff(a, b), fs.Arg(0), bName)
+	return nil
+}
+
+func printDiff(changes []garage.Change, aName, bName string) {
+	if len(changes) == 0 {
+		fmt.Println("setups are identical")
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "\tSetting\t%s\t%s\n", aName, bName)
+	category := ""
+	for _, ch := range changes {
+		if ch.Category != category {
+			category = ch.Category
+			fmt.Fprintf(w, "%s\t\t\t\n", category)
+		}
+		fmt.Fprintf(w, "\t%s\t%s\t%s\n", garage.Label(ch.Key), text(ch.From), text(ch.To))
+	}
+	w.Flush()
+}
+
+func text(s *garage.Setting) string {
+	if s == nil {
+		return "-"
+	}
+	if s.Text == "" {
+		return fmt.Sprintf("#%g", s.Index)
+	}
+	return s.Text
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/garage"
+	"github.com/snipem/go-lmu-api/lib/timing"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// sweepStep is one value of a sweep and the laps run with it.
+type sweepStep struct {
+	Index float64
+	Text  string
+	Laps  []float64
+	Vmax  []float64 // per lap in km/h, 0 when unknown
+}
+
+// Sweep phases: waiting for the car in its garage stall, out on the laps,
+// and coming back in after them.
+const (
+	sweepGarage = iota
+	sweepDriving
+	sweepReturning
+)
+
+// runSweep steps one setup setting through a range of values: for each it
+// sets the value in the garage, leaves the garage and times the flying laps
+// until the car is back, then reports lap time and top speed per value.
+// The API cannot drive or pit the car, so the stints are driven by the
+// player or by the game's AI control (its Toggle AI Control key).
+func runSweep(client *lib.Client, args []string) error {
+	fs := flag.NewFlagSet("sweep", flag.ExitOnError)
+	key := fs.String("key", "", "Setting to sweep: a key such as VM_REAR_WING or its label")
+	from := fs.Float64("from", math.NaN(), "First index (default: the setting's minimum)")
+	to := fs.Float64("to", math.NaN(), "Last index (default: the setting's maximum)")
+	step := fs.Float64("step", 1, "Index step")
+	laps := fs.Int("laps", 3, "Flying laps per value")
+	interval := fs.Duration("interval", 250*time.Millisecond, "Poll interval, for the top speeds")
+	csvPath := fs.String("csv", "", "Also write the results to this CSV file")
+	fs.Parse(args)
+	if *key == "" || *step <= 0 || *laps < 1 {
+		return fmt.Errorf("sweep needs -key, a positive -step and at least one lap")
+	}
+
+	cur, err := garage.Current(client)
+	if err != nil {
+		return err
+	}
+	k, orig, err := findSetting(cur, *key)
+	if err != nil {
+		return err
+	}
+	if math.IsNaN(*from) {
+		*from = orig.Min
+	}
+	if math.IsNaN(*to) {
+		*to = orig.Max
+	}
+	if *from < orig.Min || *to > orig.Max || *from > *to {
+		return fmt.Errorf("%s: sweep %g..%g outside the car's %g..%g", k, *from, *to, orig.Min, orig.Max)
+	}
+	var steps []*sweepStep
+	for v := *from; v <= *to+1e-9; v += *step {
+		steps = append(steps, &sweepStep{Index: v})
+	}
+	fmt.Printf("Sweeping %s from %g to %g (%d values, %d laps each), now %s\n", garage.Label(k), *from, *to, len(steps), *laps, orig.Text)
+
+	loop := run.New(*interval)
+	// Put the setting back; this only works with the car in the garage
+	loop.Defer(func() error {
+		if err := client.SetGarageValue(k, orig.Index); err != nil {
+			return fmt.Errorf("restore %s to %s: %w", k, orig.Text, err)
+		}
+		return nil
+	})
+	vmax := timing.NewVmax()
+	brk := watch.NewBreaker()
+	phase, i := sweepGarage, 0
+	var lapsDone float64
+	var pitted, prompted bool
+	err = loop.Poll(func(now time.Time) error {
+		if !brk.Allow(now) {
+			return nil
+		}
+		standings, err := client.RestWatchStandings()
+		brk.Record(now, err)
+		if err != nil {
+			if !watch.IsConnError(err) {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			return nil
+		}
+		var p *lib.RestWatchStandingsResponseItem
+		for j := range standings {
+			if standings[j].Player {
+				p = &standings[j]
+			}
+		}
+		if p == nil {
+			return nil
+		}
+		vmax.Update(standings)
+		s := steps[i]
+		inPit := p.Pitting || p.PitState != "NONE" || p.InGarageStall
+
+		switch phase {
+		case sweepGarage:
+			if !p.InGarageStall {
+				if !prompted {
+					fmt.Println("Waiting for the car in the garage")
+					prompted = true
+				}
+				return nil
+			}
+			if err := client.SetGarageValue(k, s.Index); err != nil {
+				return fmt.Errorf("set %s to %g: %w", k, s.Index, err)
+			}
+			s.Text = fmt.Sprintf("#%g", s.Index)
+			if set, err := garage.Current(client); err == nil {
+				s.Text = set.Settings[k].Text
+			}
+			if _, err := client.PostRestGarageDrive(); err != nil {
+				return fmt.Errorf("drive: %w", err)
+			}
+			fmt.Printf("[%d/%d] %s: %d flying laps\n", i+1, len(steps), s.Text, *laps)
+			phase, lapsDone, pitted, prompted = sweepDriving, p.LapsCompleted, true, false
+		case sweepDriving:
+			if p.LapsCompleted != lapsDone {
+				lapsDone = p.LapsCompleted
+				// The out-lap and in-lap say nothing about the setup
+				if !pitted && !inPit && p.LastLapTime > 0 {
+					s.Laps = append(s.Laps, p.LastLapTime)
+					s.Vmax = append(s.Vmax, vmax.Lap(int(p.SlotID), int(p.LapsCompleted)))
+					fmt.Printf("      lap %d  %s  %.0f km/h\n", len(s.Laps), fmtLap(p.LastLapTime), s.Vmax[len(s.Vmax)-1])
+				}
+				pitted = false
+			}
+			if inPit {
+				pitted = true
+			}
+			if len(s.Laps) >= *laps {
+				fmt.Println("      done, back to the garage")
+				phase = sweepReturning
+			}
+		case sweepReturning:
+			if !p.InGarageStall {
+				return nil
+			}
+			if i++; i == len(steps) {
+				loop.Stop()
+				return nil
+			}
+			phase = sweepGarage
+		}
+		return nil
+	})
+	fmt.Println()
+	printSweep(k, steps)
+	if *csvPath != "" {
+		if werr := writeSweepCSV(*csvPath, k, steps); werr != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", werr)
+		}
+	}
+	return err
+}
+
+// findSetting finds a setting by key or, case-insensitively, by label.
+func findSetting(s *garage.Setup, query string) (string, garage.Setting, error) {
+	if v, ok := s.Settings[query]; ok {
+		return query, v, nil
+	}
+	for k, v := range s.Settings {
+		if strings.EqualFold(k, query) || strings.EqualFold(garage.Label(k), query) {
+			return k, v, nil
+		}
+	}
+	return "", garage.Setting{}, fmt.Errorf("no setting %q on this car (see setup export for the keys)", query)
+}
+
+// sweepStats sums up a value's laps.
+func sweepStats(s *sweepStep) (best, mean, vmax float64) {
+	for j, t := range s.Laps {
+		mean += t
+		if best == 0 || t < best {
+			best = t
+		}
+		vmax = math.Max(vmax, s.Vmax[j])
+	}
+	if len(s.Laps) > 0 {
+		mean /= float64(len(s.Laps))
+	}
+	return best, mean, vmax
+}
+
+// printSweep prints the trade-off: mean lap and top speed per value, each
+// against the best value, with a bar for the lap time lost.
+func printSweep(key string, steps []*sweepStep) {
+	var fastest, topSpeed float64
+	for _, s := range steps {
+		_, mean, v := sweepStats(s)
+		if mean > 0 && (fastest == 0 || mean < fastest) {
+			fastest = mean
+		}
+		topSpeed = math.Max(topSpeed, v)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\tLAPS\tBEST\tMEAN\tΔ MEAN\tVMAX\tΔ VMAX\t\n", strings.ToUpper(garage.Label(key)))
+	for _, s := range steps {
+		if len(s.Laps) == 0 {
+			fmt.Fprintf(w, "%s\t0\t-\t-\t\t\t\t\n", s.Text)
+			continue
+		}
+		best, mean, v := sweepStats(s)
+		lost := mean - fastest
+		bar := strings.Repeat("█", int(math.Round(lost/0.05)))
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%+.3f\t%.0f\t%+.0f\t%s\n", s.Text, len(s.Laps), fmtLap(best), fmtLap(mean), lost, v, v-topSpeed, bar)
+	}
+	w.Flush()
+}
+
+func writeSweepCSV(path, key string, steps []*sweepStep) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	cw := csv.NewWriter(f)
+	cw.Write([]string{"setting", "index", "value", "lap", "lap_time", "vmax"})
+	for _, s := range steps {
+		for j, t := range s.Laps {
+			cw.Write([]string{key, fmt.Sprint(s.Index), s.Text, fmt.Sprint(j + 1), fmt.Sprintf("%.3f", t), fmt.Sprintf("%.1f", s.Vmax[j])})
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func fmtLap(t float64) string {
+	mins := int(t) / 60
+	return fmt.Sprintf("%d:%06.3f", mins, t-float64(mins*60))
+}
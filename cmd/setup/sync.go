@@ -0,0 +1,170 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/garage"
+)
+
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8740", "Listen address")
+	dir := fs.String("dir", "setups", "Directory to store setups in")
+	token := fs.String("token", os.Getenv("LMU_SETUP_TOKEN"), "Shared token teammates must send (default $LMU_SETUP_TOKEN)")
+	fs.Parse(args)
+
+	if *token == "" && !loopback(*addr) {
+		return fmt.Errorf("anyone who can reach %s could store setups: pass -token, or listen on 127.0.0.1", *addr)
+	}
+	if err := os.MkdirAll(*dir, 0o755); err != nil {
+		return err
+	}
+	loop := run.New(time.Second)
+	mux := loop.Mux(*addr)
+	srv := garage.NewServer(*dir, *token)
+	mux.Handle("/setups", srv)
+	mux.Handle("/setups/", srv)
+	run.Alive(mux)
+	log.Printf("serving setups from %s on %s", *dir, *addr)
+	return loop.Wait()
+}
+
+// loopback reports whether addr only listens on this machine.
+func loopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// remoteFlags adds the sync server flags, defaulting to the config file's
+// setupSync section.
+func remoteFlags(fs *flag.FlagSet) func() (*garage.Remote, error) {
+	cfgPath := fs.String("config", config.DefaultPath(), "Config file")
+	server := fs.String("server", "", "Setup sync server URL (default from config)")
+	token := fs.String("token", "", "Setup sync token (default from config)")
+	return func() (*garage.Remote, error) {
+		cfg, err := config.Load(*cfgPath)
+		if err != nil {
+			return nil, err
+		}
+		if *server == "" {
+			*server = cfg.SetupSync.Server
+		}
+		if *token == "" {
+			*token = cfg.SetupSync.Token
+		}
+		if *server == "" {
+			return nil, fmt.Errorf("no sync server: pass -server or set setupSync.server in %s", *cfgPath)
+		}
+		return garage.NewRemote(*server, *token), nil
+	}
+}
+
+func runPush(client *lib.Client, args []string) error {
+	fs := flag.NewFlagSet("push", flag.ExitOnError)
+	remote := remoteFlags(fs)
+	file := fs.String("f", "", "Push this exported setup instead of the one in the garage")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("push needs a setup name")
+	}
+	r, err := remote()
+	if err != nil {
+		return err
+	}
+	var s *garage.Setup
+	if *file != "" {
+		s, err = garage.Load(*file)
+	} else {
+		s, err = garage.Current(client)
+	}
+	if err != nil {
+		return err
+	}
+	if s.Track == "" || s.Car == "" {
+		return fmt.Errorf("setup has no track or car, cannot file it on the server")
+	}
+	if err := r.Push(s, fs.Arg(0)); err != nil {
+		return err
+	}
+	fmt.Printf("pushed %s for %s at %s\n", fs.Arg(0), s.Car, s.Track)
+	return nil
+}
+
+func runPull(client *lib.Client, args []string) error {
+	fs := flag.NewFlagSet("pull", flag.ExitOnError)
+	remote := remoteFlags(fs)
+	track := fs.String("track", "", "Track (default: the current garage track)")
+	car := fs.String("car", "", "Car (default: the current garage car)")
+	out := fs.String("o", "", "Save to this file instead of loading it into the garage")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		return fmt.Errorf("pull needs a setup name")
+	}
+	r, err := remote()
+	if err != nil {
+		return err
+	}
+	if *track == "" || *car == "" {
+		sum, err := client.RestGarageSummary()
+		if err != nil {
+			return fmt.Errorf("no -track/-car and the garage is not reachable: %w", err)
+		}
+		if *track == "" {
+			*track = sum.Track.Name
+		}
+		if *car == "" {
+			*car = sum.Car.Name
+		}
+	}
+	s, err := r.Pull(*track, *car, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if *out != "" {
+		return s.Save(*out)
+	}
+	applied, err := garage.Apply(client, s)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("loaded %s, %d settings changed\n", fs.Arg(0), len(applied))
+	return nil
+}
+
+func runList(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	remote := remoteFlags(fs)
+	track := fs.String("track", "", "Only this track")
+	car := fs.String("car", "", "Only this car")
+	fs.Parse(args)
+	r, err := remote()
+	if err != nil {
+		return err
+	}
+	entries, err := r.List(*track, *car)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Track\tCar\tName\tModified")
+	for _, e := range entries {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.Track, e.Car, e.Name, e.Modified.Local().Format("2006-01-02 15:04"))
+	}
+	return w.Flush()
+}
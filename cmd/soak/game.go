@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/vcr"
+)
+
+// game is the simulated API. While down it drops every connection without
+// an answer, as a closed game does.
+type game struct {
+	down  func() bool
+	clock func() time.Duration // game time since the start
+	tape  *vcr.Recorder        // nil for synthetic data
+}
+
+func newGame(cassette string, clock func() time.Duration) (*game, error) {
+	g := &game{clock: clock}
+	if cassette != "" {
+		rec, err := vcr.Open(cassette, vcr.Replay)
+		if err != nil {
+			return nil, err
+		}
+		if len(rec.Interactions()) == 0 {
+			return nil, fmt.Errorf("%s: no interactions", cassette)
+		}
+		g.tape = rec
+	}
+	return g, nil
+}
+
+func (g *game) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if g.down != nil && g.down() {
+		if hj, ok := w.(http.Hijacker); ok {
+			if conn, _, err := hj.Hijack(); err == nil {
+				conn.Close()
+				return
+			}
+		}
+		http.Error(w, "down", http.StatusServiceUnavailable)
+		return
+	}
+	if g.tape != nil {
+		g.replay(w, r)
+		return
+	}
+	var v interface{}
+	switch r.URL.Path {
+	case "/rest/watch/standings":
+		v = g.standings()
+	case "/rest/watch/sessionInfo":
+		v = g.sessionInfo()
+	case "/rest/watch/standings/history":
+		v = map[string]interface{}{}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func (g *game) replay(w http.ResponseWriter, r *http.Request) {
+	resp, err := g.tape.RoundTrip(r.Clone(r.Context()))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	defer resp.Body.Close()
+	for k, v := range resp.Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(resp.StatusCode)
+	io.Copy(w, resp.Body)
+}
+
+// Synthetic race: twenty cars on a 13.6 km lap, each a little slower than
+// the one ahead, in an endless race session.
+const (
+	cars     = 20
+	trackLen = 13626.0
+	lapTime  = 210.0
+)
+
+func (g *game) sessionInfo() lib.RestWatchSessionInfoResponse {
+	et := g.clock().Seconds()
+	return lib.RestWatchSessionInfoResponse{
+		Session:          "RACE1",
+		TrackName:        "Circuit de la Sarthe",
+		GamePhase:        float64(lib.PhaseGreen),
+		CurrentEventTime: et,
+		EndEventTime:     86400,
+		LapDistance:      trackLen,
+		NumberOfVehicles: cars,
+	}
+}
+
+func (g *game) standings() []lib.RestWatchStandingsResponseItem {
+	et := g.clock().Seconds()
+	out := make([]lib.RestWatchStandingsResponseItem, cars)
+	for i := range out {
+		pace := lapTime * (1 + 0.002*float64(i))
+		laps := et / pace
+		out[i] = lib.RestWatchStandingsResponseItem{
+			SlotID:        float64(i),
+			Position:      float64(i + 1),
+			CarNumber:     fmt.Sprint(i + 1),
+			DriverName:    fmt.Sprintf("Driver %d", i+1),
+			CarClass:      "Hypercar",
+			LapsCompleted: float64(int(laps)),
+			LapDistance:   (laps - float64(int(laps))) * trackLen,
+			LastLapTime:   pace,
+			BestLapTime:   pace,
+			Player:        i == 5,
+			PitState:      "NONE",
+		}
+		out[i].CarVelocity.Velocity = trackLen / pace
+		if i > 0 {
+			out[i].TimeBehindNext = et * 0.002 * lapTime / pace
+			out[i].TimeBehindLeader = float64(i) * out[i].TimeBehindNext
+		}
+	}
+	return out
+}
@@ -0,0 +1,234 @@
+// Soak check for the long-running watchers.
+// Runs the polling layers (watch.Scheduler with a Breaker, the lib/timing
+// trackers, the event detectors and a lib/feed server with clients coming
+// and going) against a simulated game for hours of game time, compressed
+// by -speed, and fails when the goroutine count or the live heap keeps
+// growing. The game goes away for a while every -outage-every, so the
+// reconnect paths are exercised too.
+//
+// The simulated game serves a cassette (see lib/vcr) when given one, e.g. a
+// proxy log of a real race, or a synthetic field of cars otherwise.
+//
+// Usage (in cmd/): go run ./soak [-duration 6h] [-speed 1000] [-cassette race.jsonl]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"sync/atomic"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/events"
+	"github.com/snipem/go-lmu-api/lib/feed"
+	"github.com/snipem/go-lmu-api/lib/timing"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+func main() {
+	duration := flag.Duration("duration", 6*time.Hour, "Game time to simulate")
+	speed := flag.Float64("speed", 1000, "Game seconds per real second")
+	interval := flag.Duration("interval", time.Second, "Poll interval in game time")
+	cassette := flag.String("cassette", "", "Serve this cassette (lib/vcr, or a cmd/proxy log) instead of synthetic data")
+	outageEvery := flag.Duration("outage-every", 30*time.Minute, "Take the game away this often, in game time (0 = never)")
+	outageFor := flag.Duration("outage-for", time.Minute, "How long an outage lasts, in game time")
+	sampleEvery := flag.Duration("sample", 15*time.Minute, "Measure this often, in game time")
+	maxGoroutines := flag.Int("max-goroutines", 10, "Goroutines allowed above the first measurement")
+	maxHeap := flag.Float64("max-heap", 2, "Live heap allowed, as a multiple of the first measurement")
+	flag.Parse()
+
+	if err := soak(options{
+		duration: *duration, speed: *speed, interval: *interval, cassette: *cassette,
+		outageEvery: *outageEvery, outageFor: *outageFor, sampleEvery: *sampleEvery,
+		maxGoroutines: *maxGoroutines, maxHeap: *maxHeap,
+	}); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+type options struct {
+	duration, interval, outageEvery, outageFor, sampleEvery time.Duration
+	speed                                                   float64
+	cassette                                                string
+	maxGoroutines                                           int
+	maxHeap                                                 float64
+}
+
+// sample is one measurement, taken after a garbage collection.
+type sample struct {
+	game       time.Duration
+	goroutines int
+	heap       uint64 // live heap bytes
+	polls      int64
+	errors     int64
+	clients    int
+}
+
+func soak(o options) error {
+	if o.speed <= 0 {
+		return fmt.Errorf("-speed must be positive")
+	}
+	scaled := func(d time.Duration) time.Duration { return time.Duration(float64(d) / o.speed) }
+	start := time.Now()
+	gameTime := func() time.Duration { return time.Duration(float64(time.Since(start)) * o.speed) }
+
+	game, err := newGame(o.cassette, gameTime)
+	if err != nil {
+		return err
+	}
+	game.down = func() bool {
+		if o.outageEvery <= 0 {
+			return false
+		}
+		t := gameTime() % o.outageEvery
+		return t >= o.outageEvery-o.outageFor
+	}
+	srv := httptest.NewServer(game)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), scaled(o.duration))
+	defer cancel()
+
+	client := lib.NewClient(srv.URL)
+	client.HTTPClient = &http.Client{Timeout: max(scaled(5*time.Second), 50*time.Millisecond)}
+	brk := watch.NewBreaker()
+	brk.Probe = scaled(5 * time.Second)
+	sched := watch.NewScheduler(watch.ClientEndpoints(client, scaled(o.interval))...)
+	sched.Breaker = brk
+
+	out, err := feed.Listen("127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	go churn(ctx, out.Addr().String(), scaled(o.interval))
+
+	results := make(chan watch.Result, 16)
+	go sched.Run(ctx, results)
+
+	var polls, failures atomic.Int64
+	go consume(results, out, &polls, &failures)
+
+	var samples []sample
+	measure := func() sample {
+		runtime.GC()
+		var m runtime.MemStats
+		runtime.ReadMemStats(&m)
+		s := sample{game: gameTime().Round(time.Minute), goroutines: runtime.NumGoroutine(), heap: m.HeapAlloc,
+			polls: polls.Load(), errors: failures.Load(), clients: out.Clients()}
+		fmt.Printf("%9s  goroutines %4d  heap %8.1f KiB  polls %7d  errors %6d  feed clients %d\n",
+			s.game, s.goroutines, float64(s.heap)/1024, s.polls, s.errors, s.clients)
+		return s
+	}
+	tick := time.NewTicker(scaled(o.sampleEvery))
+	defer tick.Stop()
+	for done := false; !done; {
+		select {
+		case <-ctx.Done():
+			done = true
+		case <-tick.C:
+			samples = append(samples, measure())
+		}
+	}
+	// Let the pollers and clients wind down before the last measurement
+	time.Sleep(max(scaled(2*o.interval), 100*time.Millisecond))
+	srv.CloseClientConnections()
+	samples = append(samples, measure())
+	return check(samples, o)
+}
+
+// check compares the last measurement with the first, taken once
+// everything was running.
+func check(samples []sample, o options) error {
+	if len(samples) < 2 {
+		return fmt.Errorf("too few measurements; lower -sample or raise -duration")
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	if last.polls == first.polls {
+		return fmt.Errorf("no polls in %s; is the game answering?", last.game-first.game)
+	}
+	var errs []string
+	if last.goroutines > first.goroutines+o.maxGoroutines {
+		errs = append(errs, fmt.Sprintf("goroutines grew from %d to %d", first.goroutines, last.goroutines))
+	}
+	if float64(last.heap) > float64(first.heap)*o.maxHeap {
+		errs = append(errs, fmt.Sprintf("live heap grew from %.1f to %.1f KiB", float64(first.heap)/1024, float64(last.heap)/1024))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("possible leak after %s of game time: %v", last.game, errs)
+	}
+	fmt.Printf("OK: %s of game time, %d polls\n", last.game, last.polls)
+	return nil
+}
+
+// consume feeds the results to the trackers and detectors the tools run
+// and writes a line per frame to the feed server.
+func consume(results <-chan watch.Result, out *feed.Server, polls, failures *atomic.Int64) {
+	vmax, gaps := timing.NewVmax(), timing.NewGaps()
+	det, incidents := events.NewDetector(), events.NewIncidents()
+	start := events.NewStart()
+	var slots watch.SlotTracker
+	var si *lib.RestWatchSessionInfoResponse
+	for r := range results {
+		polls.Add(1)
+		if r.Err != nil {
+			failures.Add(1)
+			continue
+		}
+		switch v := r.Value.(type) {
+		case *lib.RestWatchSessionInfoResponse:
+			si = v
+		case []lib.RestWatchStandingsResponseItem:
+			standings, _ := watch.SanitizeStandings(v)
+			if c := slots.Update(standings, si); c.NewSession || c.Reshuffled {
+				vmax.Remap(c)
+				gaps.Remap(c)
+			}
+			vmax.Update(standings)
+			gaps.Update(standings)
+			snap := events.Snapshot{At: r.Start, Standings: standings, Session: si}
+			evs := append(det.Process(snap), incidents.Process(snap)...)
+			evs = append(evs, start.Process(snap)...)
+			line, _ := json.Marshal(map[string]interface{}{"seq": r.Seq, "cars": len(standings), "events": evs})
+			out.Write(append(line, '\n'))
+		}
+	}
+}
+
+// churn connects feed clients that read for a while and hang up, the way
+// displays come and go during a race.
+func churn(ctx context.Context, addr string, every time.Duration) {
+	t := time.NewTicker(max(every, time.Millisecond))
+	defer t.Stop()
+	var conns []net.Conn
+	defer func() {
+		for _, c := range conns {
+			c.Close()
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		if c, err := net.Dial("tcp", addr); err == nil {
+			go io.Copy(io.Discard, c)
+			conns = append(conns, c)
+		}
+		// Keep up to four connected; the oldest hangs up
+		if len(conns) > 4 {
+			conns[0].Close()
+			conns = conns[1:]
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/carcolor"
+)
+
+// pitEvent is one detected pit stop, shown on the broadcast pit page.
+type pitEvent struct {
+	At     time.Time
+	CarNum string
+	Driver string
+	Class  string
+	Lap    float64
+	Stop   float64
+}
+
+const maxPitLog = 12
+
+var (
+	lastPitstops = map[int]float64{}
+	pitLog       []pitEvent
+)
+
+// trackPits appends a pitEvent whenever a car's pit stop counter goes up.
+func trackPits(standings []lib.RestWatchStandingsResponseItem) {
+	for _, s := range standings {
+		slot := int(s.SlotID)
+		prev, seen := lastPitstops[slot]
+		lastPitstops[slot] = s.Pitstops
+		if !seen || s.Pitstops <= prev {
+			continue
+		}
+		pitLog = append(pitLog, pitEvent{
+			At:     time.Now(),
+			CarNum: carNumber(s),
+			Driver: s.DriverName,
+			Class:  s.CarClass,
+			Lap:    s.LapsCompleted,
+			Stop:   s.Pitstops,
+		})
+		if len(pitLog) > maxPitLog {
+			pitLog = pitLog[len(pitLog)-maxPitLog:]
+		}
+	}
+}
+
+func carNumber(s lib.RestWatchStandingsResponseItem) string {
+	if s.CarNumber != "" {
+		return s.CarNumber
+	}
+	return extractCarNum(s.VehicleName)
+}
+
+// broadcastPages lists the pages to cycle through: overall, one per class
+// present in the field (in canonical order), then recent pit stops.
+func broadcastPages(standings []lib.RestWatchStandingsResponseItem) []string {
+	seen := map[string]bool{}
+	var classes []string
+	for _, s := range standings {
+		if !seen[s.CarClass] {
+			seen[s.CarClass] = true
+			classes = append(classes, s.CarClass)
+		}
+	}
+	carclass.Default().Sort(classes)
+	pages := []string{""}
+	if len(classes) > 1 {
+		pages = append(pages, classes...)
+	}
+	return append(pages, pitPage)
+}
+
+const pitPage = "\x00pit"
+
+// renderBroadcast draws the TV-friendly view: few, wide columns, relaxed gap
+// formatting and one page at a time, switching every cycle.
+func renderBroadcast(standings []lib.RestWatchStandingsResponseItem, session string, th theme, colors *carcolor.Book, started time.Time, cycle time.Duration, rows int, status string) {
+	sortByPosition(standings)
+	pages := broadcastPages(standings)
+	if cycle <= 0 {
+		cycle = 10 * time.Second
+	}
+	page := pages[int(time.Since(started)/cycle)%len(pages)]
+
+	race := isRaceSession(session)
+	title := "OVERALL"
+	switch {
+	case page == pitPage:
+		title = "PIT STOPS"
+	case page != "":
+		title = strings.ToUpper(carclass.Lookup(page).Name)
+	}
+	sessionLabel := session
+	if sessionLabel == "" {
+		sessionLabel = "---"
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "\033[H")
+	fmt.Fprintf(&buf, "\033[K\n%s\033[K\n", paint(th.Header, fmt.Sprintf("   %s   ·   %s   ·   %s",
+		strings.ToUpper(sessionLabel), title, time.Now().Format("15:04")), ""))
+	renderBanner(&buf, status, th)
+
+	if page == pitPage {
+		renderPitPage(&buf)
+	} else {
+		var leader lib.RestWatchStandingsResponseItem
+		n := 0
+		for _, s := range standings {
+			if page != "" && s.CarClass != page {
+				continue
+			}
+			n++
+			if n > rows {
+				break
+			}
+			if n == 1 {
+				leader = s
+			}
+			cls := carclass.Lookup(s.CarClass)
+			gap := broadcastGap(s, leader, n, race)
+			rowCode := ""
+			if s.Player {
+				rowCode = th.Player
+			}
+			num := carNumber(s)
+			line := fmt.Sprintf("   %3d   %s %s   %-24s   %12s",
+				n, numberCell(th, colors, num, fmt.Sprintf("%-5s", num), rowCode), paint(classCode(cls), fmt.Sprintf("%-4s", cls.Abbrev), ""),
+				truncate(s.DriverName, 24), gap)
+			fmt.Fprintf(&buf, "%s\033[K\n\033[K\n", paint(rowCode, line, ""))
+		}
+	}
+	fmt.Fprintf(&buf, "\033[J")
+	os.Stdout.Write(buf.Bytes())
+}
+
+func renderPitPage(buf *bytes.Buffer) {
+	if len(pitLog) == 0 {
+		fmt.Fprintf(buf, "   No pit stops yet\033[K\n")
+		return
+	}
+	for i := len(pitLog) - 1; i >= 0; i-- {
+		p := pitLog[i]
+		cls := carclass.Lookup(p.Class)
+		fmt.Fprintf(buf, "   %s   #%-5s %s   %-24s   lap %3.0f   stop %.0f\033[K\n\033[K\n",
+			p.At.Format("15:04"), p.CarNum, paint(classCode(cls), fmt.Sprintf("%-4s", cls.Abbrev), ""),
+			truncate(p.Driver, 24), p.Lap, p.Stop)
+	}
+}
+
+// broadcastGap formats the gap to the page's leader, the class leader on
+// class pages, for reading at a distance: whole laps spelled out, a single
+// decimal for seconds. The API's gaps are to the overall leader, so the
+// page leader's own gap is taken off, as raceGap does.
+func broadcastGap(s, leader lib.RestWatchStandingsResponseItem, classPos int, race bool) string {
+	if classPos == 1 {
+		if race {
+			return "LEADER"
+		}
+		return fmtLap(s.BestLapTime)
+	}
+	if !race {
+		if leader.BestLapTime > 0 && s.BestLapTime > 0 {
+			return fmt.Sprintf("+%.1fs", s.BestLapTime-leader.BestLapTime)
+		}
+		return "NO TIME"
+	}
+	laps := s.LapsBehindLeader - leader.LapsBehindLeader
+	switch d := s.TimeBehindLeader - leader.TimeBehindLeader; {
+	case laps == 1:
+		return "+1 LAP"
+	case laps > 1:
+		return fmt.Sprintf("+%.0f LAPS", laps)
+	case d > 0:
+		return fmt.Sprintf("+%.1fs", d)
+	}
+	return ""
+}
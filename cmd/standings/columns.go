@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// column is a table column: its -columns name and the width its cells are
+// padded to. Header and cells are right-aligned unless left is set.
+type column struct {
+	name  string
+	width int
+	left  bool
+}
+
+// allColumns lists every column in display order; explicit -columns lists
+// are shown in the order given.
+var allColumns = []column{
+	{name: "P", width: 3},
+	{name: "Grid", width: 4},
+	{name: "#", width: 5},
+	{name: "Team", width: 16, left: true},
+	{name: "Driver", width: 22, left: true},
+	{name: "Cls", width: 5, left: true},
+	{name: "PIC", width: 3},
+	{name: "Laps", width: 4},
+	{name: "Gap", width: 8},
+	{name: "Int", width: 8},
+	{name: "Trend", width: 5},
+	{name: "Net", width: 8},
+	{name: "S1", width: 7},
+	{name: "S2", width: 7},
+	{name: "S3", width: 7},
+	{name: "Last", width: 8},
+	{name: "Best", width: 8},
+	{name: "Live", width: 6},
+	{name: "Vmax", width: 5},
+	{name: "TL", width: 2},
+	{name: "Pit", width: 3},
+	{name: "Stop", width: 4},
+}
+
+// columnProfiles are the named -columns layouts. "full" is the default;
+// Int is added to it in class mode and Net with -net-gap, Grid and Live in
+// qualifying sessions.
+var columnProfiles = map[string][]string{
+	"full":       {"P", "#", "Team", "Driver", "Cls", "PIC", "Laps", "Gap", "S1", "S2", "S3", "Last", "Best", "Vmax", "TL", "Pit", "Stop"},
+	"race":       {"P", "#", "Driver", "Cls", "PIC", "Laps", "Gap", "Int", "Trend", "Last", "Best", "Pit", "Stop"},
+	"qualifying": {"P", "Grid", "#", "Driver", "Cls", "PIC", "Gap", "S1", "S2", "S3", "Last", "Best", "Live"},
+	"minimal":    {"P", "#", "Driver", "Gap", "Last"},
+}
+
+// parseColumns resolves a -columns value: a profile name or a
+// comma-separated list of column names, matched case-insensitively.
+func parseColumns(spec string) ([]column, error) {
+	if spec == "" {
+		spec = "full"
+	}
+	names, ok := columnProfiles[strings.ToLower(spec)]
+	if !ok {
+		names = strings.Split(spec, ",")
+	}
+	var out []column
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		i := slices.IndexFunc(allColumns, func(c column) bool { return strings.EqualFold(c.name, name) })
+		if i < 0 {
+			return nil, fmt.Errorf("-columns: unknown column or profile %q (profiles: full, race, qualifying, minimal)", name)
+		}
+		out = append(out, allColumns[i])
+	}
+	return out, nil
+}
+
+// withColumn adds the named column after the last of the given columns
+// present, unless it is already there.
+func withColumn(cols []column, name string, after ...string) []column {
+	if hasColumn(cols, name) {
+		return cols
+	}
+	at := len(cols)
+	for i, c := range cols {
+		if slices.Contains(after, c.name) {
+			at = i + 1
+		}
+	}
+	i := slices.IndexFunc(allColumns, func(c column) bool { return c.name == name })
+	return slices.Insert(slices.Clone(cols), at, allColumns[i])
+}
+
+func hasColumn(cols []column, name string) bool {
+	return slices.ContainsFunc(cols, func(c column) bool { return c.name == name })
+}
+
+// pad aligns text in the column; text may contain color codes, which do
+// not take up space.
+func (c column) pad(text string) string {
+	n := visibleLen(text)
+	if n >= c.width {
+		return text
+	}
+	if c.left {
+		return text + strings.Repeat(" ", c.width-n)
+	}
+	return strings.Repeat(" ", c.width-n) + text
+}
+
+// visibleLen counts the runes of text outside ANSI escape sequences.
+func visibleLen(text string) int {
+	n, esc := 0, false
+	for _, r := range text {
+		switch {
+		case esc:
+			esc = r != 'm'
+		case r == '\033':
+			esc = true
+		default:
+			n++
+		}
+	}
+	return n
+}
+
+// tableRow joins the cells of a row in column order.
+func tableRow(cols []column, cells map[string]string) string {
+	parts := make([]string, len(cols))
+	for i, c := range cols {
+		parts[i] = c.pad(cells[c.name])
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/strategy"
+	"github.com/snipem/go-lmu-api/lib/timing"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// inspectLaps is how many laps the detail pane lists.
+const inspectLaps = 10
+
+// inspector is the row selection and the detail pane of the selected car.
+// Up and down (or k and j) select a row, Enter or d opens the pane, Esc
+// closes it and then clears the selection.
+type inspector struct {
+	Slot     int // selected SlotID
+	Selected bool
+	Open     bool
+}
+
+// key applies a key press. standings is in display order, as render leaves
+// it.
+func (in *inspector) key(k run.Key, standings []lib.RestWatchStandingsResponseItem) {
+	switch k {
+	case run.KeyUp, "k":
+		in.move(-1, standings)
+	case run.KeyDown, "j":
+		in.move(1, standings)
+	case run.KeyEnter, "d":
+		if in.Selected {
+			in.Open = !in.Open
+		}
+	case run.KeyEsc:
+		if in.Open {
+			in.Open = false
+		} else {
+			in.Selected = false
+		}
+	}
+}
+
+// move selects the row delta rows away, starting from the player's car
+// when nothing is selected yet.
+func (in *inspector) move(delta int, standings []lib.RestWatchStandingsResponseItem) {
+	if len(standings) == 0 {
+		return
+	}
+	i := -1
+	for j, s := range standings {
+		if in.Selected && int(s.SlotID) == in.Slot || !in.Selected && s.Player {
+			i = j
+		}
+	}
+	switch {
+	case i < 0:
+		i = 0
+	case in.Selected:
+		i = min(max(i+delta, 0), len(standings)-1)
+	}
+	in.Slot, in.Selected = int(standings[i].SlotID), true
+}
+
+// remap follows the selected car to its new slot, and drops the selection
+// after a new session.
+func (in *inspector) remap(c watch.Change) {
+	if !in.Selected {
+		return
+	}
+	m := watch.Remap(map[int]bool{in.Slot: true}, c)
+	in.Selected, in.Open = false, false
+	for slot := range m {
+		in.Slot, in.Selected = slot, true
+	}
+}
+
+// renderInspect draws the detail pane of a car: its last laps with sectors
+// and gaps, its pit stops and stints, and how the gap to the car ahead
+// develops.
+func renderInspect(buf *bytes.Buffer, s lib.RestWatchStandingsResponseItem, laps []lib.RestWatchStandingsHistoryResponseItemItem, gaps []timing.Gap, th theme) {
+	title := []string{"── #" + carNumber(s) + " " + s.DriverName}
+	team := s.FullTeamName
+	if team == "" {
+		team = extractTeam(s.VehicleName)
+	}
+	if team != "" {
+		title = append(title, team)
+	}
+	title = append(title, carclass.Lookup(s.CarClass).Name, fmt.Sprintf("P%.0f   (Esc closes)", s.Position))
+	fmt.Fprintf(buf, "\033[K\n%s\033[K\n", paint(th.Header, strings.Join(title, "  •  "), ""))
+
+	gapAt := map[int]timing.Gap{}
+	for _, g := range gaps {
+		gapAt[g.Lap] = g
+	}
+	var best float64
+	for _, l := range laps {
+		if l.LapTime > 0 && !l.Pitting && (best == 0 || l.LapTime < best) {
+			best = l.LapTime
+		}
+	}
+	fmt.Fprintf(buf, "  %4s  %7s %7s %7s  %8s  %8s  %8s\033[K\n", "Lap", "S1", "S2", "S3", "Time", "Ahead", "Leader")
+	for i := max(0, len(laps)-inspectLaps); i < len(laps); i++ {
+		l := laps[i]
+		lap := int(l.TotalLaps)
+		if lap <= 0 {
+			lap = i + 1
+		}
+		var s1, s2, s3 float64
+		if l.SectorTime1 > 0 && l.SectorTime2 > 0 && l.LapTime > 0 {
+			s1, s2, s3 = l.SectorTime1, l.SectorTime2-l.SectorTime1, l.LapTime-l.SectorTime2
+		}
+		timeCell := fmt.Sprintf("%8s", fmtLap(l.LapTime))
+		if l.LapTime > 0 && l.LapTime == best {
+			timeCell = paint(th.Green, timeCell, "")
+		}
+		ahead, leader := "", ""
+		if g, ok := gapAt[lap]; ok {
+			if g.Ahead > 0 {
+				ahead = fmtGap(g.Ahead)
+			}
+			if g.Leader > 0 {
+				leader = fmtGap(g.Leader)
+			}
+		}
+		pit := ""
+		if l.Pitting {
+			pit = "  PIT"
+		}
+		fmt.Fprintf(buf, "  %4d  %s %s %s  %s  %8s  %8s%s\033[K\n", lap, fmtSec(s1), fmtSec(s2), fmtSec(s3), timeCell, ahead, leader, pit)
+	}
+	if len(laps) == 0 {
+		fmt.Fprintf(buf, "  no laps yet\033[K\n")
+	}
+
+	done, lastStop := strategy.Stints(laps)
+	fmt.Fprintf(buf, "  %s\033[K\n", stintLine(laps, done, lastStop, s))
+	fmt.Fprintf(buf, "  %s\033[K\n", pitLine(laps, s))
+	if d, ok := trendOf(gaps); ok {
+		fmt.Fprintf(buf, "  %s\033[K\n", d)
+	}
+}
+
+// stintLine summarises the current stint, e.g. "Stint 3: 8 laps, avg
+// 1:42.310, best 1:41.900  •  earlier 12, 14 laps".
+func stintLine(laps []lib.RestWatchStandingsHistoryResponseItemItem, done []int, lastStop int, s lib.RestWatchStandingsResponseItem) string {
+	var n, sum, best float64
+	for i, l := range laps {
+		lap := int(l.TotalLaps)
+		if lap <= 0 {
+			lap = i + 1
+		}
+		// the out-lap is slow for the stop, not the stint
+		if lap <= lastStop+1 && lastStop > 0 || l.Pitting || l.LapTime <= 0 {
+			continue
+		}
+		n, sum = n+1, sum+l.LapTime
+		if best == 0 || l.LapTime < best {
+			best = l.LapTime
+		}
+	}
+	line := fmt.Sprintf("Stint %d: %.0f laps", len(done)+1, s.LapsCompleted-float64(lastStop))
+	if n > 0 {
+		line += fmt.Sprintf(", avg %s, best %s", strings.TrimSpace(fmtLap(sum/n)), strings.TrimSpace(fmtLap(best)))
+	}
+	if len(done) > 0 {
+		earlier := make([]string, len(done))
+		for i, d := range done {
+			earlier[i] = fmt.Sprint(d)
+		}
+		line += "  •  earlier " + strings.Join(earlier, ", ") + " laps"
+	}
+	return line
+}
+
+// pitLine lists the laps the car stopped on, e.g. "Pit stops (2): laps 12,
+// 26". Stops from before the history starts only show in the count.
+func pitLine(laps []lib.RestWatchStandingsHistoryResponseItemItem, s lib.RestWatchStandingsResponseItem) string {
+	var stops []string
+	inPit := false
+	for i, l := range laps {
+		if l.Pitting && !inPit {
+			lap := int(l.TotalLaps)
+			if lap <= 0 {
+				lap = i + 1
+			}
+			stops = append(stops, fmt.Sprint(lap))
+		}
+		inPit = l.Pitting
+	}
+	line := fmt.Sprintf("Pit stops (%.0f)", s.Pitstops)
+	if len(stops) > 0 {
+		line += ": laps " + strings.Join(stops, ", ")
+	}
+	if s.PitState != "NONE" || s.InGarageStall {
+		line += "  •  in the pits now"
+	}
+	return line
+}
+
+// trendOf describes the gap to the car ahead over the recorded laps, e.g.
+// "Gap ahead 2.31 → 1.84 over 5 laps (-0.47, closing)".
+func trendOf(gaps []timing.Gap) (string, bool) {
+	// the last run of laps with a comparable gap
+	end := len(gaps) - 1
+	for end >= 0 && gaps[end].Ahead <= 0 {
+		end--
+	}
+	start := end
+	for start > 0 && gaps[start-1].Ahead > 0 && gaps[start-1].Lap == gaps[start].Lap-1 && end-start+1 < inspectLaps {
+		start--
+	}
+	if start < 0 || start == end {
+		return "", false
+	}
+	first, last := gaps[start], gaps[end]
+	d := last.Ahead - first.Ahead
+	word := "holding"
+	switch {
+	case d < -0.05:
+		word = "closing"
+	case d > 0.05:
+		word = "dropping back"
+	}
+	return fmt.Sprintf("Gap ahead %.2f → %.2f over %d laps (%+.2f, %s)", first.Ahead, last.Ahead, last.Lap-first.Lap, d, word), true
+}
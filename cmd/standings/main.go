@@ -1,55 +1,364 @@
 // Live standings monitor for LMU.
 // Polls /rest/watch/standings and /rest/watch/standings/history every second.
 //
-// Usage: go run ./cmd/standings [-base http://localhost:6397] [-interval 1s]
+// Usage (in cmd/): go run ./standings [-base http://localhost:6397] [-interval 1s]
 package main
 
 import (
 	"bytes"
+	"errors"
 	"flag"
 	"fmt"
+	"math"
+	"net/http"
 	"os"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
-	"go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/analysis"
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/carcolor"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/events"
+	"github.com/snipem/go-lmu-api/lib/locale"
+	"github.com/snipem/go-lmu-api/lib/strategy"
+	"github.com/snipem/go-lmu-api/lib/timesync"
+	"github.com/snipem/go-lmu-api/lib/timing"
+	"github.com/snipem/go-lmu-api/lib/watch"
 )
 
-var maxSpeeds = map[int]float64{}
-
 func main() {
 	baseURL := flag.String("base", "http://localhost:6397", "Base URL of the API")
 	interval := flag.Duration("interval", 1*time.Second, "Poll interval")
+	adaptive := flag.Bool("adaptive", false, run.AdaptiveUsage)
+	classFile := flag.String("classes", "", "JSON file with extra or overriding car classes")
+	cf := config.AddFlags(flag.CommandLine)
+	themeName := flag.String("theme", "", "Color theme (default, mono, contrast or user-defined)")
+	broadcast := flag.Bool("broadcast", false, "TV mode: fewer columns, auto-cycling pages")
+	cycle := flag.Duration("cycle", 10*time.Second, "Page duration in broadcast mode")
+	rows := flag.Int("rows", 20, "Maximum rows per page in broadcast mode")
+	trafficLaps := flag.Float64("traffic", 2, "Forecast traffic for the player over this many laps (0 = off)")
+	runLaps := flag.Int("long-runs", strategy.DefaultRunLaps, "Practice: rank cars by long-run pace over runs of at least this many green laps (0 = off)")
+	vmaxMode := flag.String("vmax", "session", "Top speed column: lap (last completed), stint or session")
+	byClass := flag.Bool("by-class", false, "Group the table by class, with gaps to the class leader and the car ahead in class")
+	netGap := flag.Bool("net-gap", false, "Race: add a net gap column adjusted for the pit cycle, and project the player's rejoin position")
+	pitLoss := flag.Duration("pit-loss", 0, "Time a pit stop costs for -net-gap (0 = measure from the stops seen)")
+	columnSpec := flag.String("columns", "full", "Table columns: a profile (full, race, qualifying, minimal) or a list such as P,Driver,Gap,Last")
+	colorFile := flag.String("car-colors", carcolor.DefaultPath(), "File keeping each car number's color across sessions and tools (empty = this run only)")
+	depth := flag.Int("history-depth", timing.DefaultDepth, "Laps of per-car history (top speeds, gaps) kept in memory")
+	streaming := flag.Bool("stream", false, "Draw the standings while they download, for slow links such as remote spectating over a VPN")
+	metricsAddr := flag.String("metrics", "", "Serve API request metrics for Prometheus at this address's /metrics, e.g. :9101")
+	eventsLog := events.AddLogFlag(flag.CommandLine)
+	var pluginCmds stringList
+	flag.Var(&pluginCmds, "plugin", "Run a plugin command (repeatable)")
 	flag.Parse()
 
+	cfg, prof, err := cf.Load(flag.CommandLine)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if *themeName == "" {
+		*themeName = cfg.Theme
+	}
+	th, err := resolveTheme(*themeName, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if *vmaxMode != "lap" && *vmaxMode != "stint" && *vmaxMode != "session" {
+		fmt.Fprintf(os.Stderr, "Error: -vmax must be lap, stint or session\n")
+		os.Exit(1)
+	}
+	cols, err := parseColumns(*columnSpec)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	// A profile makes room for what the other flags add; a list is taken
+	// as given
+	if _, profile := columnProfiles[strings.ToLower(*columnSpec)]; profile && *byClass {
+		cols = withColumn(cols, "Int", "Gap")
+	}
+	if *netGap {
+		cols = withColumn(cols, "Net", "Gap", "Int")
+	}
+	qualiCols := cols
+	if _, profile := columnProfiles[strings.ToLower(*columnSpec)]; profile {
+		qualiCols = withColumn(withColumn(cols, "Grid", "P"), "Live", "Best")
+	}
+	opts := viewOptions{Theme: th, TrafficLaps: *trafficLaps, Vmax: timing.NewVmax(), Gaps: timing.NewGaps(), VmaxMode: *vmaxMode, ByClass: *byClass,
+		Columns: cols, QualiColumns: qualiCols, PitLoss: pitLoss.Seconds(), RunLaps: *runLaps}
+	opts.Vmax.Depth, opts.Gaps.Depth = *depth, *depth
+	if lim := cfg.DriveTime; lim.MaxStint > 0 || lim.MaxTotal > 0 {
+		opts.DriveTime = strategy.NewDriveTime(strategy.DriveLimits{
+			MaxStint:   time.Duration(lim.MaxStint),
+			MaxTotal:   time.Duration(lim.MaxTotal),
+			WarnBefore: time.Duration(lim.WarnBefore),
+		})
+	}
+
+	opts.Colors, err = carcolor.Open(*colorFile, cfg.CarColors)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *classFile != "" {
+		if err := carclass.Default().LoadFile(*classFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	loop := run.New(*interval)
+	if *adaptive {
+		loop.Pace = &watch.DefaultPace
+	}
 	client := lib.NewClient(*baseURL)
+	client.HTTPClient = prof.HTTPClient()
+	if *metricsAddr != "" {
+		client.Metrics = lib.NewMetrics()
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", client.Metrics)
+		loop.Serve(&http.Server{Addr: *metricsAddr, Handler: mux})
+	}
+	started := time.Now()
+
+	loc, err := locale.Get(cfg.Language)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	ticker, err := events.NewTicker(loc, cfg.Ticker)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	plugs, err := startPlugins(pluginCmds, ticker)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	loop.Defer(func() error { plugs.Close(); return nil })
+	elog, err := events.OpenLog(*eventsLog, "standings")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	// A failed write would garble the table; the last one is reported on exit
+	var elogErr error
+	loop.Defer(func() error { return errors.Join(elogErr, elog.Close()) })
+	clock := timesync.New()
+	elog.Sync(clock)
+	loop.Defer(opts.Colors.Save)
+	opts.Plugins = plugs
+	countdown := events.NewCountdown(cfg.Countdowns)
+	start := events.NewStart()
+	incidents := events.NewIncidents()
+	opts.Limits = events.NewTrackLimits(cfg.TrackLimits)
+	if opts.Limits.Allowed == 0 {
+		opts.Limits.Allowed, _ = client.CutsAllowed()
+	}
+	override, _ := client.PitSpeedLimit()
+	pitLane := events.NewPitLane(cfg.PitLane, override)
+	crossover := events.NewCrossover()
 
-	// Initial clear + hide cursor
-	fmt.Print("\033[2J\033[?25l")
-	defer fmt.Print("\033[?25h")
+	loop.Terminal()
+	var keys <-chan run.Key
+	if !*broadcast {
+		keys = loop.Keys()
+		opts.Inspect = &inspector{}
+	}
 
-	for {
-		standings, err := client.RestWatchStandings()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "\rError: %v", err)
-			time.Sleep(*interval)
-			continue
+	// On errors the last good data stays on screen under a banner, so a
+	// closed game shows as such rather than as a frozen table
+	brk := watch.NewBreaker()
+	var (
+		standings  []lib.RestWatchStandingsResponseItem
+		history    map[int][]lib.RestWatchStandingsHistoryResponseItemItem
+		si         *lib.RestWatchSessionInfoResponse
+		lastUpdate time.Time
+		lastErr    error
+		slots      watch.SlotTracker
+		seq        watch.Sequence
+		forecast   *lib.Forecast
+		forecastAt time.Time
+		polledAt   time.Time
+	)
+	// draw renders a frame; with -stream also while the standings are
+	// still coming in
+	draw := func(standings []lib.RestWatchStandingsResponseItem) {
+		if *broadcast {
+			var session string
+			if si != nil {
+				session = si.Session
+			}
+			renderBroadcast(standings, session, th, opts.Colors, started, *cycle, *rows, opts.Status)
+		} else {
+			render(standings, history, si, opts)
+		}
+	}
+	err = loop.Poll(func(now time.Time) error {
+		// A key press wakes the loop early: redraw without polling again
+		for len(keys) > 0 {
+			opts.Inspect.key(<-keys, standings)
 		}
+		if now.Sub(polledAt) >= loop.Interval/2 && brk.Allow(now) {
+			polledAt = now
+			capture := seq.Start(now)
+			var fresh []lib.RestWatchStandingsResponseItem
+			var err error
+			if *streaming {
+				fresh, err = streamStandings(loop.Context(), client, standings, draw)
+			} else {
+				fresh, err = client.RestWatchStandings()
+			}
+			capture.Observe("standings", time.Since(now))
+			brk.Record(now, err)
+			lastErr = err
+			if err == nil {
+				standings, _ = watch.SanitizeStandings(fresh)
+				lastUpdate = now
+
+				var raw map[string][]lib.RestWatchStandingsHistoryResponseItemItem
+				t := time.Now()
+				historyRaw, _ := client.RestWatchStandingsHistory()
+				capture.Observe("history", time.Since(t))
+				if historyRaw != nil {
+					raw, _ = watch.SanitizeHistory(*historyRaw)
+					historyRaw = &raw
+				}
+				history = convertHistory(historyRaw)
 
-		historyRaw, _ := client.RestWatchStandingsHistory()
-		history := convertHistory(historyRaw)
+				t = time.Now()
+				si, _ = client.RestWatchSessionInfo()
+				capture.Observe("sessionInfo", time.Since(t))
+				if si != nil {
+					clock.ObserveRequest(t, time.Now(), si.CurrentEventTime)
+				}
+				loop.Adapt(watch.Classify(si, standings))
+				meta := capture.Done()
+
+				// A reloaded session reshuffles the SlotIDs; carry per-car
+				// state over by car number and driver, or drop it
+				if c := slots.Update(standings, si); c.NewSession || c.Reshuffled {
+					opts.Vmax.Remap(c)
+					opts.Gaps.Remap(c)
+					lastPitstops = watch.Remap(lastPitstops, c)
+					if opts.Inspect != nil {
+						opts.Inspect.remap(c)
+					}
+					if c.NewSession {
+						pitLog = nil
+					}
+					if opts.DriveTime != nil {
+						opts.DriveTime.Remap(c)
+					}
+				}
+				opts.Vmax.Update(standings)
+				opts.Gaps.Update(standings)
+				trackPits(standings)
+				// Failed saves are retried next frame and reported on exit
+				opts.Colors.Save()
+				plugs.Tick(standings, si, meta)
+				// The forecast changes slowly and lives in a heavy payload
+				if now.Sub(forecastAt) > 30*time.Second {
+					if f, err := client.Forecast(); err == nil {
+						forecast = f
+					}
+					forecastAt = now
+				}
+				snap := events.Snapshot{At: now, Standings: standings, Session: si, History: raw, Forecast: forecast, Meta: meta, Clock: clock}
+				evs := append(start.Process(snap), countdown.Process(snap)...)
+				evs = append(evs, incidents.Process(snap)...)
+				evs = append(evs, pitLane.Process(snap)...)
+				evs = append(evs, crossover.Process(snap)...)
+				evs = append(evs, opts.Limits.Process(snap)...)
+				plugs.Events(evs)
+				if err := elog.Write(evs...); err != nil {
+					elogErr = err
+				}
+				if opts.DriveTime != nil && si != nil {
+					opts.DriveTime.Update(standings, si.CurrentEventTime)
+				}
+			}
+		}
+		opts.Status = connectionBanner(brk.Health(), lastUpdate, lastErr, time.Now(), loop.Interval)
+		opts.PitSpeeding = pitLane.Over() && opts.Status == ""
+		opts.Crossover = ""
+		if a, ok := crossover.Advice(); ok && a.Laps <= crossoverShowLaps {
+			opts.Crossover = crossoverLine(a)
+		}
+		draw(standings)
+		return nil
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
 
-		si, _ := client.RestWatchSessionInfo()
-		var session string
-		if si != nil {
-			session = si.Session
+// connectionBanner describes a lost connection or stale data, and is empty
+// while updates arrive. Data counts as stale after three missed polls.
+func connectionBanner(h watch.Health, lastUpdate time.Time, lastErr error, now time.Time, interval time.Duration) string {
+	age := "no data yet"
+	if !lastUpdate.IsZero() {
+		age = fmt.Sprintf("last update %s ago", now.Sub(lastUpdate).Round(time.Second))
+	}
+	switch {
+	case !h.Connected:
+		return fmt.Sprintf("DISCONNECTED  |  %s  |  retrying", age)
+	case lastUpdate.IsZero() && lastErr != nil, !lastUpdate.IsZero() && now.Sub(lastUpdate) > 3*interval:
+		msg := "STALE  |  " + age
+		if lastErr != nil {
+			msg += "  |  " + truncate(lastErr.Error(), 60)
 		}
+		return msg
+	}
+	return ""
+}
+
+// renderBanner draws the connection banner, or the blank line it replaces.
+func renderBanner(buf *bytes.Buffer, status string, th theme) {
+	if status == "" {
+		fmt.Fprintf(buf, "\033[K\n")
+		return
+	}
+	fmt.Fprintf(buf, "%s\033[K\n", paint("1;7;"+th.Battle, "  "+status+"  ", ""))
+}
+
+// renderPitSpeeding draws a blinking warning in the banner line while the
+// player is too fast in the pit lane.
+func renderPitSpeeding(buf *bytes.Buffer, th theme) {
+	fmt.Fprintf(buf, "%s\033[K\n", paint("1;5;7;"+th.Warning, "  PIT SPEEDING  |  SLOW DOWN  ", ""))
+}
 
-		render(standings, history, session)
-		time.Sleep(*interval)
+// crossoverShowLaps is how close the tyre crossover must be to show in the
+// banner line.
+const crossoverShowLaps = 10
+
+// crossoverLine describes the tyre crossover advice for the banner line.
+func crossoverLine(a events.CrossoverAdvice) string {
+	msg := strings.ToUpper(a.Tyres()) + " FASTER "
+	if a.Laps < 0.5 {
+		msg += "NOW"
+	} else {
+		msg += fmt.Sprintf("IN ~%.0f LAPS", a.Laps)
+	}
+	if a.Forecast {
+		msg += "  |  forecast"
 	}
+	return msg
+}
+
+// renderCrossover draws the tyre crossover advice in the banner line.
+func renderCrossover(buf *bytes.Buffer, msg string, th theme) {
+	fmt.Fprintf(buf, "%s\033[K\n", paint("1;7;"+th.Warning, "  "+msg+"  ", ""))
 }
 
 func convertHistory(raw *map[string][]lib.RestWatchStandingsHistoryResponseItemItem) map[int][]lib.RestWatchStandingsHistoryResponseItemItem {
@@ -77,14 +386,75 @@ func lastLapFromHistory(laps []lib.RestWatchStandingsHistoryResponseItemItem) (s
 	return
 }
 
+// fmtConditions formats the weather for the header.
+func fmtConditions(c lib.Conditions) string {
+	s := fmt.Sprintf("Air %.0f°C  Track %.0f°C  Wind %.1f m/s  Wet %.0f%%", c.Ambient, c.Track, c.Wind, 100*c.Wetness)
+	if c.Raining > 0 {
+		s += fmt.Sprintf("  Rain %.0f%%", 100*c.Raining)
+	}
+	return s
+}
+
+// vmax picks the top speed the Vmax column shows.
+func vmax(s timing.Speeds, mode string) float64 {
+	switch mode {
+	case "lap":
+		return s.LastLap
+	case "stint":
+		return s.Stint
+	}
+	return s.Session
+}
+
 func isRaceSession(session string) bool {
 	return strings.Contains(strings.ToUpper(session), "RACE")
 }
 
-func render(standings []lib.RestWatchStandingsResponseItem, history map[int][]lib.RestWatchStandingsHistoryResponseItemItem, session string) {
-	sort.Slice(standings, func(i, j int) bool {
-		return standings[i].Position < standings[j].Position
-	})
+func isPracticeSession(session string) bool {
+	s := strings.ToUpper(session)
+	return strings.HasPrefix(s, "PRACTICE") || strings.HasPrefix(s, "WARMUP")
+}
+
+// viewOptions carries display settings from flags and config into render.
+type viewOptions struct {
+	Theme       theme
+	TrafficLaps float64
+	RunLaps     int                 // green laps a long run needs; 0 hides the practice long-run panel
+	DriveTime   *strategy.DriveTime // nil when no limits are configured
+	Plugins     *plugins
+	Status      string // connection banner, empty while connected
+	PitSpeeding bool   // the player is over the pit speed limit; flashes the banner line
+	Crossover   string // tyre crossover advice for the banner line, empty without one
+	Vmax        *timing.Vmax
+	Gaps        *timing.Gaps
+	VmaxMode    string // lap, stint or session
+	Limits      *events.TrackLimits
+	ByClass     bool // one block per class, gaps within the class
+	Columns     []column
+	// QualiColumns replace Columns in qualifying sessions, with Grid and
+	// Live added to a profile
+	QualiColumns []column
+	PitLoss      float64 // seconds; 0 measures it for the Net column
+	Colors       *carcolor.Book
+	Inspect      *inspector // row selection and detail pane; nil without keys
+}
+
+// trendLaps is how many laps the Trend column looks back.
+const trendLaps = 3
+
+func render(standings []lib.RestWatchStandingsResponseItem, history map[int][]lib.RestWatchStandingsHistoryResponseItemItem, si *lib.RestWatchSessionInfoResponse, opts viewOptions) {
+	th := opts.Theme
+	var session string
+	if si != nil {
+		session = si.Session
+	}
+
+	sortByPosition(standings)
+	if opts.ByClass {
+		sort.SliceStable(standings, func(i, j int) bool {
+			return carclass.Default().Less(standings[i].CarClass, standings[j].CarClass)
+		})
+	}
 
 	classCount := map[int]int{}
 	pic := map[int]int{}
@@ -98,16 +468,23 @@ func render(standings []lib.RestWatchStandingsResponseItem, history map[int][]li
 		pic[int(s.SlotID)] = classPosCounter[s.CarClass]
 	}
 
+	race := isRaceSession(session)
+
+	// Best laps come from the history where there is one, so laps inferred
+	// to be invalid (see lib/analysis) do not go purple
+	best := map[int]float64{}
+	classBest := map[string]float64{}
 	for _, s := range standings {
-		spd := s.CarVelocity.Velocity * 3.6
-		slot := int(s.SlotID)
-		if spd > maxSpeeds[slot] {
-			maxSpeeds[slot] = spd
+		b := s.BestLapTime
+		if laps := history[int(s.SlotID)]; len(laps) > 0 {
+			b = analysis.DefaultValidity.BestValid(analysis.FromHistory(laps))
+		}
+		best[int(s.SlotID)] = b
+		if b > 0 && (classBest[s.CarClass] == 0 || b < classBest[s.CarClass]) {
+			classBest[s.CarClass] = b
 		}
 	}
 
-	race := isRaceSession(session)
-
 	var leaderBest float64
 	if !race && len(standings) > 0 {
 		leaderBest = standings[0].BestLapTime
@@ -120,24 +497,83 @@ func render(standings []lib.RestWatchStandingsResponseItem, history map[int][]li
 	if sessionLabel == "" {
 		sessionLabel = "---"
 	}
-	fmt.Fprintf(&buf, "  LMU Live  |  %s  |  %s  |  %d cars\033[K\n\n",
+	title := fmt.Sprintf("  LMU Live  |  %s  |  %s  |  %d cars",
 		strings.ToUpper(sessionLabel), time.Now().Format("15:04:05"), len(standings))
+	if si != nil {
+		title += "  |  " + fmtConditions(lib.ConditionsOf(si))
+	}
+	fmt.Fprintf(&buf, "%s\033[K\n", paint(th.Header, title, ""))
+	pace := strategy.CarPace(standings, history, strategy.DefaultPaceLaps)
+	if race && si != nil {
+		var leaderPace float64
+		for _, s := range standings {
+			if s.Position == 1 {
+				leaderPace = pace[int(s.SlotID)]
+			}
+		}
+		fmt.Fprintf(&buf, "%s\033[K\n", paint(th.Header, progressLine(strategy.RaceProgress(si, standings, leaderPace), standings), ""))
+	}
+	cols := opts.Columns
+	var quali map[int]timing.QualiCar
+	if !race && timing.IsQualifying(session) {
+		quali = timing.Qualifying(si, standings)
+		if opts.QualiColumns != nil {
+			cols = opts.QualiColumns
+		}
+		if si != nil && si.EndEventTime > 0 {
+			fmt.Fprintf(&buf, "%s\033[K\n", paint(th.Header, qualiLine(si, standings, quali), ""))
+		}
+	}
+	if opts.PitSpeeding {
+		renderPitSpeeding(&buf, th)
+	} else if opts.Status == "" && opts.Crossover != "" {
+		renderCrossover(&buf, opts.Crossover, th)
+	} else {
+		renderBanner(&buf, opts.Status, th)
+	}
 
-	hdr := fmt.Sprintf(
-		"%3s %4s  %-16s %-22s %-5s %3s %4s %8s %7s %7s %7s %8s %8s %5s %3s",
-		"P", "#", "Team", "Driver", "Cls", "PIC", "Laps", "Gap", "S1", "S2", "S3", "Last", "Best", "Vmax", "Pit",
-	)
+	var net map[int]strategy.NetGap
+	pitLoss := opts.PitLoss
+	if race && hasColumn(cols, "Net") {
+		if pitLoss <= 0 {
+			pitLoss = strategy.PitLoss(history, pace)
+		}
+		net = strategy.NetGaps(standings, pitLoss)
+	}
+
+	headers := make(map[string]string, len(cols))
+	for _, c := range cols {
+		headers[c.name] = c.name
+	}
+	if opts.ByClass {
+		headers["Gap"] = "Cls Gap"
+	}
+	hdr := tableRow(cols, headers)
 	fmt.Fprintf(&buf, "%s\033[K\n", hdr)
-	fmt.Fprintf(&buf, "%s\033[K\n", strings.Repeat("─", len(hdr)))
+	fmt.Fprintf(&buf, "%s\033[K\n", strings.Repeat("─", visibleLen(hdr)))
 
+	var rivals map[int]strategy.RivalStint
+	if race {
+		rivals = strategy.RivalStints(standings, history)
+	}
+	classSize := map[string]int{}
 	for _, s := range standings {
+		classSize[s.CarClass]++
+	}
+	var ahead lib.RestWatchStandingsResponseItem // previous car, in class mode in the same class
+	classLeader := map[string]lib.RestWatchStandingsResponseItem{}
+	var selected lib.RestWatchStandingsResponseItem
+	var found bool
+	for i, s := range standings {
 		slot := int(s.SlotID)
-
-		carNum := s.CarNumber
-		if carNum == "" {
-			carNum = extractCarNum(s.VehicleName)
+		if opts.ByClass && (i == 0 || s.CarClass != standings[i-1].CarClass) {
+			cls := carclass.Lookup(s.CarClass)
+			fmt.Fprintf(&buf, "%s\033[K\n", paint(classCode(cls), fmt.Sprintf("── %s (%d) ", cls.Name, classSize[s.CarClass]), ""))
+			classLeader[s.CarClass] = s
 		}
 
+		carNum := carNumber(s)
+
 		team := truncate(s.FullTeamName, 16)
 		if team == "" {
 			team = truncate(extractTeam(s.VehicleName), 16)
@@ -174,46 +610,445 @@ func render(standings []lib.RestWatchStandingsResponseItem, history map[int][]li
 			}
 		}
 
+		// Int is the gap to the car ahead, in class mode in the same class
+		interval := "---"
+		first := i == 0
+		if opts.ByClass {
+			leader := classLeader[s.CarClass]
+			first = leader.SlotID == s.SlotID
+			if first {
+				gap = "---"
+			} else if race {
+				gap = raceGap(s, leader)
+			} else {
+				gap = bestGap(best[slot], best[int(leader.SlotID)])
+			}
+		}
+		if !first {
+			if race {
+				interval = raceGap(s, ahead)
+			} else {
+				interval = bestGap(best[slot], best[int(ahead.SlotID)])
+			}
+		}
+		ahead = s
+
 		marker := " "
 		if s.Player {
 			marker = ">"
 		}
 
 		status := ""
-		if s.PitState != "NONE" || s.InGarageStall {
+		inPit := s.PitState != "NONE" || s.InGarageStall
+		if inPit {
 			status = " PIT"
 		}
 
-		line := fmt.Sprintf(
-			"%s%2.0f %4s  %-16s %-22s %-5s %3d %4.0f %8s %7s %7s %7s %8s %8s %5.0f %3.0f%s",
-			marker,
-			s.Position,
-			carNum,
-			team,
-			driver,
-			s.CarClass,
-			pic[slot],
-			s.LapsCompleted,
-			gap,
-			fmtSec(s1), fmtSec(s2), fmtSec(s3),
-			fmtLap(s.LastLapTime),
-			fmtLap(s.BestLapTime),
-			maxSpeeds[slot],
-			s.Pitstops,
-			status,
-		)
+		cls := carclass.Lookup(s.CarClass)
+		var rowCode string
+		switch {
+		case s.Player:
+			rowCode = th.Player
+		case race && s.Position > 1 && s.LapsBehindNext == 0 && s.TimeBehindNext > 0 && s.TimeBehindNext < th.BattleWithin:
+			rowCode = th.Battle
+		case inPit:
+			rowCode = th.Dim
+		case th.ClassRows:
+			rowCode = classCode(cls)
+		}
+		if in := opts.Inspect; in != nil && in.Selected && in.Slot == slot {
+			rowCode = strings.TrimPrefix(rowCode+";7", ";")
+			selected, found = s, true
+		}
 
-		if s.Player {
-			fmt.Fprintf(&buf, "\033[1;36m%s\033[0m\033[K\n", line)
+		clsCell := fmt.Sprintf("%-5s", cls.Abbrev)
+		if th.ClassColors && !th.ClassRows {
+			clsCell = paint(classCode(cls), clsCell, rowCode)
+		}
+		lastCell := fmt.Sprintf("%8s", fmtLap(s.LastLapTime))
+		bestCell := fmt.Sprintf("%8s", fmtLap(best[slot]))
+		if best[slot] > 0 && best[slot] == classBest[s.CarClass] {
+			bestCell = paint(th.Purple, bestCell, rowCode)
+		}
+		if s.LastLapTime > 0 && s.LastLapTime == best[slot] {
+			code := th.Green
+			if s.LastLapTime == classBest[s.CarClass] {
+				code = th.Purple
+			}
+			lastCell = paint(code, lastCell, rowCode)
+		}
+
+		tlCell := "  "
+		if n := opts.Limits.Count(slot); n > 0 {
+			tlCell = fmt.Sprintf("%2d", n)
+			if opts.Limits.Near(n) {
+				tlCell = paint(th.Warning, tlCell, rowCode)
+			}
+		}
+
+		stopCell := "    "
+		if r := rivals[slot]; r.Known() && !inPit {
+			if r.LapsLeft <= 0 {
+				stopCell = paint(th.Warning, " due", rowCode)
+			} else {
+				stopCell = fmt.Sprintf("%4.0f", math.Ceil(r.LapsLeft))
+			}
+		}
+
+		trendCell := ""
+		if d, ok := opts.Gaps.Trend(slot, trendLaps); ok {
+			trendCell = fmt.Sprintf("%+.1f", d)
+		}
+
+		var gridCell, liveCell string
+		if q, ok := quali[slot]; ok {
+			gridCell = qualiGrid(q, opts.ByClass)
+			liveCell = qualiLive(q, inPit || finished(s), si.EndEventTime > 0, th, rowCode)
+		}
+
+		cells := map[string]string{
+			"P":      marker + fmt.Sprintf("%2.0f", s.Position),
+			"Grid":   gridCell,
+			"Live":   liveCell,
+			"#":      numberCell(th, opts.Colors, carNum, carNum, rowCode),
+			"Team":   team,
+			"Driver": driver,
+			"Cls":    clsCell,
+			"PIC":    fmt.Sprint(pic[slot]),
+			"Laps":   fmt.Sprintf("%.0f", s.LapsCompleted),
+			"Gap":    gap,
+			"Int":    interval,
+			"Trend":  trendCell,
+			"S1":     fmtSec(s1),
+			"S2":     fmtSec(s2),
+			"S3":     fmtSec(s3),
+			"Last":   lastCell,
+			"Best":   bestCell,
+			"Vmax":   fmt.Sprintf("%.0f", vmax(opts.Vmax.Car(slot), opts.VmaxMode)),
+			"TL":     tlCell,
+			"Pit":    fmt.Sprintf("%.0f", s.Pitstops),
+			"Stop":   stopCell,
+		}
+		if net != nil {
+			cells["Net"] = netCell(net[slot], pitLoss, th, rowCode)
+		}
+		line := tableRow(cols, cells) + status
+
+		fmt.Fprintf(&buf, "%s\033[K\n", paint(rowCode, line, ""))
+	}
+
+	if in := opts.Inspect; in != nil && in.Selected && found {
+		if in.Open {
+			slot := int(selected.SlotID)
+			renderInspect(&buf, selected, history[slot], opts.Gaps.History(slot), th)
 		} else {
-			fmt.Fprintf(&buf, "%s\033[K\n", line)
+			fmt.Fprintf(&buf, "%s\033[K\n", paint(th.Dim, "  ↑/↓ select  •  Enter details  •  Esc clear", ""))
 		}
 	}
+	if net != nil {
+		renderRejoin(&buf, standings, pitLoss, th)
+	}
+	if opts.TrafficLaps > 0 && si != nil {
+		renderTraffic(&buf, strategy.Traffic(standings, pace, si.LapDistance, opts.TrafficLaps), opts.TrafficLaps, th)
+	}
+	if opts.RunLaps > 0 && isPracticeSession(session) {
+		renderLongRuns(&buf, strategy.LongRuns(standings, history, opts.RunLaps), opts.RunLaps, th)
+	}
+	if opts.DriveTime != nil {
+		renderDriveWarnings(&buf, opts.DriveTime.Warnings(), standings, th)
+	}
+	if opts.Plugins != nil {
+		opts.Plugins.Render(&buf, th)
+	}
 	fmt.Fprintf(&buf, "\033[J")
 
 	os.Stdout.Write(buf.Bytes())
 }
 
+// progressLine is the race header: the player's lap and distance, the time
+// left and the leader's lap, e.g. "Lap 138/~176  •  1h 23m remaining  •
+// leader on lap 142". "~" marks a distance estimated from the leader's pace
+// in a timed race, "—" an unknown one.
+func progressLine(p strategy.Progress, standings []lib.RestWatchStandingsResponseItem) string {
+	laps := func(lap, total int) string {
+		switch {
+		case total == 0:
+			return fmt.Sprintf("%d/—", lap)
+		case p.Estimated:
+			return fmt.Sprintf("%d/~%d", lap, total)
+		}
+		return fmt.Sprintf("%d/%d", lap, total)
+	}
+	// The player's lap when they are behind the leader, else the leader's
+	var parts []string
+	player := -1
+	for i, s := range standings {
+		if s.Player && s.Position != 1 {
+			player = i
+		}
+	}
+	if player >= 0 {
+		parts = append(parts, "Lap "+laps(p.CarLaps(standings[player])))
+	} else {
+		parts = append(parts, "Lap "+laps(p.LeaderLap, p.TotalLaps))
+	}
+	if p.Timed {
+		if p.TimeLeft > 0 {
+			parts = append(parts, fmtRemaining(p.TimeLeft)+" remaining")
+		} else {
+			parts = append(parts, "time expired, final lap")
+		}
+	}
+	if player >= 0 {
+		parts = append(parts, fmt.Sprintf("leader on lap %d", p.LeaderLap))
+	}
+	return "  " + strings.Join(parts, "  •  ")
+}
+
+// qualiLine is the qualifying header: the time left and how many cars can
+// still start a lap, or how many are on their last one after the flag.
+func qualiLine(si *lib.RestWatchSessionInfoResponse, standings []lib.RestWatchStandingsResponseItem, quali map[int]timing.QualiCar) string {
+	left := si.EndEventTime - si.CurrentEventTime
+	if left <= 0 {
+		onTrack := 0
+		for _, s := range standings {
+			if s.PitState == "NONE" && !s.InGarageStall && !finished(s) {
+				onTrack++
+			}
+		}
+		return fmt.Sprintf("  Qualifying  •  time expired  •  %d on their last lap", onTrack)
+	}
+	can := 0
+	for _, q := range quali {
+		if q.CanStart {
+			can++
+		}
+	}
+	return fmt.Sprintf("  Qualifying  •  %s remaining  •  %d of %d cars can start a lap", fmtRemaining(left), can, len(standings))
+}
+
+// qualiGrid is a car's provisional grid slot, in class when the table is
+// grouped by class.
+func qualiGrid(q timing.QualiCar, byClass bool) string {
+	n := q.Grid
+	if byClass {
+		n = q.ClassGrid
+	}
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprint(n)
+}
+
+// qualiLive is the lap in progress against the car's best at the last
+// split, green when improving. In timed sessions a car that cannot start
+// another lap before the flag shows "last" while on track and "done" once
+// in the pits or finished.
+func qualiLive(q timing.QualiCar, stopped, timed bool, th theme, rowCode string) string {
+	switch {
+	case q.Split > 0:
+		cell := fmt.Sprintf("%+.3f", q.Delta)
+		if q.Improving() {
+			cell = paint(th.Green, cell, rowCode)
+		}
+		return cell
+	case !timed || q.CanStart:
+		return ""
+	case stopped:
+		return paint(th.Dim, "done", rowCode)
+	}
+	return "last"
+}
+
+// finished reports whether a car has taken the chequered flag or retired.
+func finished(s lib.RestWatchStandingsResponseItem) bool {
+	return s.FinishStatus != "" && s.FinishStatus != "FSTAT_NONE" && s.FinishStatus != "NONE"
+}
+
+// fmtRemaining formats a time left coarsely: 1h 23m, 23m, 45s.
+func fmtRemaining(sec float64) string {
+	s := int(sec)
+	switch {
+	case s >= 3600:
+		return fmt.Sprintf("%dh %02dm", s/3600, s/60%60)
+	case s >= 60:
+		return fmt.Sprintf("%dm", s/60)
+	}
+	return fmt.Sprintf("%ds", s)
+}
+
+// renderTraffic draws the player's traffic forecast below the table.
+func renderTraffic(buf *bytes.Buffer, enc []strategy.Encounter, laps float64, th theme) {
+	const maxLines = 5
+	fmt.Fprintf(buf, "\033[K\n%s\033[K\n", paint(th.Header, fmt.Sprintf("  Traffic next %.0f laps", laps), ""))
+	if len(enc) == 0 {
+		fmt.Fprintf(buf, "  clear\033[K\n")
+		return
+	}
+	for i, e := range enc {
+		if i == maxLines {
+			break
+		}
+		verb := "catch"
+		if !e.Catching {
+			verb = "caught by"
+		}
+		if e.Lapping {
+			verb = "lap"
+			if !e.Catching {
+				verb = "lapped by"
+			}
+		}
+		cls := carclass.Lookup(e.CarClass)
+		fmt.Fprintf(buf, "  %-9s #%-4s %s %-22s in %5.0fs (lap +%.1f)\033[K\n",
+			verb, e.CarNumber, paint(classCode(cls), fmt.Sprintf("%-4s", cls.Abbrev), ""),
+			truncate(e.Driver, 22), e.InSec, e.InLaps)
+	}
+}
+
+// renderLongRuns ranks the cars by the pace of their fastest long run, with
+// where their best lap alone would put them.
+func renderLongRuns(buf *bytes.Buffer, runs []strategy.LongRun, minLaps int, th theme) {
+	const maxLines = 10
+	fmt.Fprintf(buf, "\033[K\n%s\033[K\n", paint(th.Header, fmt.Sprintf("  Long runs (%d+ green laps)", minLaps), ""))
+	byLap := make([]float64, 0, len(runs))
+	for _, r := range runs {
+		if r.BestLap > 0 {
+			byLap = append(byLap, r.BestLap)
+		}
+	}
+	sort.Float64s(byLap)
+	var first float64
+	n := 0
+	for _, r := range runs {
+		if r.Pace <= 0 {
+			break
+		}
+		if n == 0 {
+			first = r.Pace
+		}
+		n++
+		if n > maxLines {
+			break
+		}
+		cls := carclass.Lookup(r.CarClass)
+		gap := "        "
+		if n > 1 {
+			gap = fmt.Sprintf("%+8.3f", r.Pace-first)
+		}
+		stints := fmt.Sprintf("%d runs", len(r.Runs))
+		if len(r.Runs) == 1 {
+			stints = "1 run"
+		}
+		fmt.Fprintf(buf, "  %2d  #%-4s %s %-22s %8s %s  %3d laps  %-7s  best %s (P%d)\033[K\n",
+			n, r.CarNumber, paint(classCode(cls), fmt.Sprintf("%-4s", cls.Abbrev), ""),
+			truncate(r.Driver, 22), fmtLap(r.Pace), gap, r.Laps,
+			stints, fmtLap(r.BestLap), sort.SearchFloat64s(byLap, r.BestLap)+1)
+	}
+	if n == 0 {
+		fmt.Fprintf(buf, "  no runs of %d green laps yet\033[K\n", minLaps)
+	}
+}
+
+// renderDriveWarnings shows the player car's driver time warnings.
+func renderDriveWarnings(buf *bytes.Buffer, warnings []strategy.DriveWarning, standings []lib.RestWatchStandingsResponseItem, th theme) {
+	player := -1
+	for _, s := range standings {
+		if s.Player {
+			player = int(s.SlotID)
+		}
+	}
+	for _, w := range warnings {
+		if w.SlotID != player {
+			continue
+		}
+		msg := fmt.Sprintf("  %s: %s limit in %s (%s of %s)", w.Driver, w.Kind,
+			w.Remaining.Round(time.Second), w.Used.Round(time.Second), w.Limit)
+		code := th.Battle
+		if w.Exceeded() {
+			msg = fmt.Sprintf("  %s: %s limit EXCEEDED by %s", w.Driver, w.Kind, (-w.Remaining).Round(time.Second))
+			code = "1;" + th.Battle
+		}
+		fmt.Fprintf(buf, "\033[K\n%s\033[K\n", paint(code, msg, ""))
+	}
+}
+
+// netCell shows a car's net gap to the net class leader. Cars that still
+// have to make this cycle's stop are marked with "*"; without a pit loss
+// there is nothing to adjust by, so the cell stays empty.
+func netCell(n strategy.NetGap, pitLoss float64, th theme, rowCode string) string {
+	switch {
+	case pitLoss <= 0:
+		return "   --.--"
+	case n.Position == 1:
+		return "     ---"
+	}
+	gap := fmtGap(n.Gap)
+	if n.Laps > 0 {
+		gap = fmt.Sprintf("+%.0fL", n.Laps)
+	}
+	if n.Owed > 0 {
+		return paint(th.Dim, fmt.Sprintf("%8s", gap+"*"), rowCode)
+	}
+	return fmt.Sprintf("%8s", gap)
+}
+
+// renderRejoin projects where the player comes out if they pit now.
+func renderRejoin(buf *bytes.Buffer, standings []lib.RestWatchStandingsResponseItem, pitLoss float64, th theme) {
+	if pitLoss <= 0 {
+		return
+	}
+	byslot := map[int]lib.RestWatchStandingsResponseItem{}
+	player := -1
+	for _, s := range standings {
+		byslot[int(s.SlotID)] = s
+		if s.Player {
+			player = int(s.SlotID)
+		}
+	}
+	r, ok := strategy.ProjectRejoin(standings, player, pitLoss)
+	if !ok {
+		return
+	}
+	msg := fmt.Sprintf("  Pit now (%.0fs): rejoin P%d in class", pitLoss, r.Position)
+	if r.Ahead >= 0 && r.GapAhead > 0 {
+		msg += fmt.Sprintf(", %.1fs behind #%s", r.GapAhead, carNumber(byslot[r.Ahead]))
+	}
+	if r.Behind >= 0 && r.GapBehind > 0 {
+		msg += fmt.Sprintf(", %.1fs ahead of #%s", r.GapBehind, carNumber(byslot[r.Behind]))
+	}
+	fmt.Fprintf(buf, "\033[K\n%s\033[K\n", paint(th.Header, msg, ""))
+}
+
+// raceGap is how far s is behind a car ahead of it, from both cars' gaps to
+// the overall leader.
+func raceGap(s, ahead lib.RestWatchStandingsResponseItem) string {
+	if laps := s.LapsBehindLeader - ahead.LapsBehindLeader; laps > 0 {
+		return fmt.Sprintf("%8s", fmt.Sprintf("+%.0fL", laps))
+	}
+	if d := s.TimeBehindLeader - ahead.TimeBehindLeader; d > 0.001 {
+		return fmt.Sprintf("%8s", fmtGap(d))
+	}
+	return "     ---"
+}
+
+// bestGap is the difference between two best laps outside races.
+func bestGap(best, ref float64) string {
+	switch {
+	case best <= 0 || ref <= 0:
+		return "   --.--"
+	case best-ref > 0.001:
+		return fmt.Sprintf("%8s", fmtGap(best-ref))
+	}
+	return "     ---"
+}
+
+func sortByPosition(standings []lib.RestWatchStandingsResponseItem) {
+	sort.Slice(standings, func(i, j int) bool {
+		return standings[i].Position < standings[j].Position
+	})
+}
+
 func extractCarNum(vn string) string {
 	idx := strings.LastIndex(vn, "#")
 	if idx < 0 {
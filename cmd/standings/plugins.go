@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/events"
+	"github.com/snipem/go-lmu-api/lib/plugin"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// stringList is a repeatable string flag.
+type stringList []string
+
+func (l *stringList) String() string     { return strings.Join(*l, ", ") }
+func (l *stringList) Set(v string) error { *l = append(*l, v); return nil }
+
+// plugins holds the running plugins and the panels and notifications they
+// contributed on the last tick.
+type plugins struct {
+	list   []*plugin.Plugin
+	panels []plugin.Panel
+	notes  []string
+	ticker *events.Ticker
+}
+
+func startPlugins(cmdlines []string, ticker *events.Ticker) (*plugins, error) {
+	ps := &plugins{ticker: ticker}
+	for _, c := range cmdlines {
+		p, err := plugin.Start(context.Background(), c, "standings")
+		if err != nil {
+			ps.Close()
+			return nil, err
+		}
+		ps.list = append(ps.list, p)
+	}
+	return ps, nil
+}
+
+// Tick queues the frame for every plugin and collects panels. A plugin that
+// doesn't answer in time keeps its previous panel.
+func (ps *plugins) Tick(standings []lib.RestWatchStandingsResponseItem, si *lib.RestWatchSessionInfoResponse, meta watch.FrameMeta) {
+	if len(ps.list) == 0 {
+		return
+	}
+	if len(ps.panels) != len(ps.list) {
+		ps.panels = make([]plugin.Panel, len(ps.list))
+	}
+	for i, p := range ps.list {
+		// Snapshot only queues the frame; a plugin that is behind is not
+		// asked for its panel either (the plugin logs the drops)
+		if err := p.Snapshot(plugin.Snapshot{Standings: standings, Session: si, Meta: &meta}); err != nil {
+			if !errors.Is(err, plugin.ErrDropped) {
+				fmt.Fprintf(os.Stderr, "\rplugin %s: %v", p.Info.Name, err)
+			}
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		if panel, err := p.Panel(ctx); err == nil {
+			ps.panels[i] = panel
+		}
+		cancel()
+	drain:
+		for {
+			select {
+			case n := <-p.Notify:
+				ps.notes = append(ps.notes, fmt.Sprintf("%s  %s: %s", time.Now().Format("15:04:05"), p.Info.Name, n.Text))
+			default:
+				break drain
+			}
+		}
+	}
+	if len(ps.notes) > 3 {
+		ps.notes = ps.notes[len(ps.notes)-3:]
+	}
+}
+
+// Events sends events to the sink plugins and lists them with the
+// notifications in the footer.
+func (ps *plugins) Events(evs []events.Event) {
+	for _, e := range evs {
+		for _, p := range ps.list {
+			if err := p.Event(e); err != nil && !errors.Is(err, plugin.ErrDropped) {
+				fmt.Fprintf(os.Stderr, "\rplugin %s: %v", p.Info.Name, err)
+			}
+		}
+		text, err := ps.ticker.Line(e)
+		if err != nil {
+			text = err.Error()
+		}
+		if text != "" {
+			ps.notes = append(ps.notes, fmt.Sprintf("%s  %s", e.At.Format("15:04:05"), text))
+		}
+	}
+	if len(ps.notes) > 3 {
+		ps.notes = ps.notes[len(ps.notes)-3:]
+	}
+}
+
+func (ps *plugins) Render(buf *bytes.Buffer, th theme) {
+	for _, panel := range ps.panels {
+		if panel.Title == "" && len(panel.Lines) == 0 {
+			continue
+		}
+		fmt.Fprintf(buf, "\033[K\n%s\033[K\n", paint(th.Header, "  "+panel.Title, ""))
+		for _, l := range panel.Lines {
+			fmt.Fprintf(buf, "  %s\033[K\n", l)
+		}
+	}
+	if len(ps.notes) > 0 {
+		fmt.Fprintf(buf, "\033[K\n")
+		for _, n := range ps.notes {
+			fmt.Fprintf(buf, "  %s\033[K\n", n)
+		}
+	}
+}
+
+func (ps *plugins) Close() {
+	for _, p := range ps.list {
+		p.Close()
+	}
+}
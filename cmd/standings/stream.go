@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// streamRedraw is how often the table is redrawn while the standings
+// stream in.
+const streamRedraw = 100 * time.Millisecond
+
+// streamStandings fetches the standings with the streaming decoder and,
+// every streamRedraw until all cars are in, calls draw with the cars
+// received so far over the last frame's cars for the rest.
+func streamStandings(ctx context.Context, client *lib.Client, last []lib.RestWatchStandingsResponseItem, draw func([]lib.RestWatchStandingsResponseItem)) ([]lib.RestWatchStandingsResponseItem, error) {
+	cars := make(chan lib.RestWatchStandingsResponseItem, 16)
+	errc := make(chan error, 1)
+	go func() { errc <- client.StreamStandings(ctx, cars) }()
+	var got []lib.RestWatchStandingsResponseItem
+	drawn := time.Now()
+	for s := range cars {
+		got = append(got, s)
+		if time.Since(drawn) >= streamRedraw {
+			partial, _ := watch.SanitizeStandings(mergeStandings(last, got))
+			draw(partial)
+			drawn = time.Now()
+		}
+	}
+	return got, <-errc
+}
+
+// mergeStandings returns last with the cars in fresh replacing theirs by
+// slot, and new slots added.
+func mergeStandings(last, fresh []lib.RestWatchStandingsResponseItem) []lib.RestWatchStandingsResponseItem {
+	out := append([]lib.RestWatchStandingsResponseItem(nil), last...)
+	index := make(map[float64]int, len(out))
+	for i, s := range out {
+		index[s.SlotID] = i
+	}
+	for _, s := range fresh {
+		if i, ok := index[s.SlotID]; ok {
+			out[i] = s
+		} else {
+			index[s.SlotID] = len(out)
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/carcolor"
+	"github.com/snipem/go-lmu-api/lib/config"
+)
+
+// theme is a resolved config.Theme with every role filled in.
+type theme struct {
+	Header       string
+	Player       string
+	Battle       string
+	Purple       string
+	Green        string
+	Dim          string
+	Warning      string
+	ClassColors  bool
+	ClassRows    bool
+	CarNumbers   bool
+	BattleWithin float64
+}
+
+var builtinThemes = map[string]theme{
+	"default": {
+		Header: "1", Player: "1;36", Battle: "33", Purple: "35", Green: "32", Dim: "2", Warning: "1;31",
+		ClassColors: true, CarNumbers: true, BattleWithin: 1.0,
+	},
+	"mono": {
+		Header: "1", Player: "1;7", Battle: "4", Purple: "1", Green: "1", Dim: "2", Warning: "1;4",
+		BattleWithin: 1.0,
+	},
+	"contrast": {
+		Header: "1;97", Player: "1;30;106", Battle: "1;93", Purple: "1;95", Green: "1;92", Dim: "37", Warning: "1;97;41",
+		ClassColors: true, ClassRows: true, CarNumbers: true, BattleWithin: 1.0,
+	},
+}
+
+// themeNames lists built-in and user-defined theme names.
+func themeNames(cfg *config.Config) []string {
+	set := map[string]bool{}
+	for n := range builtinThemes {
+		set[n] = true
+	}
+	for n := range cfg.Themes {
+		set[n] = true
+	}
+	names := make([]string, 0, len(set))
+	for n := range set {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resolveTheme picks a theme by name. User-defined themes override built-in
+// ones role by role, falling back to the built-in of the same name or to
+// "default".
+func resolveTheme(name string, cfg *config.Config) (theme, error) {
+	if name == "" {
+		name = "default"
+	}
+	base, ok := builtinThemes[name]
+	if !ok {
+		base = builtinThemes["default"]
+	}
+	user, hasUser := cfg.Themes[name]
+	if !ok && !hasUser {
+		return theme{}, fmt.Errorf("unknown theme %q (available: %s)", name, strings.Join(themeNames(cfg), ", "))
+	}
+	if !hasUser {
+		return base, nil
+	}
+	pick := func(user, base string) string {
+		if user != "" {
+			return user
+		}
+		return base
+	}
+	t := theme{
+		Header:       pick(user.Header, base.Header),
+		Player:       pick(user.Player, base.Player),
+		Battle:       pick(user.Battle, base.Battle),
+		Purple:       pick(user.Purple, base.Purple),
+		Green:        pick(user.Green, base.Green),
+		Dim:          pick(user.Dim, base.Dim),
+		Warning:      pick(user.Warning, base.Warning),
+		ClassColors:  base.ClassColors,
+		ClassRows:    base.ClassRows,
+		CarNumbers:   base.CarNumbers,
+		BattleWithin: base.BattleWithin,
+	}
+	if user.ClassColors != nil {
+		t.ClassColors = *user.ClassColors
+	}
+	if user.ClassRows != nil {
+		t.ClassRows = *user.ClassRows
+	}
+	if user.CarNumbers != nil {
+		t.CarNumbers = *user.CarNumbers
+	}
+	if user.BattleWithin > 0 {
+		t.BattleWithin = user.BattleWithin
+	}
+	return t, nil
+}
+
+// paint wraps text in an SGR sequence. rowCode is re-applied after the reset
+// so a colored cell does not end the row's own highlight.
+func paint(code, text, rowCode string) string {
+	if code == "" {
+		return text
+	}
+	s := "\033[" + code + "m" + text + "\033[0m"
+	if rowCode != "" {
+		s += "\033[" + rowCode + "m"
+	}
+	return s
+}
+
+func classCode(c carclass.Class) string {
+	return fmt.Sprintf("38;5;%d", c.ANSI)
+}
+
+// numberCell colors a car number cell with the car's color when the theme
+// asks for it.
+func numberCell(th theme, colors *carcolor.Book, number, cell, rowCode string) string {
+	if !th.CarNumbers || colors == nil || strings.TrimSpace(number) == "" {
+		return cell
+	}
+	return paint(fmt.Sprintf("1;38;5;%d", colors.Get(number).ANSI), cell, rowCode)
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+)
+
+// retryEvery is the wait before dialling the relay again after a failure.
+const retryEvery = 5 * time.Second
+
+func runConnect(args []string) error {
+	fs := flag.NewFlagSet("connect", flag.ExitOnError)
+	relayURL := fs.String("relay", "", "URL of the relay, e.g. https://relay.example.org:7400")
+	token := fs.String("token", os.Getenv("LMU_TUNNEL_TOKEN"), "The relay's agent token (default $LMU_TUNNEL_TOKEN)")
+	baseURL := fs.String("base", "http://localhost:6397", "Base URL of the API")
+	caFile := fs.String("ca", "", "Trust the relay's certificate from this PEM file, e.g. a self-signed one")
+	workers := fs.Int("workers", 4, "Crew requests carried at the same time")
+	fs.Parse(args)
+	if *relayURL == "" || *token == "" {
+		return fmt.Errorf("connect needs -relay and -token")
+	}
+	if *workers < 1 {
+		*workers = 1
+	}
+
+	a := &agent{
+		relay: strings.TrimSuffix(*relayURL, "/"),
+		token: *token,
+		base:  strings.TrimSuffix(*baseURL, "/"),
+		// The relay holds a poll open for agentPoll
+		relayClient: &http.Client{Timeout: agentPoll + 15*time.Second},
+		gameClient:  &http.Client{Timeout: requestTimeout},
+	}
+	if *caFile != "" {
+		pem, err := os.ReadFile(*caFile)
+		if err != nil {
+			return err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates in %s", *caFile)
+		}
+		t := http.DefaultTransport.(*http.Transport).Clone()
+		t.TLSClientConfig = &tls.Config{RootCAs: pool}
+		a.relayClient.Transport = t
+	}
+	loop := run.New(0)
+	fmt.Fprintf(os.Stderr, "Carrying %s to %s\n", a.relay, a.base)
+	for i := 0; i < *workers; i++ {
+		go a.work(loop.Context())
+	}
+	return loop.Wait()
+}
+
+// agent polls the relay for crew requests and answers them from the game.
+type agent struct {
+	relay, token, base string
+	relayClient        *http.Client
+	gameClient         *http.Client
+
+	mu    sync.Mutex
+	state string // last reported, so each change is reported once
+}
+
+func (a *agent) work(ctx context.Context) {
+	for ctx.Err() == nil {
+		req, err := a.next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			a.report("Relay unreachable: " + err.Error() + ", retrying")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryEvery):
+			}
+			continue
+		}
+		a.report("Connected to the relay")
+		if req == nil {
+			continue
+		}
+		if err := a.reply(ctx, a.forward(ctx, *req)); err != nil && ctx.Err() == nil {
+			a.report("Relay unreachable: " + err.Error() + ", retrying")
+		}
+	}
+}
+
+func (a *agent) report(state string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if state != a.state {
+		a.state = state
+		fmt.Fprintln(os.Stderr, state)
+	}
+}
+
+// next waits for a crew request; nil when the poll ended without one.
+func (a *agent) next(ctx context.Context) (*tunnelRequest, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.relay+nextPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+a.token)
+	resp, err := a.relayClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent:
+		return nil, nil
+	default:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 200))
+		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	var tr tunnelRequest
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return nil, err
+	}
+	return &tr, nil
+}
+
+// forward makes the crew's request to the game. A game that cannot be
+// reached, or whose answer is over maxResponseBody, answers 502.
+func (a *agent) forward(ctx context.Context, tr tunnelRequest) tunnelResponse {
+	out := tunnelResponse{ID: tr.ID}
+	req, err := http.NewRequestWithContext(ctx, tr.Method, a.base+tr.Path, bytes.NewReader(tr.Body))
+	if err == nil {
+		if len(tr.Body) > 0 {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		var resp *http.Response
+		if resp, err = a.gameClient.Do(req); err == nil {
+			defer resp.Body.Close()
+			out.Status, out.ContentType = resp.StatusCode, resp.Header.Get("Content-Type")
+			out.Body, err = io.ReadAll(io.LimitReader(resp.Body, maxResponseBody+1))
+			if err == nil && len(out.Body) > maxResponseBody {
+				err = fmt.Errorf("the game's answer is over %d MiB", maxResponseBody>>20)
+			}
+		}
+	}
+	if err != nil {
+		out.Status, out.ContentType, out.Body = http.StatusBadGateway, "text/plain", []byte(err.Error())
+	}
+	return out
+}
+
+// reply sends the game's answer to the relay.
+func (a *agent) reply(ctx context.Context, tr tunnelResponse) error {
+	body, err := json.Marshal(tr)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.relay+replyPath, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+a.token)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := a.relayClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Remote spectating tunnel for the LMU API.
+// The game's API only listens on localhost. "tunnel connect" runs on the
+// game's PC and dials out to a relay; "tunnel serve" is the relay, on a
+// host the pit crew can reach. The crew points its tools at the relay and
+// every request travels over the agent's outgoing connection to the game
+// and back, so the game's side needs neither a VPN nor an open port. The
+// agent and the crew authenticate with separate bearer tokens, and the
+// relay serves HTTPS with -cert and -key.
+//
+// Usage (in cmd/):
+//
+//	go run ./tunnel serve [-listen :7400] [-token t] [-crew-token c] [-cert cert.pem -key key.pem] [-read-only]
+//	go run ./tunnel connect -relay https://relay.example.org:7400 [-token t] [-ca cert.pem] [-base http://localhost:6397]
+package main
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const usage = `Usage: tunnel <serve|connect> [flags]
+
+  serve     run the relay the agent and the crew connect to
+  connect   carry the relay's requests to the game's API on this PC
+`
+
+// tunnelRequest is a crew request carried to the agent.
+type tunnelRequest struct {
+	ID     uint64 `json:"id"`
+	Method string `json:"method"`
+	Path   string `json:"path"` // with the query
+	Body   []byte `json:"body,omitempty"`
+}
+
+// tunnelResponse is the game's answer carried back to the relay.
+type tunnelResponse struct {
+	ID          uint64 `json:"id"`
+	Status      int    `json:"status"`
+	ContentType string `json:"contentType,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// The agent's endpoints on the relay. Everything else is the crew's.
+const (
+	nextPath  = "/_tunnel/next"
+	replyPath = "/_tunnel/reply"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch sub, args := os.Args[1], os.Args[2:]; sub {
+	case "serve":
+		err = runServe(args)
+	case "connect":
+		err = runConnect(args)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// authorized reports whether r carries the bearer token, comparing in
+// constant time.
+func authorized(r *http.Request, token string) bool {
+	got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/cmd/internal/run"
+)
+
+const (
+	// agentPoll is how long the relay holds an agent's poll open when no
+	// crew request comes.
+	agentPoll = 25 * time.Second
+	// agentGone is how long after its last poll the agent counts as
+	// disconnected, and crew requests fail at once.
+	agentGone = agentPoll + 10*time.Second
+	// requestTimeout is how long a crew request waits for the game.
+	requestTimeout = 30 * time.Second
+	// maxRequestBody caps the body of a crew request.
+	maxRequestBody = 1 << 20
+	// maxResponseBody caps the game's answer to one; the standings history
+	// of a full grid late in a race is a few MB.
+	maxResponseBody = 16 << 20
+)
+
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	listen := fs.String("listen", ":7400", "Address to listen on for the agent and the crew")
+	token := fs.String("token", os.Getenv("LMU_TUNNEL_TOKEN"), "Token the agent must send (default $LMU_TUNNEL_TOKEN)")
+	crewToken := fs.String("crew-token", os.Getenv("LMU_TUNNEL_CREW_TOKEN"), "Token the crew's tools must send (default $LMU_TUNNEL_CREW_TOKEN)")
+	cert := fs.String("cert", "", "TLS certificate file; serve HTTPS")
+	key := fs.String("key", "", "TLS key file for -cert")
+	readOnly := fs.Bool("read-only", false, "Only pass the crew's GET requests, so it can watch but not change anything in the game")
+	fs.Parse(args)
+	if *token == "" || *crewToken == "" {
+		return fmt.Errorf("serve needs -token and -crew-token")
+	}
+	if *token == *crewToken {
+		return fmt.Errorf("-token and -crew-token must differ, or the crew could stand in for the game")
+	}
+
+	loop := run.New(0)
+	srv := &http.Server{Addr: *listen, Handler: newRelay(loop.Context(), *token, &run.Access{Token: *crewToken, ReadOnly: *readOnly})}
+	if *cert != "" {
+		c, err := tls.LoadX509KeyPair(*cert, *key)
+		if err != nil {
+			return err
+		}
+		srv.TLSConfig = &tls.Config{Certificates: []tls.Certificate{c}}
+	} else {
+		fmt.Fprintf(os.Stderr, "Warning: without -cert the tokens and data cross the network unencrypted; use it or a TLS proxy in front\n")
+	}
+	fmt.Fprintf(os.Stderr, "Relaying on %s\n", *listen)
+	loop.Serve(srv)
+	return loop.Wait()
+}
+
+// relay queues crew requests for the agent's polls and matches the
+// agent's replies to them.
+type relay struct {
+	token string
+	crew  http.Handler // serveCrew behind the crew's token
+	// stop ends the polls and requests held open, so the server can shut
+	// down without waiting for them
+	stop <-chan struct{}
+
+	queue chan *pending
+
+	mu       sync.Mutex
+	waiting  map[uint64]*pending
+	next     uint64
+	lastPoll time.Time
+}
+
+// pending is a crew request on its way to the game. ctx ends when the
+// crew stops waiting for it.
+type pending struct {
+	ctx   context.Context
+	req   tunnelRequest
+	reply chan tunnelResponse
+}
+
+// fail answers p without the game, unless it was answered already.
+func (p *pending) fail(status int, msg string) {
+	select {
+	case p.reply <- tunnelResponse{ID: p.req.ID, Status: status, ContentType: "text/plain; charset=utf-8", Body: []byte(msg + "\n")}:
+	default:
+	}
+}
+
+func newRelay(ctx context.Context, token string, crew *run.Access) *relay {
+	rl := &relay{token: token, stop: ctx.Done(), queue: make(chan *pending, 64), waiting: map[uint64]*pending{}}
+	rl.crew = crew.Handler(http.HandlerFunc(rl.serveCrew))
+	return rl
+}
+
+func (rl *relay) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case nextPath:
+		rl.serveNext(w, r)
+	case replyPath:
+		rl.serveReply(w, r)
+	default:
+		rl.crew.ServeHTTP(w, r)
+	}
+}
+
+// serveNext hands the agent the next crew request, or 204 after agentPoll
+// without one.
+func (rl *relay) serveNext(w http.ResponseWriter, r *http.Request) {
+	if !authorized(r, rl.token) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	rl.mu.Lock()
+	if time.Since(rl.lastPoll) > agentGone {
+		fmt.Fprintf(os.Stderr, "Agent connected from %s\n", r.RemoteAddr)
+	}
+	rl.lastPoll = time.Now()
+	rl.mu.Unlock()
+
+	t := time.NewTimer(agentPoll)
+	defer t.Stop()
+	switch p := rl.take(r.Context(), t.C); {
+	case p == nil:
+		if r.Context().Err() == nil {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	case r.Context().Err() != nil:
+		p.fail(http.StatusBadGateway, "the game dropped its connection to the relay")
+	default:
+		rl.mu.Lock()
+		rl.waiting[p.req.ID] = p
+		rl.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(p.req); err != nil {
+			rl.mu.Lock()
+			delete(rl.waiting, p.req.ID)
+			rl.mu.Unlock()
+			p.fail(http.StatusBadGateway, "the game dropped its connection to the relay")
+		}
+	}
+	rl.mu.Lock()
+	rl.lastPoll = time.Now()
+	rl.mu.Unlock()
+}
+
+// take returns the next crew request the crew still waits for, or nil
+// when the poll times out, the agent goes away or the relay stops first.
+func (rl *relay) take(agent context.Context, timeout <-chan time.Time) *pending {
+	for {
+		select {
+		case p := <-rl.queue:
+			if p.ctx.Err() == nil {
+				return p
+			}
+			// The crew gave up on it; a pit menu change or camera switch
+			// must not reach the game after the crew was told it failed
+		case <-timeout:
+			return nil
+		case <-rl.stop:
+			return nil
+		case <-agent.Done():
+			return nil
+		}
+	}
+}
+
+// serveReply passes the game's answer to the crew request waiting for it.
+func (rl *relay) serveReply(w http.ResponseWriter, r *http.Request) {
+	if !authorized(r, rl.token) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	// The body travels base64 encoded, a third larger
+	var resp tunnelResponse
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 2*maxResponseBody)).Decode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	rl.mu.Lock()
+	p := rl.waiting[resp.ID]
+	delete(rl.waiting, resp.ID)
+	rl.mu.Unlock()
+	if p != nil {
+		p.reply <- resp
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// serveCrew carries a crew request to the agent and waits for the answer.
+func (rl *relay) serveCrew(w http.ResponseWriter, r *http.Request) {
+	rl.mu.Lock()
+	connected := time.Since(rl.lastPoll) <= agentGone
+	rl.next++
+	id := rl.next
+	rl.mu.Unlock()
+	if !connected {
+		http.Error(w, "the game is not connected to the relay", http.StatusServiceUnavailable)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBody+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxRequestBody {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	// Cancelled on return, which drops the request from the queue when
+	// the agent has not taken it yet
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+	p := &pending{ctx: ctx, req: tunnelRequest{ID: id, Method: r.Method, Path: r.URL.RequestURI(), Body: body}, reply: make(chan tunnelResponse, 1)}
+	defer func() {
+		rl.mu.Lock()
+		delete(rl.waiting, id)
+		rl.mu.Unlock()
+	}()
+
+	select {
+	case rl.queue <- p:
+	case <-ctx.Done():
+		if r.Context().Err() == nil {
+			http.Error(w, "the relay is busy", http.StatusServiceUnavailable)
+		}
+		return
+	case <-rl.stop:
+		http.Error(w, "the relay is shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	select {
+	case resp := <-p.reply:
+		if resp.ContentType != "" {
+			w.Header().Set("Content-Type", resp.ContentType)
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(resp.Body)))
+		w.WriteHeader(resp.Status)
+		w.Write(resp.Body)
+	case <-ctx.Done():
+		if r.Context().Err() == nil {
+			http.Error(w, "no answer from the game", http.StatusGatewayTimeout)
+		}
+	case <-rl.stop:
+		http.Error(w, "the relay is shutting down", http.StatusServiceUnavailable)
+	}
+}
@@ -1,3 +1,3 @@
-module go-lmu-api
+module github.com/snipem/go-lmu-api
 
 go 1.22.12
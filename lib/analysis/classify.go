@@ -0,0 +1,103 @@
+package analysis
+
+import (
+	"math"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Kind classifies a lap for pace calculations. Only green laps are
+// representative of a car's pace.
+type Kind string
+
+const (
+	Green   Kind = "green"
+	Untimed Kind = "untimed" // no lap time
+	InLap   Kind = "in-lap"  // ends in the pits
+	OutLap  Kind = "out-lap" // starts from the pits
+	FCY     Kind = "fcy"     // full course yellow during the lap
+	Traffic Kind = "traffic" // held up by the car ahead
+)
+
+// Classifier assigns lap kinds.
+type Classifier struct {
+	// TrafficGap is the on-track gap to the car ahead, in seconds, below
+	// which a lap counts as traffic-affected. 0 disables the check.
+	TrafficGap float64
+}
+
+// DefaultClassifier treats laps that came within a second of the car ahead
+// as traffic.
+var DefaultClassifier = Classifier{TrafficGap: 1.0}
+
+// Classify returns the kind of lap i of a car's laps; earlier laps tell
+// whether it is an out-lap.
+func (c Classifier) Classify(laps []Lap, i int) Kind {
+	l := laps[i]
+	switch {
+	case l.Time <= 0:
+		return Untimed
+	case l.Pit:
+		return InLap
+	case i > 0 && laps[i-1].Pit:
+		return OutLap
+	case l.FCY:
+		return FCY
+	case c.TrafficGap > 0 && l.Gap > 0 && l.Gap < c.TrafficGap:
+		return Traffic
+	}
+	return Green
+}
+
+// Kinds classifies every lap.
+func (c Classifier) Kinds(laps []Lap) []Kind {
+	out := make([]Kind, len(laps))
+	for i := range laps {
+		out[i] = c.Classify(laps, i)
+	}
+	return out
+}
+
+// GreenTimes returns the lap times of the green laps.
+func (c Classifier) GreenTimes(laps []Lap) []float64 {
+	var out []float64
+	for i, l := range laps {
+		if c.Classify(laps, i) == Green {
+			out = append(out, l.Time)
+		}
+	}
+	return out
+}
+
+// GapsAhead returns, per SlotID, the on-track gap in seconds to the nearest
+// car physically ahead regardless of class or position, estimated from lap
+// distances and the following car's speed. Cars in the pits are left out,
+// as are gaps that cannot be estimated (standing cars, unknown track length).
+func GapsAhead(standings []lib.RestWatchStandingsResponseItem, trackLength float64) map[int]float64 {
+	out := make(map[int]float64)
+	if trackLength <= 0 {
+		return out
+	}
+	onTrack := func(s lib.RestWatchStandingsResponseItem) bool {
+		return !s.Pitting && !s.InGarageStall && (s.PitState == "" || s.PitState == "NONE")
+	}
+	for _, s := range standings {
+		if !onTrack(s) || s.CarVelocity.Velocity < 10 {
+			continue
+		}
+		nearest := math.Inf(1)
+		for _, o := range standings {
+			if o.SlotID == s.SlotID || !onTrack(o) {
+				continue
+			}
+			d := math.Mod(o.LapDistance-s.LapDistance+trackLength, trackLength)
+			if d > 0 && d < nearest {
+				nearest = d
+			}
+		}
+		if !math.IsInf(nearest, 1) {
+			out[int(s.SlotID)] = nearest / s.CarVelocity.Velocity
+		}
+	}
+	return out
+}
@@ -0,0 +1,118 @@
+package analysis
+
+import "sort"
+
+// FullCourseYellow reports whether the session's yellowFlagState means a
+// full course yellow phase: anything but NONE (or an empty or INVALID
+// state), i.e. pending, pits closed or open, last lap and resume.
+func FullCourseYellow(state string) bool {
+	switch state {
+	case "", "NONE", "INVALID":
+		return false
+	}
+	return true
+}
+
+// SectorFilter decides which laps' sectors may make up a theoretical best.
+// Sectors from in- and out-laps are left out because the pit lane can cut
+// a corner, sectors under FCY because the timing under a neutralisation says
+// nothing about pace, and sectors far quicker than the car's usual time
+// because they are more likely a timing glitch or a cut than a real gain.
+type SectorFilter struct {
+	Validity  *Validity // leave out laps it rejects; nil keeps them
+	SkipPit   bool      // leave out in-laps and the out-lap after each
+	SkipFCY   bool      // leave out laps run under a full course yellow
+	MaxSpread float64   // leave out sectors this fraction under the car's median for the sector, 0 = off
+}
+
+// DefaultSectorFilter applies every rule with a 5% spread.
+var DefaultSectorFilter = SectorFilter{Validity: &DefaultValidity, SkipPit: true, SkipFCY: true, MaxSpread: 0.05}
+
+// Excluded returns why each lap's sectors may not count, "" for those that
+// may. Single implausible sectors are dropped later, by Sectors.
+func (f SectorFilter) Excluded(laps []Lap) []string {
+	out := make([]string, len(laps))
+	var invalid []string
+	if f.Validity != nil {
+		invalid = f.Validity.Invalid(laps)
+	}
+	for i := range laps {
+		kind := Classifier{}.Classify(laps, i)
+		switch {
+		case invalid != nil && invalid[i] != "":
+			out[i] = "invalid: " + invalid[i]
+		case f.SkipPit && (kind == InLap || kind == OutLap), f.SkipFCY && kind == FCY:
+			out[i] = string(kind)
+		}
+	}
+	return out
+}
+
+// Ideal is a theoretical best lap: the best usable time of each sector.
+type Ideal struct {
+	Sectors [3]float64
+	Laps    [3]int // lap number each sector comes from
+	Time    float64
+}
+
+// TheoreticalBest combines the best usable sectors of a car's laps. ok is
+// false when a sector has no usable time.
+func (f SectorFilter) TheoreticalBest(laps []Lap) (Ideal, bool) {
+	var id Ideal
+	sectors := f.Sectors(laps)
+	for s := 0; s < 3; s++ {
+		for i, l := range laps {
+			t := sectors[i][s]
+			if t > 0 && (id.Sectors[s] == 0 || t < id.Sectors[s]) {
+				id.Sectors[s], id.Laps[s] = t, l.Number
+			}
+		}
+		if id.Sectors[s] == 0 {
+			return Ideal{}, false
+		}
+		id.Time += id.Sectors[s]
+	}
+	return id, true
+}
+
+// Sectors returns each lap's sector times with the ones the filter rejects
+// set to 0.
+func (f SectorFilter) Sectors(laps []Lap) [][3]float64 {
+	out := make([][3]float64, len(laps))
+	excluded := f.Excluded(laps)
+	for i, l := range laps {
+		if excluded[i] == "" {
+			out[i] = [3]float64{l.S1, l.S2, l.S3}
+		}
+	}
+	if f.MaxSpread <= 0 {
+		return out
+	}
+	for s := 0; s < 3; s++ {
+		var times []float64
+		for i := range out {
+			if out[i][s] > 0 {
+				times = append(times, out[i][s])
+			}
+		}
+		if len(times) < 3 {
+			continue
+		}
+		med := median(times)
+		for i := range out {
+			if out[i][s] > 0 && out[i][s] < med*(1-f.MaxSpread) {
+				out[i][s] = 0
+			}
+		}
+	}
+	return out
+}
+
+func median(v []float64) float64 {
+	s := append([]float64(nil), v...)
+	sort.Float64s(s)
+	if len(s)%2 == 1 {
+		return s[len(s)/2]
+	}
+	return (s[len(s)/2-1] + s[len(s)/2]) / 2
+}
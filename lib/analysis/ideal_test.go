@@ -0,0 +1,168 @@
+package analysis
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func lap(n int, s1, s2, s3 float64) Lap {
+	return Lap{Number: n, Time: s1 + s2 + s3, S1: s1, S2: s2, S3: s3}
+}
+
+func pit(l Lap) Lap { l.Pit = true; return l }
+func fcy(l Lap) Lap { l.FCY = true; return l }
+
+// green are four clean laps; their ideal is S1 of lap 3, S2 of lap 2 and
+// S3 of lap 4.
+var green = []Lap{
+	lap(1, 30.0, 40.0, 20.0),
+	lap(2, 30.2, 39.8, 20.1),
+	lap(3, 29.9, 40.1, 20.2),
+	lap(4, 30.1, 40.2, 19.9),
+}
+
+var greenIdeal = Ideal{Sectors: [3]float64{29.9, 39.8, 19.9}, Laps: [3]int{3, 2, 4}, Time: 89.6}
+
+func with(extra ...Lap) []Lap {
+	return append(append([]Lap(nil), green...), extra...)
+}
+
+// reasons are the Excluded results for green followed by the extra laps.
+func reasons(extra ...string) []string {
+	return append(make([]string, len(green)), extra...)
+}
+
+func TestSectorFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   SectorFilter
+		laps     []Lap
+		excluded []string
+		want     Ideal
+		ok       bool
+	}{
+		{
+			name:     "green laps",
+			filter:   DefaultSectorFilter,
+			laps:     green,
+			excluded: reasons(),
+			want:     greenIdeal, ok: true,
+		},
+		{
+			name:     "fcy lap skipped",
+			filter:   SectorFilter{SkipFCY: true},
+			laps:     with(fcy(lap(5, 30.5, 39.0, 20.5))),
+			excluded: reasons("fcy"),
+			want:     greenIdeal, ok: true,
+		},
+		{
+			name:     "fcy lap kept when not skipping",
+			filter:   SectorFilter{SkipPit: true},
+			laps:     with(fcy(lap(5, 30.5, 39.0, 20.5))),
+			excluded: reasons(""),
+			want:     Ideal{Sectors: [3]float64{29.9, 39.0, 19.9}, Laps: [3]int{3, 5, 4}, Time: 88.8}, ok: true,
+		},
+		{
+			name:     "in-lap and out-lap skipped",
+			filter:   SectorFilter{SkipPit: true},
+			laps:     with(pit(lap(5, 29.0, 40.5, 35.0)), lap(6, 45.0, 40.5, 19.0)),
+			excluded: reasons("in-lap", "out-lap"),
+			want:     greenIdeal, ok: true,
+		},
+		{
+			name:     "pit laps kept when not skipping",
+			filter:   SectorFilter{SkipFCY: true},
+			laps:     with(pit(lap(5, 29.0, 40.5, 35.0)), lap(6, 45.0, 40.5, 19.0)),
+			excluded: reasons("", ""),
+			want:     Ideal{Sectors: [3]float64{29.0, 39.8, 19.0}, Laps: [3]int{5, 2, 6}, Time: 87.8}, ok: true,
+		},
+		{
+			name:     "sector far under the median dropped",
+			filter:   SectorFilter{MaxSpread: 0.05},
+			laps:     with(lap(5, 28.0, 40.5, 20.5)),
+			excluded: reasons(""),
+			want:     greenIdeal, ok: true,
+		},
+		{
+			name:     "sector within the spread kept",
+			filter:   SectorFilter{MaxSpread: 0.05},
+			laps:     with(lap(5, 28.8, 40.5, 20.5)),
+			excluded: reasons(""),
+			want:     Ideal{Sectors: [3]float64{28.8, 39.8, 19.9}, Laps: [3]int{5, 2, 4}, Time: 88.5}, ok: true,
+		},
+		{
+			name:     "spread needs three times",
+			filter:   SectorFilter{MaxSpread: 0.05},
+			laps:     []Lap{lap(1, 30.0, 40.0, 20.0), lap(2, 25.0, 40.0, 20.0)},
+			excluded: []string{"", ""},
+			want:     Ideal{Sectors: [3]float64{25.0, 40.0, 20.0}, Laps: [3]int{2, 1, 1}, Time: 85.0}, ok: true,
+		},
+		{
+			name:     "cut lap skipped",
+			filter:   SectorFilter{Validity: &DefaultValidity},
+			laps:     with(lap(5, 28.0, 39.0, 19.5)),
+			excluded: reasons("invalid: 3.650s faster than median"),
+			want:     greenIdeal, ok: true,
+		},
+		{
+			name:     "lap with incomplete sectors skipped",
+			filter:   SectorFilter{Validity: &DefaultValidity},
+			laps:     with(Lap{Number: 5, Time: 89.0, S1: 29.5, S2: 39.5}),
+			excluded: reasons("invalid: incomplete sectors"),
+			want:     greenIdeal, ok: true,
+		},
+		{
+			// Lap 8's S1 passes the validity check but not the spread
+			name:     "every rule at once",
+			filter:   DefaultSectorFilter,
+			laps:     with(fcy(lap(5, 30.5, 39.0, 20.5)), pit(lap(6, 29.0, 40.5, 35.0)), lap(7, 45.0, 40.5, 19.0), lap(8, 28.45, 40.5, 20.5)),
+			excluded: reasons("fcy", "in-lap", "out-lap", ""),
+			want:     greenIdeal, ok: true,
+		},
+		{
+			name:     "no usable sector",
+			filter:   DefaultSectorFilter,
+			laps:     []Lap{pit(lap(1, 30.0, 40.0, 30.0)), lap(2, 50.0, 40.0, 20.0)},
+			excluded: []string{"in-lap", "out-lap"},
+			ok:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Excluded(tt.laps); !reflect.DeepEqual(got, tt.excluded) {
+				t.Errorf("Excluded = %q, want %q", got, tt.excluded)
+			}
+			got, ok := tt.filter.TheoreticalBest(tt.laps)
+			if ok != tt.ok {
+				t.Fatalf("TheoreticalBest ok = %v, want %v", ok, tt.ok)
+			}
+			if got.Laps != tt.want.Laps || math.Abs(got.Time-tt.want.Time) > 1e-9 {
+				t.Errorf("TheoreticalBest = %+v, want %+v", got, tt.want)
+			}
+			for s := range got.Sectors {
+				if math.Abs(got.Sectors[s]-tt.want.Sectors[s]) > 1e-9 {
+					t.Errorf("S%d = %.3f, want %.3f", s+1, got.Sectors[s], tt.want.Sectors[s])
+				}
+			}
+		})
+	}
+}
+
+func TestFullCourseYellow(t *testing.T) {
+	for state, want := range map[string]bool{
+		"":             false,
+		"NONE":         false,
+		"INVALID":      false,
+		"PENDING":      true,
+		"PITS_CLOSED":  true,
+		"PITS_OPEN":    true,
+		"LAST_LAP":     true,
+		"RESUME":       true,
+		"PIT_LEAD_LAP": true,
+	} {
+		if got := FullCourseYellow(state); got != want {
+			t.Errorf("FullCourseYellow(%q) = %v, want %v", state, got, want)
+		}
+	}
+}
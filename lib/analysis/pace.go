@@ -0,0 +1,51 @@
+package analysis
+
+import "math"
+
+// PaceWithin is how far off the best lap, as a factor, a green lap may be
+// and still count towards a driver's pace: slower ones had a problem the
+// lap kinds do not show, such as a spin.
+const PaceWithin = 1.07
+
+// Pace sums up a driver's green laps, e.g. to compare two sessions.
+type Pace struct {
+	Laps   int // green laps counted
+	Best   float64
+	Median float64
+	StdDev float64 // consistency: the lower, the steadier
+	Vmax   float64 // top speed in km/h, 0 when unknown
+}
+
+// PaceOf sums up green lap times and their top speeds (vmax may be nil or
+// hold 0 for unknown speeds). ok is false without a timed lap.
+func PaceOf(times, vmax []float64) (p Pace, ok bool) {
+	for i, t := range times {
+		if t > 0 && (p.Best == 0 || t < p.Best) {
+			p.Best = t
+		}
+		if i < len(vmax) {
+			p.Vmax = math.Max(p.Vmax, vmax[i])
+		}
+	}
+	if p.Best == 0 {
+		return Pace{}, false
+	}
+	var kept []float64
+	var sum float64
+	for _, t := range times {
+		if t > 0 && t <= p.Best*PaceWithin {
+			kept = append(kept, t)
+			sum += t
+		}
+	}
+	p.Laps, p.Median = len(kept), median(kept)
+	mean := sum / float64(len(kept))
+	var ss float64
+	for _, t := range kept {
+		ss += (t - mean) * (t - mean)
+	}
+	if len(kept) > 1 {
+		p.StdDev = math.Sqrt(ss / float64(len(kept)-1))
+	}
+	return p, true
+}
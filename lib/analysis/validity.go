@@ -0,0 +1,155 @@
+// Package analysis works on lap lists for statistics: which laps are valid,
+// and which are representative of a car's pace.
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Lap is one completed lap of a car. Sector times are durations in seconds,
+// 0 when unknown. Pit marks the in-lap. FCY and Gap are not in the history;
+// lib/store records them from the live frames.
+type Lap struct {
+	Number     int
+	Time       float64
+	S1, S2, S3 float64
+	Pit        bool
+	FCY        bool    // a full course yellow was out during the lap
+	Gap        float64 // closest on-track gap to the car ahead in seconds, 0 when unknown
+}
+
+// FromHistory converts a car's history entries. The API reports sector 2 as
+// a cumulative time; it and sector 3 are turned into durations.
+func FromHistory(history []lib.RestWatchStandingsHistoryResponseItemItem) []Lap {
+	laps := make([]Lap, len(history))
+	for i, h := range history {
+		l := Lap{Number: i + 1, Time: h.LapTime, Pit: h.Pitting}
+		if h.SectorTime1 > 0 {
+			l.S1 = h.SectorTime1
+			if h.SectorTime2 > h.SectorTime1 {
+				l.S2 = h.SectorTime2 - h.SectorTime1
+				if h.LapTime > h.SectorTime2 {
+					l.S3 = h.LapTime - h.SectorTime2
+				}
+			}
+		}
+		laps[i] = l
+	}
+	return laps
+}
+
+// Validity decides which laps count. The API does not say whether a lap was
+// invalidated for track limits, so it is inferred: a lap needs a time and
+// complete sectors, and a lap or sector much faster than the car's usual
+// time around it is taken to be a cut. The usual time is the median of the
+// nearest comparable laps, so a real step in pace, fresh tyres or a clear
+// track after traffic, is not held against the best lap so far.
+type Validity struct {
+	LapTolerance    float64 // a lap this fraction faster than the median is invalid
+	SectorTolerance float64 // the same for a single sector
+	Window          int     // comparable laps nearest by number the median is taken over
+	MinSamples      int     // comparable laps needed before the outlier rules apply
+}
+
+// DefaultValidity flags laps 3% and sectors 6% faster than the median of
+// the car's five nearest green laps, once it has three.
+var DefaultValidity = Validity{LapTolerance: 0.03, SectorTolerance: 0.06, Window: 5, MinSamples: 3}
+
+// Check returns why lap is invalid compared with others (the same car's
+// other laps, pit laps included or not), or "" when it counts. Sector 3 is
+// the lap time less sector 2 (see FromHistory), so it catches a cut in the
+// last sector that is too short to show in the lap time.
+func (v Validity) Check(lap Lap, others []Lap) string {
+	if lap.Time <= 0 {
+		return "no time"
+	}
+	if lap.S1 <= 0 || lap.S2 <= 0 || lap.S3 <= 0 {
+		return "incomplete sectors"
+	}
+	if lap.Pit {
+		return ""
+	}
+	var near []Lap
+	for _, o := range others {
+		if o.Number == lap.Number || o.Time <= 0 || o.Pit || o.FCY || o.S1 <= 0 || o.S2 <= 0 || o.S3 <= 0 {
+			continue
+		}
+		near = append(near, o)
+	}
+	if len(near) < v.MinSamples {
+		return ""
+	}
+	dist := func(o Lap) int {
+		if o.Number < lap.Number {
+			return lap.Number - o.Number
+		}
+		return o.Number - lap.Number
+	}
+	sort.SliceStable(near, func(i, j int) bool { return dist(near[i]) < dist(near[j]) })
+	if v.Window > 0 && len(near) > v.Window {
+		near = near[:v.Window]
+	}
+	var times, s1, s2, s3 []float64
+	for _, o := range near {
+		times, s1, s2, s3 = append(times, o.Time), append(s1, o.S1), append(s2, o.S2), append(s3, o.S3)
+	}
+	if m := median(times); lap.Time < m*(1-v.LapTolerance) {
+		return fmt.Sprintf("%.3fs faster than median", m-lap.Time)
+	}
+	for i, s := range [3][2]float64{{lap.S1, median(s1)}, {lap.S2, median(s2)}, {lap.S3, median(s3)}} {
+		if s[0] < s[1]*(1-v.SectorTolerance) {
+			return fmt.Sprintf("S%d %.3fs faster than median", i+1, s[1]-s[0])
+		}
+	}
+	return ""
+}
+
+// IsCut reports whether a reason from Check is a suspected track limits cut,
+// as opposed to a lap without complete timing. Reasons recorded before the
+// median rule say "faster than best" and count too.
+func IsCut(reason string) bool {
+	return strings.Contains(reason, "faster than")
+}
+
+// Cuts returns the numbers of a car's laps taken to be track limits cuts.
+func (v Validity) Cuts(laps []Lap) []int {
+	var out []int
+	for i, reason := range v.Invalid(laps) {
+		if IsCut(reason) {
+			out = append(out, laps[i].Number)
+		}
+	}
+	return out
+}
+
+// Invalid checks every lap against the car's other laps. The result holds
+// the reason for each lap, "" for valid ones.
+func (v Validity) Invalid(laps []Lap) []string {
+	out := make([]string, len(laps))
+	for i, l := range laps {
+		out[i] = v.Check(l, laps)
+	}
+	return out
+}
+
+// BestValid returns the fastest valid lap time, 0 when there is none.
+func (v Validity) BestValid(laps []Lap) float64 {
+	var best float64
+	for i, reason := range v.Invalid(laps) {
+		if reason == "" && !laps[i].Pit {
+			best = minPositive(best, laps[i].Time)
+		}
+	}
+	return best
+}
+
+func minPositive(cur, v float64) float64 {
+	if cur <= 0 || v < cur {
+		return v
+	}
+	return cur
+}
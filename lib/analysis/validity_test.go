@@ -0,0 +1,59 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+func TestValidityMedian(t *testing.T) {
+	// Stuck in traffic for four laps, then a clear track: 2.6% faster than
+	// the laps before, a real gain and no cut
+	laps := []Lap{
+		lap(1, 31.5, 42.0, 21.5),
+		lap(2, 31.6, 41.9, 21.5),
+		lap(3, 31.4, 42.1, 21.6),
+		lap(4, 31.5, 42.0, 21.5),
+		lap(5, 30.6, 40.9, 21.0),
+	}
+	if got := DefaultValidity.Invalid(laps); got[4] != "" {
+		t.Errorf("clear lap after traffic: %q, want valid", got[4])
+	}
+	// The same gain against a settled pace is a cut
+	laps = with(lap(5, 29.0, 38.5, 19.5))
+	if got := DefaultValidity.Invalid(laps)[4]; !IsCut(got) {
+		t.Errorf("lap 3.5s under the median: %q, want a cut", got)
+	}
+	// A slow lap among the others moves the median, not the verdict
+	laps = with(lap(5, 36.0, 45.0, 25.0), lap(6, 30.0, 40.0, 20.0))
+	if got := DefaultValidity.Invalid(laps); got[4] != "" || got[5] != "" {
+		t.Errorf("slow lap among green ones: %q, want all valid", got)
+	}
+}
+
+func TestValiditySectorFromHistory(t *testing.T) {
+	// Sector 2 is cumulative in the history; lap 5 cuts 1.5s in sector 3,
+	// under the lap tolerance but over the sector one
+	var history []lib.RestWatchStandingsHistoryResponseItemItem
+	for _, lapTime := range []float64{90.0, 90.2, 89.9, 90.1, 88.5} {
+		history = append(history, lib.RestWatchStandingsHistoryResponseItemItem{
+			SectorTime1: 30.0, SectorTime2: 70.0, LapTime: lapTime,
+		})
+	}
+	laps := FromHistory(history)
+	if laps[4].S3 != 18.5 {
+		t.Fatalf("S3 of lap 5 = %.3f, want 18.500", laps[4].S3)
+	}
+	got := DefaultValidity.Invalid(laps)
+	for i, want := range []string{"", "", "", "", "S3 1.550s faster than median"} {
+		if got[i] != want {
+			t.Errorf("lap %d: %q, want %q", i+1, got[i], want)
+		}
+	}
+	if cuts := DefaultValidity.Cuts(laps); len(cuts) != 1 || cuts[0] != 5 {
+		t.Errorf("Cuts = %v, want [5]", cuts)
+	}
+	if best := DefaultValidity.BestValid(laps); best != 89.9 {
+		t.Errorf("BestValid = %.3f, want 89.900", best)
+	}
+}
@@ -0,0 +1,131 @@
+// Package carclass is a curated registry of LMU car classes: canonical
+// order, display abbreviation and color. The TUI uses it for color-coding and
+// exporters use it for consistent class sorting. Mod content can add or
+// override classes from a JSON file.
+package carclass
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Class describes one car class.
+type Class struct {
+	Name    string   `json:"name"`    // canonical name, e.g. "Hypercar"
+	Abbrev  string   `json:"abbrev"`  // short badge, e.g. "HY"
+	Order   int      `json:"order"`   // lower sorts first
+	Color   string   `json:"color"`   // hex, e.g. "#d7263d"
+	ANSI    int      `json:"ansi"`    // 256-color palette index for terminals
+	Aliases []string `json:"aliases"` // carClass strings the API may report
+}
+
+// Unknown is returned for classes the registry has never heard of. It sorts
+// after every known class.
+var Unknown = Class{Name: "Unknown", Abbrev: "?", Order: 1000, Color: "#9e9e9e", ANSI: 250}
+
+var builtin = []Class{
+	{Name: "Hypercar", Abbrev: "HY", Order: 10, Color: "#d7263d", ANSI: 160, Aliases: []string{"Hyper", "HYPERCAR", "LMH", "LMDh"}},
+	{Name: "LMP2", Abbrev: "P2", Order: 20, Color: "#1e88e5", ANSI: 33, Aliases: []string{"LMP2_ELMS", "LMP2 ELMS", "P2"}},
+	{Name: "LMP3", Abbrev: "P3", Order: 30, Color: "#8e24aa", ANSI: 129, Aliases: []string{"P3"}},
+	{Name: "LMGTE", Abbrev: "GTE", Order: 40, Color: "#fb8c00", ANSI: 208, Aliases: []string{"GTE", "GTE-Am", "GTE-Pro"}},
+	{Name: "LMGT3", Abbrev: "GT3", Order: 50, Color: "#43a047", ANSI: 34, Aliases: []string{"GT3", "LM GT3"}},
+}
+
+// Registry resolves API class strings to Class entries. It is safe for
+// concurrent use.
+type Registry struct {
+	mu      sync.RWMutex
+	classes map[string]Class // keyed by lower-cased name or alias
+}
+
+// New returns a registry holding the built-in classes.
+func New() *Registry {
+	r := &Registry{classes: map[string]Class{}}
+	for _, c := range builtin {
+		r.add(c)
+	}
+	return r
+}
+
+var defaultRegistry = New()
+
+// Default returns the process-wide registry used by Lookup.
+func Default() *Registry { return defaultRegistry }
+
+// Lookup resolves a class string against the default registry.
+func Lookup(name string) Class { return defaultRegistry.Lookup(name) }
+
+func (r *Registry) add(c Class) {
+	r.classes[strings.ToLower(c.Name)] = c
+	for _, a := range c.Aliases {
+		r.classes[strings.ToLower(a)] = c
+	}
+}
+
+// Add registers or replaces a class and its aliases.
+func (r *Registry) Add(c Class) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.add(c)
+}
+
+// Lookup resolves a class string. Unknown classes get the Unknown entry with
+// their own name and a badge derived from it, so they still render sensibly.
+func (r *Registry) Lookup(name string) Class {
+	r.mu.RLock()
+	c, ok := r.classes[strings.ToLower(strings.TrimSpace(name))]
+	r.mu.RUnlock()
+	if ok {
+		return c
+	}
+	u := Unknown
+	if name != "" {
+		u.Name = name
+		u.Abbrev = name
+		if len(u.Abbrev) > 4 {
+			u.Abbrev = u.Abbrev[:4]
+		}
+	}
+	return u
+}
+
+// Less orders two class strings canonically, falling back to the name for
+// classes with equal order.
+func (r *Registry) Less(a, b string) bool {
+	ca, cb := r.Lookup(a), r.Lookup(b)
+	if ca.Order != cb.Order {
+		return ca.Order < cb.Order
+	}
+	return ca.Name < cb.Name
+}
+
+// Sort orders class strings canonically in place.
+func (r *Registry) Sort(names []string) {
+	sort.SliceStable(names, func(i, j int) bool { return r.Less(names[i], names[j]) })
+}
+
+// LoadFile merges classes from a JSON file holding an array of Class
+// objects. Entries replace built-ins with the same name or alias.
+func (r *Registry) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var classes []Class
+	if err := json.Unmarshal(data, &classes); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, c := range classes {
+		if c.Name == "" {
+			return fmt.Errorf("parse %s: class without name", path)
+		}
+		r.add(c)
+	}
+	return nil
+}
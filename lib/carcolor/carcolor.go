@@ -0,0 +1,260 @@
+// Package carcolor gives every car a color that stays the same across
+// sessions and tools, so a broadcast's tower, timing screens and published
+// results agree on which car is which. Colors are assigned on first sight
+// and kept in a file next to the config, keyed by car number:
+//
+//	{"7": "#e6194b", "50": "#4363d8"}
+//
+// Colors pinned in the config (config.CarColors) win over the file.
+package carcolor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/snipem/go-lmu-api/lib/config"
+)
+
+// Color is a car's color for the web and for terminals.
+type Color struct {
+	Hex  string // e.g. "#e6194b"
+	ANSI int    // nearest 256-color palette index
+}
+
+// Palette is the colors handed out, in order: twenty colors that are easy
+// to tell apart on screen and on air.
+var Palette = []string{
+	"#e6194b", "#3cb44b", "#ffe119", "#4363d8", "#f58231",
+	"#911eb4", "#46f0f0", "#f032e6", "#bcf60c", "#fabebe",
+	"#008080", "#e6beff", "#9a6324", "#fffac8", "#800000",
+	"#aaffc3", "#808000", "#ffd8b1", "#000075", "#808080",
+}
+
+// DefaultPath is carcolors.json next to the config file.
+func DefaultPath() string {
+	return filepath.Join(filepath.Dir(config.DefaultPath()), "carcolors.json")
+}
+
+// Book holds the color assignments. It is safe for concurrent use.
+type Book struct {
+	mu     sync.Mutex
+	path   string
+	pinned map[string]string
+	colors map[string]string
+	forgot map[string]bool
+	dirty  bool
+}
+
+// Open reads the assignments file at path; a missing file starts an empty
+// book. pinned maps car numbers to colors that are never reassigned. An
+// empty path keeps the assignments in memory only.
+func Open(path string, pinned map[string]string) (*Book, error) {
+	b := &Book{path: path, pinned: map[string]string{}, forgot: map[string]bool{}}
+	for num, hex := range pinned {
+		if !valid(hex) {
+			return nil, fmt.Errorf("car #%s: color %q is not #rrggbb", num, hex)
+		}
+		b.pinned[key(num)] = strings.ToLower(hex)
+	}
+	colors, err := read(path)
+	if err != nil {
+		return nil, err
+	}
+	b.colors = colors
+	return b, nil
+}
+
+func read(path string) (map[string]string, error) {
+	colors := map[string]string{}
+	if path == "" {
+		return colors, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return colors, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &colors); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for num, hex := range colors {
+		if !valid(hex) {
+			delete(colors, num)
+		}
+	}
+	return colors, nil
+}
+
+// Get returns the color of a car number, assigning one the first time the
+// number is seen: the palette color fewest cars have, earliest first.
+// Save writes new assignments.
+func (b *Book) Get(number string) Color {
+	k := key(number)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if hex, ok := b.pinned[k]; ok {
+		return color(hex)
+	}
+	if hex, ok := b.colors[k]; ok {
+		return color(hex)
+	}
+	used := map[string]int{}
+	for _, hex := range b.colors {
+		used[hex]++
+	}
+	for _, hex := range b.pinned {
+		used[hex]++
+	}
+	pick := Palette[0]
+	for _, hex := range Palette {
+		if used[hex] < used[pick] {
+			pick = hex
+		}
+	}
+	b.colors[k] = pick
+	b.dirty = true
+	return color(pick)
+}
+
+// Save writes the assignments when any were added since the last save.
+// Cars another tool assigned in the meantime keep that tool's color.
+func (b *Book) Save() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.dirty || b.path == "" {
+		return nil
+	}
+	disk, err := read(b.path)
+	if err != nil {
+		return err
+	}
+	for k, hex := range disk {
+		if !b.forgot[k] {
+			b.colors[k] = hex
+		}
+	}
+	data, err := json.MarshalIndent(b.colors, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(b.path), 0o755); err != nil {
+		return err
+	}
+	tmp := b.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, b.path); err != nil {
+		return err
+	}
+	b.dirty = false
+	b.forgot = map[string]bool{}
+	return nil
+}
+
+// Forget drops a car's assignment, so it gets a fresh color next time.
+// Pinned colors stay.
+func (b *Book) Forget(number string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.colors[key(number)]; ok {
+		delete(b.colors, key(number))
+		b.forgot[key(number)] = true
+		b.dirty = true
+	}
+}
+
+// Assignment is a car number and its color.
+type Assignment struct {
+	Number string
+	Color  Color
+	Pinned bool
+}
+
+// All lists the assigned and pinned colors by car number.
+func (b *Book) All() []Assignment {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var out []Assignment
+	for k, hex := range b.colors {
+		if _, ok := b.pinned[k]; !ok {
+			out = append(out, Assignment{Number: k, Color: color(hex)})
+		}
+	}
+	for k, hex := range b.pinned {
+		out = append(out, Assignment{Number: k, Color: color(hex), Pinned: true})
+	}
+	sort.Slice(out, func(i, j int) bool { return lessNumber(out[i].Number, out[j].Number) })
+	return out
+}
+
+// key normalizes a car number: "#07" and "7" are the same car.
+func key(number string) string {
+	number = strings.TrimLeft(strings.TrimSpace(number), "#")
+	if t := strings.TrimLeft(number, "0"); t != "" {
+		return t
+	}
+	return number
+}
+
+func lessNumber(a, b string) bool {
+	na, errA := strconv.Atoi(a)
+	nb, errB := strconv.Atoi(b)
+	if errA == nil && errB == nil {
+		return na < nb
+	}
+	if (errA == nil) != (errB == nil) {
+		return errA == nil
+	}
+	return a < b
+}
+
+func valid(hex string) bool {
+	if len(hex) != 7 || hex[0] != '#' {
+		return false
+	}
+	_, err := strconv.ParseUint(hex[1:], 16, 32)
+	return err == nil
+}
+
+func color(hex string) Color {
+	return Color{Hex: hex, ANSI: ANSI(hex)}
+}
+
+// ANSI returns the 256-color palette index closest to a #rrggbb color,
+// from the 6×6×6 color cube.
+func ANSI(hex string) int {
+	v, err := strconv.ParseUint(strings.TrimPrefix(hex, "#"), 16, 32)
+	if err != nil {
+		return 7
+	}
+	level := func(c uint64) int {
+		steps := []uint64{0, 95, 135, 175, 215, 255}
+		best := 0
+		for i, s := range steps {
+			if diff(c, s) < diff(c, steps[best]) {
+				best = i
+			}
+		}
+		return best
+	}
+	r, g, b := level(v>>16&0xff), level(v>>8&0xff), level(v&0xff)
+	return 16 + 36*r + 6*g + b
+}
+
+func diff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
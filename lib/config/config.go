@@ -0,0 +1,241 @@
+// Package config loads the shared user configuration for the LMU tools. The
+// file is JSON; every section is optional and a missing file yields the
+// zero Config.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Config is the top-level configuration file.
+type Config struct {
+	// Theme selects a built-in or user-defined theme by name.
+	Theme string `json:"theme,omitempty"`
+	// Themes defines additional themes, or overrides built-in ones by name.
+	Themes map[string]Theme `json:"themes,omitempty"`
+	// CarColors pins cars' colors ("#rrggbb") by car number; other cars are
+	// assigned one (see lib/carcolor).
+	CarColors map[string]string `json:"carColors,omitempty"`
+	// DriveTime holds the driver time limits of the championship.
+	DriveTime DriveTime `json:"driveTime,omitempty"`
+	// Engineer configures the race engineer announcements.
+	Engineer Engineer `json:"engineer,omitempty"`
+	// Language is en (the default), de or fr, for the ticker's and the
+	// engineer's messages and their numbers and times.
+	Language string `json:"language,omitempty"`
+	// Ticker replaces the ticker line templates (see lib/events), keyed by
+	// event kind.
+	Ticker map[string]string `json:"ticker,omitempty"`
+	// Alerts are user-defined conditions that raise notifier events.
+	Alerts []Alert `json:"alerts,omitempty"`
+	// SetupSync points the setup tool at the team's setup sync server.
+	SetupSync SetupSync `json:"setupSync,omitempty"`
+	// Join is the default server, car and livery for lmu join.
+	Join Join `json:"join,omitempty"`
+	// Countdowns are the session phase marks announced as events.
+	Countdowns Countdowns `json:"countdowns,omitempty"`
+	// TrackLimits sets the track limits warnings allowed before a penalty.
+	TrackLimits TrackLimits `json:"trackLimits,omitempty"`
+	// PitLane sets the pit speed limit the player is warned against.
+	PitLane PitLane `json:"pitLane,omitempty"`
+	// Temperatures overrides the brake and tyre temperature warnings by car
+	// class (see lib/events.DefaultTempLimits).
+	Temperatures map[string]TempLimits `json:"temperatures,omitempty"`
+	// Sheets is the Google Sheet lmu sheets appends laps and results to.
+	Sheets Sheets `json:"sheets,omitempty"`
+	// Upload is the league results API lmu record -upload sends results to.
+	Upload Upload `json:"upload,omitempty"`
+	// Targets are practice goals the delta tool shows live splits against.
+	Targets []Target `json:"targets,omitempty"`
+	// Profiles are named connections selected with -profile.
+	Profiles map[string]Profile `json:"profiles,omitempty"`
+	// DefaultProfile is used when -profile is not given.
+	DefaultProfile string `json:"defaultProfile,omitempty"`
+}
+
+// Join configures lmu join. Server is a name or host:port from the servers
+// file; Car and Livery match the vehicle list case-insensitively.
+type Join struct {
+	Server   string `json:"server,omitempty"`
+	Password string `json:"password,omitempty"`
+	Car      string `json:"car,omitempty"`
+	Livery   string `json:"livery,omitempty"`
+	Drive    bool   `json:"drive,omitempty"`
+}
+
+// Countdowns configures the session phase events. Empty lists use the
+// defaults (10, 5 and 1 minutes; 5 and 1 laps).
+type Countdowns struct {
+	Minutes   []float64 `json:"minutes,omitempty"` // before the end of a timed session
+	Laps      []float64 `json:"laps,omitempty"`    // to go in a race, for the leader
+	PitWindow PitWindow `json:"pitWindow,omitempty"`
+}
+
+// Sheets configures lmu sheets. Credentials is a service account key file;
+// Laps and Results name the tabs (default "Laps" and "Results").
+type Sheets struct {
+	Spreadsheet string `json:"spreadsheet,omitempty"`
+	Credentials string `json:"credentials,omitempty"`
+	Laps        string `json:"laps,omitempty"`
+	Results     string `json:"results,omitempty"`
+}
+
+// Upload configures the results upload. Every results file is POSTed to
+// URL with Headers, whose values are text/template strings (see
+// cmd/internal/upload) so tokens can come from the environment. Queue is
+// the directory files wait in until the server took them (default: upload
+// next to the history database).
+type Upload struct {
+	URL     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Queue   string            `json:"queue,omitempty"`
+}
+
+// Target is a goal lap at a track, for one car, one class or (with
+// neither set) every car. Times are in seconds; Sectors are split times
+// (S1, S2, S3), and without them the lap is split like the driver's best.
+type Target struct {
+	Track   string    `json:"track"`
+	Car     string    `json:"car,omitempty"`
+	Class   string    `json:"class,omitempty"`
+	Lap     float64   `json:"lap"`
+	Sectors []float64 `json:"sectors,omitempty"`
+}
+
+// TrackLimits is the event's track limits rule. Allowed 0 takes the number
+// from the session's cuts allowed setting where the game reports one.
+type TrackLimits struct {
+	Allowed    int `json:"allowed,omitempty"`    // warnings before a penalty
+	WarnWithin int `json:"warnWithin,omitempty"` // highlight this many warnings before the penalty (default 1)
+}
+
+// PitLane is the pit lane speed rule. SpeedLimit is the track's limit in
+// km/h, used when the server has no pit speed override (default 60, the
+// limit at most WEC tracks); Tolerance is how far above it a car may go
+// before the warning.
+type PitLane struct {
+	SpeedLimit float64 `json:"speedLimit,omitempty"`
+	Tolerance  float64 `json:"tolerance,omitempty"`
+}
+
+// TempLimits are the temperatures in °C beyond which the player's brakes
+// or tyres are warned about. Zero fields keep the class default.
+type TempLimits struct {
+	BrakeMax float64 `json:"brakeMax,omitempty"`
+	TyreMin  float64 `json:"tyreMin,omitempty"`
+	TyreMax  float64 `json:"tyreMax,omitempty"`
+	// Sustain is how long a temperature must stay out of range before the
+	// warning (default 5s), so a single lock-up does not raise one.
+	Sustain Duration `json:"sustain,omitempty"`
+}
+
+// PitWindow is a regulated pit window, counted in the leader's laps or in
+// race time. The API does not report the event's pit rules, so they are
+// configured here; zero bounds are off.
+type PitWindow struct {
+	OpenLap  int      `json:"openLap,omitempty"`
+	CloseLap int      `json:"closeLap,omitempty"`
+	Open     Duration `json:"open,omitempty"`
+	Close    Duration `json:"close,omitempty"`
+}
+
+// SetupSync is the address and shared token of a setup sync server
+// (setup serve).
+type SetupSync struct {
+	Server string `json:"server,omitempty"`
+	Token  string `json:"token,omitempty"`
+}
+
+// Alert is a user rule. When is an expression (see lib/rules) over
+// "standing" (each car), "player" (the player's car) and "session"; the
+// alert fires when it turns true and again only after it was false.
+type Alert struct {
+	Name     string   `json:"name"`
+	When     string   `json:"when"`
+	Message  string   `json:"message"`
+	Priority string   `json:"priority,omitempty"` // low, normal, high, critical
+	Cooldown Duration `json:"cooldown,omitempty"`
+}
+
+// Engineer configures cmd/engineer. Templates are text/template strings
+// keyed by event kind and replace the built-in phrasing.
+type Engineer struct {
+	Templates map[string]string `json:"templates,omitempty"`
+	MinGap    Duration          `json:"minGap,omitempty"`   // silence between announcements
+	Cooldown  Duration          `json:"cooldown,omitempty"` // repeat suppression per kind and car
+}
+
+// DriveTime configures driver stint time tracking. Zero limits are off.
+type DriveTime struct {
+	MaxStint   Duration `json:"maxStint,omitempty"`
+	MaxTotal   Duration `json:"maxTotal,omitempty"`
+	WarnBefore Duration `json:"warnBefore,omitempty"`
+}
+
+// Duration is a time.Duration written as a Go duration string ("65m", "1h30m").
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string like \"90s\": %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// Theme holds SGR parameter strings ("1;36", "38;5;208") for the TUI's
+// highlight roles. Empty roles inherit from the built-in default theme.
+type Theme struct {
+	Header       string  `json:"header,omitempty"`
+	Player       string  `json:"player,omitempty"`
+	Battle       string  `json:"battle,omitempty"`
+	Purple       string  `json:"purple,omitempty"`
+	Green        string  `json:"green,omitempty"`
+	Dim          string  `json:"dim,omitempty"`
+	Warning      string  `json:"warning,omitempty"`      // track limits warnings near the penalty
+	ClassColors  *bool   `json:"classColors,omitempty"`  // color the class badge
+	ClassRows    *bool   `json:"classRows,omitempty"`    // tint whole rows by class
+	CarNumbers   *bool   `json:"carNumbers,omitempty"`   // color car numbers with the car's color
+	BattleWithin float64 `json:"battleWithin,omitempty"` // seconds to the car ahead
+}
+
+// DefaultPath returns the per-user config location, e.g.
+// ~/.config/lmu/config.json on Linux or %AppData%\lmu\config.json on Windows.
+func DefaultPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "lmu.json"
+	}
+	return filepath.Join(dir, "lmu", "config.json")
+}
+
+// Load reads the config file at path. A missing file is not an error.
+func Load(path string) (*Config, error) {
+	var cfg Config
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return &cfg, nil
+}
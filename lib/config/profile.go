@@ -0,0 +1,135 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Profile is a named connection, e.g. "rig", "laptop-spectator" or
+// "dedicated": which game API to talk to, how to authenticate (for an API
+// exposed through a reverse proxy), and default values for command flags.
+type Profile struct {
+	BaseURL  string            `json:"baseURL,omitempty"`
+	Token    string            `json:"token,omitempty"`    // sent as "Authorization: Bearer <token>"
+	Username string            `json:"username,omitempty"` // HTTP basic auth
+	Password string            `json:"password,omitempty"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	// Flags holds default values by flag name ("interval": "500ms"). A
+	// profile is shared by all the tools, so flags a tool does not have
+	// are ignored.
+	Flags map[string]string `json:"flags,omitempty"`
+}
+
+// Profile returns the named profile. An empty name selects the config's
+// default profile; with none set it returns nil, meaning plain flags.
+func (c *Config) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = c.DefaultProfile
+	}
+	if name == "" {
+		return nil, nil
+	}
+	p, ok := c.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(c.Profiles))
+		for n := range c.Profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown profile %q (have: %s)", name, strings.Join(names, ", "))
+	}
+	return &p, nil
+}
+
+// Apply sets the flags of fs that were not given on the command line: -base
+// from BaseURL, then the profile's Flags. fs must have been parsed.
+func (p *Profile) Apply(fs *flag.FlagSet) error {
+	if p == nil {
+		return nil
+	}
+	given := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { given[f.Name] = true })
+	set := func(name, value string) error {
+		if given[name] || fs.Lookup(name) == nil {
+			return nil
+		}
+		if err := fs.Set(name, value); err != nil {
+			return fmt.Errorf("profile flag -%s: %w", name, err)
+		}
+		return nil
+	}
+	if p.BaseURL != "" {
+		if err := set("base", p.BaseURL); err != nil {
+			return err
+		}
+	}
+	for name, value := range p.Flags {
+		if err := set(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HTTPClient returns a client that adds the profile's credentials and
+// headers to every request, or http.DefaultClient when there are none.
+func (p *Profile) HTTPClient() *http.Client {
+	if p == nil || (p.Token == "" && p.Username == "" && len(p.Headers) == 0) {
+		return http.DefaultClient
+	}
+	return &http.Client{Transport: &authTransport{p: p, next: http.DefaultTransport}}
+}
+
+type authTransport struct {
+	p    *Profile
+	next http.RoundTripper
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range t.p.Headers {
+		req.Header.Set(k, v)
+	}
+	if t.p.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.p.Token)
+	} else if t.p.Username != "" {
+		req.SetBasicAuth(t.p.Username, t.p.Password)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// Flags are the -config and -profile flags of a command.
+type Flags struct {
+	Path    string
+	Profile string
+}
+
+// AddFlags registers -config and -profile on fs. The profile defaults to
+// $LMU_PROFILE, so a spectator machine can select its profile once.
+func AddFlags(fs *flag.FlagSet) *Flags {
+	f := &Flags{}
+	fs.StringVar(&f.Path, "config", DefaultPath(), "Config file")
+	fs.StringVar(&f.Profile, "profile", os.Getenv("LMU_PROFILE"), "Connection profile from the config file")
+	return f
+}
+
+// Load reads the config file and applies the selected profile to fs, which
+// must have been parsed. The profile is nil when none is selected.
+func (f *Flags) Load(fs *flag.FlagSet) (*Config, *Profile, error) {
+	cfg, err := Load(f.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	p, err := cfg.Profile(f.Profile)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := p.Apply(fs); err != nil {
+		return nil, nil, err
+	}
+	return cfg, p, nil
+}
@@ -0,0 +1,144 @@
+package lib_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// The benchmarks decode a 60-car grid and 30 laps of history per car
+// through a Client, so they measure what a poll costs apart from the
+// network: the generated decoders, or encoding/json with -tags
+// lmu_stdjson. The encoding/json sub-benchmarks are the baseline. Run
+// them on recorded responses with e.g.
+//
+//	go test ./lib -run '^$' -bench Decode -args -standings rest_watch_standings.json
+var (
+	standingsFile = flag.String("standings", "testdata/standings_60.json", "/rest/watch/standings response to decode")
+	historyFile   = flag.String("history", "testdata/standings_history_60.json", "/rest/watch/standings/history response to decode")
+)
+
+// The plain types have the models' fields but not their methods, so
+// encoding/json decodes them by reflection. Nested structs only have
+// unexported decoders and are decoded by reflection either way.
+type (
+	plainStanding lib.RestWatchStandingsResponseItem
+	plainLap      lib.RestWatchStandingsHistoryResponseItemItem
+)
+
+// memTransport answers GET requests with the payloads of their paths.
+type memTransport map[string][]byte
+
+func (t memTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	data, ok := t[r.URL.Path]
+	if !ok {
+		return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody, Request: r}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(data)),
+		ContentLength: int64(len(data)), Header: http.Header{"Content-Type": {"application/json"}}, Request: r}, nil
+}
+
+func memClient(tb testing.TB) (*lib.Client, []byte, []byte) {
+	tb.Helper()
+	standings, err := os.ReadFile(*standingsFile)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	history, err := os.ReadFile(*historyFile)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	client := lib.NewClient("http://game")
+	client.HTTPClient = &http.Client{Transport: memTransport{
+		"/rest/watch/standings":         standings,
+		"/rest/watch/standings/history": history,
+	}}
+	return client, standings, history
+}
+
+// agree fails unless the values decoded by the client and by encoding/json
+// are the same. Both go through JSON again to compare the plain types with
+// the models.
+func agree(t *testing.T, client, plain any) {
+	t.Helper()
+	a, _ := json.Marshal(client)
+	b, _ := json.Marshal(plain)
+	if !bytes.Equal(a, b) {
+		t.Fatal("the client and encoding/json decode differently")
+	}
+}
+
+func TestDecodeAgrees(t *testing.T) {
+	client, standings, history := memClient(t)
+	got, err := client.RestWatchStandings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []plainStanding
+	if err := json.Unmarshal(standings, &want); err != nil {
+		t.Fatal(err)
+	}
+	agree(t, got, want)
+
+	gotHistory, err := client.RestWatchStandingsHistory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wantHistory map[string][]plainLap
+	if err := json.Unmarshal(history, &wantHistory); err != nil {
+		t.Fatal(err)
+	}
+	agree(t, *gotHistory, wantHistory)
+}
+
+func BenchmarkDecodeStandings(b *testing.B) {
+	client, standings, _ := memClient(b)
+	b.Run("client", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(standings)))
+		for i := 0; i < b.N; i++ {
+			if _, err := client.RestWatchStandings(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("encoding_json", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(standings)))
+		for i := 0; i < b.N; i++ {
+			var v []plainStanding
+			if err := json.Unmarshal(standings, &v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkDecodeHistory(b *testing.B) {
+	client, _, history := memClient(b)
+	b.Run("client", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(history)))
+		for i := 0; i < b.N; i++ {
+			if _, err := client.RestWatchStandingsHistory(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("encoding_json", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(history)))
+		for i := 0; i < b.N; i++ {
+			var v map[string][]plainLap
+			if err := json.Unmarshal(history, &v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,170 @@
+// Package delta computes a live running delta of the player's current lap
+// against a reference lap. With only sector times available for the
+// reference, time within a sector is interpolated linearly over distance
+// between the sector boundaries, which are learned from the live car. A
+// reference with a mini-sector Profile is followed mini-sector by
+// mini-sector instead.
+package delta
+
+import (
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Reference is the lap to compare against. Sectors are split times
+// (S1, S2, S3), not cumulative.
+type Reference struct {
+	LapTime float64
+	Sectors [3]float64
+	Label   string // e.g. "PB 2025-03-02"
+	// Profile, when learned on this track, gives the reference's time at
+	// every mini-sector boundary.
+	Profile Profile
+}
+
+// Valid reports whether the reference has a lap time and all three sectors.
+func (r Reference) Valid() bool {
+	return r.LapTime > 0 && r.Sectors[0] > 0 && r.Sectors[1] > 0 && r.Sectors[2] > 0
+}
+
+// Reading is the state after one update.
+type Reading struct {
+	Delta        float64 // running delta, negative = ahead of reference
+	Valid        bool
+	Sector       int        // current sector, 0-based
+	SectorDeltas [3]float64 // deltas of sectors completed this lap
+	SectorsDone  int
+	LastLapDelta float64 // delta of the last completed lap, 0 if none
+	// MiniDone is the mini-sectors completed this lap and MiniDelta the
+	// delta of the last of them; both 0 without a reference profile.
+	MiniDone  int
+	MiniDelta float64
+}
+
+// Tracker follows one car lap by lap.
+type Tracker struct {
+	Ref         Reference
+	TrackLength float64
+
+	bounds  [2]float64 // lap distance at the start of S2 and S3
+	lastLap float64
+	last    Reading
+	prev    sample  // the car at the last update, for mini-sector crossings
+	cross   float64 // time into the lap at the last mini-sector boundary, -1 before the first seen
+	miniLap float64
+}
+
+func NewTracker(ref Reference, trackLength float64) *Tracker {
+	t := &Tracker{Ref: ref, TrackLength: trackLength}
+	// until the boundaries are seen, assume thirds
+	t.bounds = [2]float64{trackLength / 3, 2 * trackLength / 3}
+	return t
+}
+
+// sectorIndex maps the API's sector string to 0..2.
+func sectorIndex(s string) int {
+	switch s {
+	case "SECTOR2":
+		return 1
+	case "SECTOR3", "SECTOR0":
+		return 2
+	}
+	return 0
+}
+
+// refTimeAt is the reference's elapsed lap time at distance d.
+func (t *Tracker) refTimeAt(d float64, sector int) float64 {
+	if t.Ref.Profile.Fits(t.TrackLength) {
+		return t.Ref.Profile.TimeAt(d)
+	}
+	starts := [3]float64{0, t.bounds[0], t.bounds[1]}
+	ends := [3]float64{t.bounds[0], t.bounds[1], t.TrackLength}
+	base := 0.0
+	for i := 0; i < sector; i++ {
+		base += t.Ref.Sectors[i]
+	}
+	span := ends[sector] - starts[sector]
+	if span <= 0 {
+		return base
+	}
+	f := (d - starts[sector]) / span
+	if f < 0 {
+		f = 0
+	}
+	if f > 1 {
+		f = 1
+	}
+	return base + f*t.Ref.Sectors[sector]
+}
+
+// Update feeds the car's latest standings entry.
+func (t *Tracker) Update(s lib.RestWatchStandingsResponseItem) Reading {
+	r := Reading{Sector: sectorIndex(s.Sector), LastLapDelta: t.last.LastLapDelta}
+	if !t.Ref.Valid() || t.TrackLength <= 0 {
+		return r
+	}
+
+	// learn sector boundaries from the first sample inside a new sector
+	if r.Sector != t.last.Sector && r.Sector > 0 && s.LapDistance > 0 {
+		t.bounds[r.Sector-1] = s.LapDistance
+	}
+	if s.LapsCompleted != t.lastLap {
+		if t.lastLap > 0 && s.LastLapTime > 0 {
+			r.LastLapDelta = s.LastLapTime - t.Ref.LapTime
+		}
+		t.lastLap = s.LapsCompleted
+	}
+
+	if s.CurrentSectorTime1 > 0 && r.Sector >= 1 {
+		r.SectorDeltas[0] = s.CurrentSectorTime1 - t.Ref.Sectors[0]
+		r.SectorsDone = 1
+	}
+	if s.CurrentSectorTime2 > 0 && r.Sector >= 2 {
+		r.SectorDeltas[1] = (s.CurrentSectorTime2 - s.CurrentSectorTime1) - t.Ref.Sectors[1]
+		r.SectorsDone = 2
+	}
+
+	if s.TimeIntoLap > 0 {
+		r.Delta = s.TimeIntoLap - t.refTimeAt(s.LapDistance, r.Sector)
+		r.Valid = true
+	}
+	t.miniSectors(s, &r)
+	t.last = r
+	return r
+}
+
+// miniSectors times the mini-sectors completed since the last update,
+// placing each boundary crossing between the two updates.
+func (t *Tracker) miniSectors(s lib.RestWatchStandingsResponseItem, r *Reading) {
+	p := t.Ref.Profile
+	cur := sample{s.LapDistance, s.TimeIntoLap}
+	if !p.Fits(t.TrackLength) || s.TimeIntoLap <= 0 {
+		t.prev = cur
+		return
+	}
+	if s.LapsCompleted != t.miniLap || cur.dist < t.prev.dist {
+		t.miniLap, t.prev, t.cross = s.LapsCompleted, sample{0, 0}, 0
+		// Joined mid-lap: the mini-sector in progress was not seen starting
+		if cur.dist > p.Boundary(1) {
+			t.prev, t.cross = cur, -1
+			r.MiniDone = min(int(cur.dist/p.Boundary(1)), p.MiniSectors())
+		}
+	} else {
+		r.MiniDone, r.MiniDelta = t.last.MiniDone, t.last.MiniDelta
+	}
+	for r.MiniDone < p.MiniSectors() {
+		b := p.Boundary(r.MiniDone + 1)
+		if cur.dist < b {
+			break
+		}
+		at := cur.t
+		if cur.dist > t.prev.dist {
+			at = t.prev.t + (b-t.prev.dist)/(cur.dist-t.prev.dist)*(cur.t-t.prev.t)
+		}
+		if t.cross >= 0 {
+			r.MiniDelta = (at - t.cross) - p.Mini(r.MiniDone)
+		}
+		t.cross = at
+		r.MiniDone++
+	}
+	t.prev = cur
+}
@@ -0,0 +1,155 @@
+package delta
+
+import (
+	"math"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// DefaultMiniSectors is how many mini-sectors a lap is split into.
+const DefaultMiniSectors = 50
+
+// Profile is a lap's elapsed time at evenly spaced points around the track,
+// the mini-sector boundaries: Times[i] is the time at distance
+// i*TrackLength/(len(Times)-1), so Times[0] is 0 and the last is the lap
+// time. The API only times three sectors; profiles are learned from the
+// car's lap distance and time into the lap at every poll.
+type Profile struct {
+	TrackLength float64   `json:"trackLength"`
+	Times       []float64 `json:"times"`
+}
+
+// Valid reports whether the profile has mini-sectors.
+func (p Profile) Valid() bool {
+	return p.TrackLength > 0 && len(p.Times) >= 2 && p.Times[len(p.Times)-1] > 0
+}
+
+// MiniSectors is the number of mini-sectors.
+func (p Profile) MiniSectors() int {
+	return max(len(p.Times)-1, 0)
+}
+
+// Mini is the time spent in mini-sector i.
+func (p Profile) Mini(i int) float64 {
+	if i < 0 || i+1 >= len(p.Times) {
+		return 0
+	}
+	return p.Times[i+1] - p.Times[i]
+}
+
+// Boundary is the lap distance where mini-sector i starts.
+func (p Profile) Boundary(i int) float64 {
+	return float64(i) * p.TrackLength / float64(p.MiniSectors())
+}
+
+// TimeAt is the elapsed lap time at distance d, interpolated within the
+// mini-sector.
+func (p Profile) TimeAt(d float64) float64 {
+	if !p.Valid() {
+		return 0
+	}
+	n := p.MiniSectors()
+	x := math.Min(math.Max(d/p.TrackLength, 0), 1) * float64(n)
+	i := min(int(x), n-1)
+	return p.Times[i] + (x-float64(i))*p.Mini(i)
+}
+
+// Fits reports whether the profile was learned on a track of the given
+// length, give or take 1%.
+func (p Profile) Fits(trackLength float64) bool {
+	return p.Valid() && math.Abs(p.TrackLength-trackLength) <= trackLength/100
+}
+
+// sample is a car's position and time into the lap at one poll.
+type sample struct {
+	dist, t float64
+}
+
+// Learner builds a car's profile lap by lap from its standings entries.
+// Laps the car was not seen on all the way round, with a gap of more than
+// 5% of the lap between two polls, or that went backwards (a reset to the
+// garage) give no profile.
+type Learner struct {
+	TrackLength float64
+	N           int // mini-sectors, DefaultMiniSectors when 0
+
+	lap     float64 // LapsCompleted while the samples were taken
+	samples []sample
+	bad     bool
+	last    Profile
+	lastLap int
+}
+
+// NewLearner returns a learner for a track of the given length.
+func NewLearner(trackLength float64) *Learner {
+	return &Learner{TrackLength: trackLength, lap: -1}
+}
+
+// Update feeds the car's latest entry. When the car has just completed a
+// lap that could be learned, it returns its profile and true.
+func (l *Learner) Update(s lib.RestWatchStandingsResponseItem) (Profile, bool) {
+	var out Profile
+	var ok bool
+	if s.LapsCompleted != l.lap {
+		if l.lap >= 0 && s.LapsCompleted == l.lap+1 && s.LastLapTime > 0 {
+			out, ok = l.build(s.LastLapTime)
+			if ok {
+				l.last, l.lastLap = out, int(s.LapsCompleted)
+			}
+		}
+		l.lap, l.samples, l.bad = s.LapsCompleted, l.samples[:0], false
+	}
+	if s.PitState != "NONE" && s.PitState != "" || s.InGarageStall {
+		l.bad = true
+	}
+	if s.LapDistance >= 0 && s.TimeIntoLap > 0 {
+		if n := len(l.samples); n > 0 && s.LapDistance < l.samples[n-1].dist {
+			l.bad = true
+		}
+		l.samples = append(l.samples, sample{s.LapDistance, s.TimeIntoLap})
+	}
+	return out, ok
+}
+
+// Last returns the last profile learned and the number of the lap it is
+// of, 0 before the first.
+func (l *Learner) Last() (int, Profile) {
+	return l.lastLap, l.last
+}
+
+// build interpolates the samples of a completed lap at the boundaries.
+func (l *Learner) build(lapTime float64) (Profile, bool) {
+	if l.bad || l.TrackLength <= 0 || len(l.samples) < 2 {
+		return Profile{}, false
+	}
+	maxGap := l.TrackLength / 20
+	pts := make([]sample, 0, len(l.samples)+2)
+	pts = append(pts, sample{0, 0})
+	pts = append(pts, l.samples...)
+	pts = append(pts, sample{l.TrackLength, lapTime})
+	for i := 1; i < len(pts); i++ {
+		if pts[i].dist-pts[i-1].dist > maxGap || pts[i].t < pts[i-1].t {
+			return Profile{}, false
+		}
+	}
+	n := l.N
+	if n <= 0 {
+		n = DefaultMiniSectors
+	}
+	p := Profile{TrackLength: l.TrackLength, Times: make([]float64, n+1)}
+	j := 0
+	for i := 1; i < n; i++ {
+		d := p.Boundary(i)
+		for j+1 < len(pts)-1 && pts[j+1].dist < d {
+			j++
+		}
+		a, b := pts[j], pts[j+1]
+		if b.dist > a.dist {
+			p.Times[i] = a.t + (d-a.dist)/(b.dist-a.dist)*(b.t-a.t)
+		} else {
+			p.Times[i] = a.t
+		}
+	}
+	p.Times[n] = lapTime
+	return p, true
+}
@@ -0,0 +1,129 @@
+package delta
+
+import (
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/config"
+)
+
+// TargetFor picks the configured target for a track and car: one for the
+// car before one for its class before one for any car. Tracks and cars
+// match case-insensitively.
+func TargetFor(targets []config.Target, track, car, class string) (config.Target, bool) {
+	best, rank := config.Target{}, 0
+	for _, t := range targets {
+		if !strings.EqualFold(t.Track, track) || t.Lap <= 0 {
+			continue
+		}
+		r := 0
+		switch {
+		case t.Car != "":
+			if strings.EqualFold(t.Car, car) {
+				r = 3
+			}
+		case t.Class != "":
+			if carclass.Lookup(t.Class).Name == carclass.Lookup(class).Name {
+				r = 2
+			}
+		default:
+			r = 1
+		}
+		if r > rank {
+			best, rank = t, r
+		}
+	}
+	return best, rank > 0
+}
+
+// TargetReference turns a target into a reference lap. A target without
+// its three sectors is split in the proportions of like, e.g. the personal
+// best, or in thirds when like has no sectors either.
+func TargetReference(t config.Target, like Reference) Reference {
+	ref := Reference{LapTime: t.Lap, Label: "target"}
+	switch {
+	case len(t.Sectors) == 3:
+		copy(ref.Sectors[:], t.Sectors)
+	case like.Valid():
+		for i, s := range like.Sectors {
+			ref.Sectors[i] = s / like.LapTime * t.Lap
+		}
+	default:
+		ref.Sectors = [3]float64{t.Lap / 3, t.Lap / 3, t.Lap / 3}
+	}
+	return ref
+}
+
+// Run collects the flying laps of a practice run, from leaving the pits to
+// returning, for a summary against a target.
+type Run struct {
+	Target Reference
+
+	laps []runLap
+}
+
+type runLap struct {
+	time    float64
+	sectors [3]float64
+}
+
+// Add records a flying lap. Sectors are split times, 0 when unknown.
+func (r *Run) Add(lap float64, sectors [3]float64) {
+	if lap > 0 {
+		r.laps = append(r.laps, runLap{lap, sectors})
+	}
+}
+
+// Laps returns the number of laps recorded.
+func (r *Run) Laps() int { return len(r.laps) }
+
+// RunSummary sums up a run against its target. Deltas are negative when
+// faster than the target.
+type RunSummary struct {
+	Laps          int
+	Beaten        int // laps at or under the target
+	Best          float64
+	Mean          float64
+	BestDelta     float64
+	MeanDelta     float64
+	BestSectors   [3]float64 // each sector's best over the run, 0 when unknown
+	SectorDeltas  [3]float64
+	SectorsBeaten [3]int // laps with the sector at or under the target's
+}
+
+// Summary sums up the laps recorded.
+func (r *Run) Summary() RunSummary {
+	s := RunSummary{Laps: len(r.laps)}
+	if s.Laps == 0 {
+		return s
+	}
+	var sum float64
+	for _, l := range r.laps {
+		sum += l.time
+		if s.Best == 0 || l.time < s.Best {
+			s.Best = l.time
+		}
+		if l.time <= r.Target.LapTime {
+			s.Beaten++
+		}
+		for i, sec := range l.sectors {
+			if sec <= 0 {
+				continue
+			}
+			if s.BestSectors[i] == 0 || sec < s.BestSectors[i] {
+				s.BestSectors[i] = sec
+			}
+			if sec <= r.Target.Sectors[i] {
+				s.SectorsBeaten[i]++
+			}
+		}
+	}
+	s.Mean = sum / float64(s.Laps)
+	s.BestDelta, s.MeanDelta = s.Best-r.Target.LapTime, s.Mean-r.Target.LapTime
+	for i, b := range s.BestSectors {
+		if b > 0 {
+			s.SectorDeltas[i] = b - r.Target.Sectors[i]
+		}
+	}
+	return s
+}
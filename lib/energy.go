@@ -0,0 +1,114 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Hand-written typed wrappers for the fuel and virtual energy data. The
+// generated models for these endpoints are inferred from a single sample and
+// key /rest/strategy/usage by driver name, which makes them unusable across
+// sessions; these types describe the real shapes.
+
+// EnergyState is the player car's current fuel, virtual energy and battery
+// levels together with their capacities.
+type EnergyState struct {
+	Fuel             float64 `json:"currentFuel"`
+	MaxFuel          float64 `json:"maxFuel"`
+	VirtualEnergy    float64 `json:"currentVirtualEnergy"`
+	MaxVirtualEnergy float64 `json:"maxVirtualEnergy"`
+	Battery          float64 `json:"currentBattery"`
+	MaxBattery       float64 `json:"maxBattery"`
+}
+
+// UsesVirtualEnergy reports whether the car runs on a virtual energy budget
+// (Hypercar, LMGT3 under BoP) rather than plain fuel.
+func (e EnergyState) UsesVirtualEnergy() bool {
+	return e.MaxVirtualEnergy > 0
+}
+
+// EnergyPercent is the remaining virtual energy in percent of the tank.
+func (e EnergyState) EnergyPercent() float64 {
+	if e.MaxVirtualEnergy <= 0 {
+		return 0
+	}
+	return 100 * e.VirtualEnergy / e.MaxVirtualEnergy
+}
+
+// RefuelRates are the pit crew timings that matter for refuelling, taken
+// from the pit stop time table. Fill rates are in units per second of the
+// matching EnergyState field.
+type RefuelRates struct {
+	FuelFillRate     float64 `json:"FuelFillRate"`
+	FuelInsert       float64 `json:"FuelInsert"`
+	FuelRemove       float64 `json:"FuelRemove"`
+	EnergyFillRate   float64 `json:"virtualEnergyFillRate"`
+	EnergyInsert     float64 `json:"virtualEnergyInsert"`
+	EnergyRemove     float64 `json:"virtualEnergyRemove"`
+	EnergyConcurrent float64 `json:"virtualEnergyTimeConcurrent"`
+}
+
+// ExpectedUsage is the game's own per-lap consumption estimate.
+type ExpectedUsage struct {
+	FuelPerLap           float64 `json:"fuelConsumption"`
+	FuelFractionPerLap   float64 `json:"fuelFractionPerLap"`
+	EnergyPerLap         float64 `json:"virtualEnergyConsumption"`
+	EnergyFractionPerLap float64 `json:"virtualEnergyFractionPerLap"`
+}
+
+// UsageLap is one lap of a driver's fuel/energy history from /rest/strategy/usage.
+type UsageLap struct {
+	Lap   float64 `json:"lap"`
+	Pit   bool    `json:"pit"`
+	Stint float64 `json:"stint"`
+	VE    float64 `json:"ve"`   // virtual energy left at the end of the lap, 0..1
+	Fuel  float64 `json:"fuel"` // fuel fraction left, when the car has no VE
+}
+
+// Energy returns the player car's fuel and energy levels together with the
+// refuel timings, both from the repair and refuel screen.
+func (c *Client) Energy() (*EnergyState, *RefuelRates, error) {
+	data, err := c.doRequest("GET", "/rest/garage/UIScreen/RepairAndRefuel", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var result struct {
+		FuelInfo     EnergyState `json:"fuelInfo"`
+		PitStopTimes struct {
+			Times RefuelRates `json:"times"`
+		} `json:"pitStopTimes"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, nil, fmt.Errorf("decode energy: %w", err)
+	}
+	return &result.FuelInfo, &result.PitStopTimes.Times, nil
+}
+
+// ExpectedEnergyUsage returns the game's consumption estimate from the tyre
+// management screen.
+func (c *Client) ExpectedEnergyUsage() (*ExpectedUsage, error) {
+	data, err := c.doRequest("GET", "/rest/garage/UIScreen/TireManagement", nil)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		ExpectedUsage ExpectedUsage `json:"expectedUsage"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("decode expected usage: %w", err)
+	}
+	return &result.ExpectedUsage, nil
+}
+
+// StrategyUsage returns per-driver fuel/energy history, keyed by driver name.
+func (c *Client) StrategyUsage() (map[string][]UsageLap, error) {
+	data, err := c.doRequest("GET", "/rest/strategy/usage", nil)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string][]UsageLap
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("decode strategy usage: %w", err)
+	}
+	return result, nil
+}
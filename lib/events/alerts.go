@@ -0,0 +1,119 @@
+package events
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/rules"
+)
+
+type alertRule struct {
+	cfg     config.Alert
+	when    *rules.Program
+	message *template.Template
+	perCar  bool
+	active  map[int]bool      // condition state per slot (-1 for session-wide rules)
+	fired   map[int]time.Time // last firing per slot
+}
+
+// Alerts evaluates user-defined alert rules against snapshots. Rules that
+// mention "standing" are evaluated per car; all others once per snapshot.
+type Alerts struct {
+	rules []*alertRule
+}
+
+// NewAlerts compiles the rules and type-checks them against the standings
+// and session models, so typos in field names are reported at startup
+// rather than mid-race.
+func NewAlerts(cfg []config.Alert) (*Alerts, error) {
+	a := &Alerts{}
+	probe := map[string]interface{}{
+		"standing": lib.RestWatchStandingsResponseItem{},
+		"player":   lib.RestWatchStandingsResponseItem{},
+		"session":  lib.RestWatchSessionInfoResponse{},
+	}
+	for _, c := range cfg {
+		prog, err := rules.Compile(c.When)
+		if err != nil {
+			return nil, fmt.Errorf("alert %q: %w", c.Name, err)
+		}
+		if err := prog.CheckBool(probe); err != nil {
+			return nil, fmt.Errorf("alert %q: %w", c.Name, err)
+		}
+		msg := c.Message
+		if msg == "" {
+			msg = c.Name
+		}
+		tmpl, err := template.New(c.Name).Parse(msg)
+		if err != nil {
+			return nil, fmt.Errorf("alert %q: message: %w", c.Name, err)
+		}
+		a.rules = append(a.rules, &alertRule{
+			cfg:     c,
+			when:    prog,
+			message: tmpl,
+			perCar:  prog.Uses("standing"),
+			active:  map[int]bool{},
+			fired:   map[int]time.Time{},
+		})
+	}
+	return a, nil
+}
+
+// Process evaluates every rule and returns Alert events for conditions that
+// just became true. Evaluation errors are returned alongside the events.
+func (a *Alerts) Process(snap Snapshot) ([]Event, error) {
+	var out []Event
+	var firstErr error
+	player, _ := snap.player()
+	var session interface{} = lib.RestWatchSessionInfoResponse{}
+	if snap.Session != nil {
+		session = *snap.Session
+	}
+	for _, r := range a.rules {
+		check := func(slot int, car lib.RestWatchStandingsResponseItem) {
+			env := map[string]interface{}{"standing": car, "player": player, "session": session}
+			ok, err := r.when.EvalBool(env)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("alert %q: %w", r.cfg.Name, err)
+				}
+				return
+			}
+			was := r.active[slot]
+			r.active[slot] = ok
+			if !ok || was {
+				return
+			}
+			if last, seen := r.fired[slot]; seen && snap.At.Sub(last) < time.Duration(r.cfg.Cooldown) {
+				return
+			}
+			r.fired[slot] = snap.At
+			e := newEvent(Alert, snap, car)
+			if slot < 0 {
+				e.SlotID = -1
+			}
+			e.Rule = r.cfg.Name
+			e.Priority = r.cfg.Priority
+			var buf bytes.Buffer
+			if err := r.message.Execute(&buf, e); err != nil {
+				e.Detail = r.cfg.Name
+			} else {
+				e.Detail = buf.String()
+			}
+			out = append(out, e)
+		}
+		if r.perCar {
+			for _, s := range snap.Standings {
+				check(int(s.SlotID), s)
+			}
+		} else {
+			check(-1, player)
+		}
+	}
+	return out, firstErr
+}
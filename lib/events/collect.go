@@ -0,0 +1,162 @@
+package events
+
+import (
+	"errors"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/strategy"
+	"github.com/snipem/go-lmu-api/lib/timesync"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// Section names, the keys of Snapshot.Errors and the endpoint names of the
+// snapshot's FrameMeta.
+const (
+	SectionStandings = "standings"
+	SectionSession   = "sessionInfo"
+	SectionHistory   = "history"
+	SectionEnergy    = "energy"
+	SectionWheels    = "wheels"
+	SectionForecast  = "forecast"
+)
+
+// Collector fetches the snapshot sections from the API once per tick. A
+// section that fails does not fail the tick: its error goes into
+// Snapshot.Errors and for Budget ticks in a row it keeps the value of the
+// last tick that had it, so one slow or broken endpoint neither blanks a
+// display nor makes the detectors see cars vanish; after that it is nil.
+// Standings are required: a tick fails when they fail with a connection
+// error, as the game is not there and the other sections are not tried,
+// or when they have failed for longer than the budget. It is not safe for
+// concurrent use.
+type Collector struct {
+	Client *lib.Client
+	// Budget is how many ticks in a row a failed section keeps its last
+	// value; 0 drops it at once.
+	Budget int
+	// ForecastEvery is how often the forecast, which changes slowly and
+	// lives in a heavy payload, is fetched; 0 leaves it out.
+	ForecastEvery time.Duration
+
+	seq        watch.Sequence
+	clock      *timesync.Clock
+	last       Snapshot
+	fails      map[string]int
+	forecastAt time.Time
+}
+
+func NewCollector(c *lib.Client) *Collector {
+	return &Collector{Client: c, Budget: 3, ForecastEvery: 30 * time.Second, clock: timesync.New(), fails: map[string]int{}}
+}
+
+// Clock is the fit of the session clock the snapshots carry, e.g. for
+// Log.Sync.
+func (c *Collector) Clock() *timesync.Clock { return c.clock }
+
+// Collect fetches a snapshot. Energy and wheels are the player car's
+// garage screens, which fail while spectating.
+func (c *Collector) Collect(now time.Time) (Snapshot, error) {
+	capture := c.seq.Start(now)
+	snap := Snapshot{At: now, Clock: c.clock}
+	// section fetches one section with get, which fills snap, or records
+	// the error and lets keep carry the last value over.
+	section := func(name string, get func() error, keep func()) error {
+		t := time.Now()
+		err := get()
+		capture.Observe(name, time.Since(t))
+		if err == nil {
+			c.fails[name] = 0
+			return nil
+		}
+		if snap.Errors == nil {
+			snap.Errors = map[string]error{}
+		}
+		snap.Errors[name] = err
+		c.fails[name]++
+		if c.fails[name] <= c.Budget {
+			keep()
+		}
+		return err
+	}
+
+	err := section(SectionStandings, func() error {
+		s, err := c.Client.RestWatchStandings()
+		if err == nil {
+			snap.Standings, _ = watch.SanitizeStandings(s)
+		}
+		return err
+	}, func() { snap.Standings = c.last.Standings })
+	if watch.IsConnError(err) || err != nil && snap.Standings == nil {
+		return Snapshot{}, err
+	}
+
+	section(SectionSession, func() error {
+		t := time.Now()
+		si, err := c.Client.RestWatchSessionInfo()
+		if err == nil {
+			c.clock.ObserveRequest(t, time.Now(), si.CurrentEventTime)
+		}
+		snap.Session = si
+		return err
+	}, func() { snap.Session = c.last.Session })
+	section(SectionHistory, func() error {
+		raw, err := c.Client.RestWatchStandingsHistory()
+		if err == nil && raw != nil {
+			snap.History, _ = watch.SanitizeHistory(*raw)
+		}
+		return err
+	}, func() { snap.History = c.last.History })
+	section(SectionEnergy, func() error {
+		e, rates, err := c.Client.Energy()
+		if err != nil {
+			return err
+		}
+		usage, err := c.Client.ExpectedEnergyUsage()
+		if err != nil {
+			return err
+		}
+		tank := strategy.NewTank(*e, *usage, *rates)
+		snap.Tank = &tank
+		return nil
+	}, func() { snap.Tank = c.last.Tank })
+	section(SectionWheels, func() error {
+		w, err := c.Client.WheelTemps()
+		snap.Wheels = w
+		return err
+	}, func() { snap.Wheels = c.last.Wheels })
+
+	snap.Forecast = c.last.Forecast
+	if c.ForecastEvery > 0 && now.Sub(c.forecastAt) >= c.ForecastEvery {
+		c.forecastAt = now
+		section(SectionForecast, func() error {
+			f, err := c.Client.Forecast()
+			snap.Forecast = f
+			return err
+		}, func() { snap.Forecast = c.last.Forecast })
+	}
+
+	snap.Meta = capture.Done()
+	c.last = snap
+	return snap, nil
+}
+
+// Err joins the section errors, each prefixed with its section, nil when
+// none failed.
+func (s Snapshot) Err() error {
+	var errs []error
+	for _, name := range []string{SectionStandings, SectionSession, SectionHistory, SectionEnergy, SectionWheels, SectionForecast} {
+		if err := s.Errors[name]; err != nil {
+			errs = append(errs, sectionError{name, err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type sectionError struct {
+	section string
+	err     error
+}
+
+func (e sectionError) Error() string { return e.section + ": " + e.err.Error() }
+func (e sectionError) Unwrap() error { return e.err }
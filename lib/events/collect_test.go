@@ -0,0 +1,141 @@
+package events
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// game serves small payloads for the endpoints the collector fetches and
+// fails the ones marked broken with HTTP 500.
+type game struct {
+	mu     sync.Mutex
+	broken map[string]bool
+}
+
+var payloads = map[string]string{
+	"/rest/watch/standings":                 `[{"slotID": 1, "driverName": "A", "vehicleName": "Car", "position": 1}]`,
+	"/rest/watch/sessionInfo":               `{"session": "RACE1", "currentEventTime": 120}`,
+	"/rest/watch/standings/history":         `{"1": [{"slotID": 1, "lapTime": 95.5}]}`,
+	"/rest/garage/UIScreen/RepairAndRefuel": `{}`,
+	"/rest/garage/UIScreen/TireManagement":  `{}`,
+}
+
+func (g *game) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	broken := g.broken[r.URL.Path]
+	g.mu.Unlock()
+	body, ok := payloads[r.URL.Path]
+	switch {
+	case broken:
+		http.Error(w, "broken", http.StatusInternalServerError)
+	case !ok:
+		http.NotFound(w, r)
+	default:
+		w.Write([]byte(body))
+	}
+}
+
+func (g *game) breakPath(path string, broken bool) {
+	g.mu.Lock()
+	g.broken[path] = broken
+	g.mu.Unlock()
+}
+
+func newCollector(t *testing.T) (*Collector, *game, *httptest.Server) {
+	g := &game{broken: map[string]bool{}}
+	srv := httptest.NewServer(g)
+	t.Cleanup(srv.Close)
+	c := NewCollector(lib.NewClient(srv.URL))
+	c.Budget = 2
+	c.ForecastEvery = 0
+	return c, g, srv
+}
+
+func TestCollectPartial(t *testing.T) {
+	c, g, _ := newCollector(t)
+	now := time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC)
+
+	snap, err := c.Collect(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snap.History) != 1 || snap.Errors[SectionHistory] != nil {
+		t.Fatalf("healthy tick: history %v, errors %v", snap.History, snap.Errors)
+	}
+
+	g.breakPath("/rest/watch/standings/history", true)
+	// Budget ticks keep the last history, the one after drops it
+	for i := 1; i <= c.Budget+1; i++ {
+		now = now.Add(time.Second)
+		snap, err := c.Collect(now)
+		if err != nil {
+			t.Fatalf("tick %d: history failing fails the tick: %v", i, err)
+		}
+		if len(snap.Standings) != 1 || snap.Session == nil || snap.Session.CurrentEventTime != 120 {
+			t.Fatalf("tick %d: healthy sections missing: standings %v, session %v", i, snap.Standings, snap.Session)
+		}
+		if snap.Errors[SectionHistory] == nil {
+			t.Fatalf("tick %d: no history error in %v", i, snap.Errors)
+		}
+		if snap.Errors[SectionStandings] != nil || snap.Errors[SectionSession] != nil {
+			t.Fatalf("tick %d: errors for healthy sections: %v", i, snap.Errors)
+		}
+		if keep := i <= c.Budget; keep != (snap.History != nil) {
+			t.Fatalf("tick %d: history %v, want kept = %v", i, snap.History, keep)
+		}
+		if snap.Err() == nil {
+			t.Fatalf("tick %d: Err is nil", i)
+		}
+	}
+
+	g.breakPath("/rest/watch/standings/history", false)
+	snap, err = c.Collect(now.Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snap.History) != 1 || snap.Errors[SectionHistory] != nil {
+		t.Fatalf("recovered tick: history %v, errors %v", snap.History, snap.Errors)
+	}
+}
+
+func TestCollectStandingsRequired(t *testing.T) {
+	c, g, srv := newCollector(t)
+	now := time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC)
+
+	g.breakPath("/rest/watch/standings", true)
+	if _, err := c.Collect(now); err == nil {
+		t.Fatal("no standings yet and they fail: want an error")
+	}
+
+	g.breakPath("/rest/watch/standings", false)
+	if _, err := c.Collect(now); err != nil {
+		t.Fatal(err)
+	}
+	g.breakPath("/rest/watch/standings", true)
+	for i := 1; i <= c.Budget; i++ {
+		snap, err := c.Collect(now.Add(time.Duration(i) * time.Second))
+		if err != nil {
+			t.Fatalf("tick %d: standings within budget fail the tick: %v", i, err)
+		}
+		if len(snap.Standings) != 1 || snap.Errors[SectionStandings] == nil {
+			t.Fatalf("tick %d: standings %v, errors %v", i, snap.Standings, snap.Errors)
+		}
+	}
+	if _, err := c.Collect(now.Add(time.Minute)); err == nil {
+		t.Fatal("standings failing past the budget: want an error")
+	}
+
+	g.breakPath("/rest/watch/standings", false)
+	if _, err := c.Collect(now.Add(2 * time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+	if _, err := c.Collect(now.Add(3 * time.Minute)); err == nil {
+		t.Fatal("game gone: want an error even within the budget")
+	}
+}
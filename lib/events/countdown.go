@@ -0,0 +1,139 @@
+package events
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/strategy"
+)
+
+const (
+	TimeToGo       Kind = "time_to_go"       // Value: minutes left in the session
+	LapsToGo       Kind = "laps_to_go"       // Value: laps left for the leader
+	PitWindowClose Kind = "pit_window_close" // the regulated pit window closed
+)
+
+// Countdown emits events at the configured session phase marks: minutes to
+// the end of a timed session, laps to go in a race, and the opening and
+// closing of a regulated pit window (reported as PitWindowOpen with Detail
+// "Regulated window", and PitWindowClose). Each mark fires once per session,
+// when it is crossed; marks already passed when the countdown starts stay
+// quiet.
+// Countdown events are session-wide and carry SlotID -1.
+type Countdown struct {
+	minutes []float64
+	laps    []float64
+	window  config.PitWindow
+
+	prev  *Snapshot
+	fired map[string]bool
+}
+
+func NewCountdown(cfg config.Countdowns) *Countdown {
+	c := &Countdown{minutes: cfg.Minutes, laps: cfg.Laps, window: cfg.PitWindow, fired: map[string]bool{}}
+	if len(c.minutes) == 0 {
+		c.minutes = []float64{10, 5, 1}
+	}
+	if len(c.laps) == 0 {
+		c.laps = []float64{5, 1}
+	}
+	return c
+}
+
+// Process returns the marks crossed since the previous snapshot. The first
+// snapshot only primes the countdown.
+func (c *Countdown) Process(snap Snapshot) []Event {
+	if snap.Session == nil {
+		return nil
+	}
+	prev := c.prev
+	c.prev = &snap
+	if prev == nil || prev.Session == nil {
+		return nil
+	}
+	si, psi := snap.Session, prev.Session
+	if si.CurrentEventTime < psi.CurrentEventTime || si.Session != psi.Session {
+		c.fired = map[string]bool{}
+		return nil
+	}
+
+	var out []Event
+	emit := func(key string, kind Kind, value float64, detail string) {
+		if c.fired[key] {
+			return
+		}
+		c.fired[key] = true
+		p, _ := snap.player()
+		e := newEvent(kind, snap, p)
+		e.SlotID, e.Value, e.Detail = -1, value, detail
+		e.Lap = 0
+		for _, car := range snap.Standings {
+			if car.Position == 1 {
+				e.Lap = int(car.LapsCompleted) + 1
+			}
+		}
+		out = append(out, e)
+	}
+
+	// Minutes to the end of a timed session
+	if si.EndEventTime > 0 {
+		left, before := si.EndEventTime-si.CurrentEventTime, psi.EndEventTime-psi.CurrentEventTime
+		for _, m := range c.minutes {
+			if before > m*60 && left <= m*60 {
+				emit(fmt.Sprintf("min:%g", m), TimeToGo, m, strings.ToLower(si.Session))
+			}
+		}
+	}
+
+	if !strings.Contains(strings.ToUpper(si.Session), "RACE") {
+		return out
+	}
+	leader, ok := leaderOf(snap.Standings)
+	prevLeader, pok := leaderOf(prev.Standings)
+	if !ok || !pok {
+		return out
+	}
+
+	// Laps to go for the leader, whole laps as shown on a lap board
+	pace := leader.LastLapTime
+	if pace <= 0 {
+		pace = leader.EstimatedLapTime
+	}
+	left := math.Ceil(strategy.RemainingLaps(si, leader.LapsCompleted, pace))
+	before := math.Ceil(strategy.RemainingLaps(psi, prevLeader.LapsCompleted, pace))
+	for _, l := range c.laps {
+		if left > 0 && before > l && left <= l {
+			emit(fmt.Sprintf("laps:%g", l), LapsToGo, l, "")
+		}
+	}
+
+	// Regulated pit window
+	crossed := func(lap int, at config.Duration) bool {
+		if lap > 0 && prevLeader.LapsCompleted < float64(lap) && leader.LapsCompleted >= float64(lap) {
+			return true
+		}
+		t := time.Duration(at).Seconds()
+		return t > 0 && psi.CurrentEventTime < t && si.CurrentEventTime >= t
+	}
+	w := c.window
+	if crossed(w.OpenLap, w.Open) {
+		emit("window:open", PitWindowOpen, 0, "Regulated window")
+	}
+	if crossed(w.CloseLap, w.Close) {
+		emit("window:close", PitWindowClose, 0, "Regulated window")
+	}
+	return out
+}
+
+func leaderOf(standings []lib.RestWatchStandingsResponseItem) (lib.RestWatchStandingsResponseItem, bool) {
+	for _, s := range standings {
+		if s.Position == 1 {
+			return s, true
+		}
+	}
+	return lib.RestWatchStandingsResponseItem{}, false
+}
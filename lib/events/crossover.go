@@ -0,0 +1,277 @@
+package events
+
+import (
+	"math"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// TyreCrossover advises the player to change between slicks and wets.
+// Value: laps until the other tyres are faster, 0 when they already are;
+// Detail: the tyres that will be faster, "wets" or "slicks".
+const TyreCrossover Kind = "tyre_crossover"
+
+const (
+	// crossoverWet is the path wetness above which wets are taken to be on
+	// a car first seen, and that the forecast must reach to advise them.
+	crossoverWet = 0.3
+	// crossoverDry is the wetness below which the forecast advises slicks.
+	crossoverDry = 0.1
+	// crossoverPace is the change in a car's pace relative to its class, as
+	// a share of the lap, that tells a tyre change at a stop from a refuel.
+	crossoverPace = 0.015
+	// crossoverWindow is how far back, in seconds, the pace trends go.
+	crossoverWindow = 600
+)
+
+// CrossoverAdvice is the current crossover estimate for the player.
+type CrossoverAdvice struct {
+	Wets     bool    // the advice is wets; slicks otherwise
+	Laps     float64 // until they are faster, 0 when they already are
+	Forecast bool    // estimated from the forecast, nobody in the class runs the other tyres
+}
+
+// Tyres returns "wets" or "slicks".
+func (a CrossoverAdvice) Tyres() string {
+	if a.Wets {
+		return "wets"
+	}
+	return "slicks"
+}
+
+// Crossover watches who in the player's class runs slicks and who wets and
+// how their pace develops, and advises the player when the other tyres are
+// about to be faster. The API does not report tyre compounds: a car is
+// taken to change tyres at a stop when its pace relative to the class
+// changes afterwards. Without laps on the other tyres, the advice comes
+// from the track wetness forecast (see lib.Forecast.Timeline). It needs
+// the snapshot's Session, and Forecast for the forecast advice.
+type Crossover struct {
+	// AdviseLaps is how many laps before the crossover the player is told.
+	AdviseLaps float64
+
+	cars    map[int]*tyreCar
+	laps    []classLap
+	advice  CrossoverAdvice
+	ok      bool
+	advised string // tyres last advised, until the player stops
+	stops   float64
+	last    float64 // session time of the last snapshot
+}
+
+type tyreCar struct {
+	wet     bool
+	lap     float64 // LapsCompleted last seen
+	stops   float64
+	stopLap float64 // LapsCompleted at the last stop
+	before  float64 // pace relative to the class on the last lap before the stop
+	pace    float64 // the same on the latest green lap
+	paced   bool    // pace is known
+	known   bool    // before is known
+	pending bool    // the tyres after the stop are not known yet
+}
+
+// classLap is a green lap as a share of the class's best lap.
+type classLap struct {
+	slot  int
+	class string
+	t     float64 // session time
+	ratio float64
+	wet   bool
+}
+
+func NewCrossover() *Crossover {
+	return &Crossover{AdviseLaps: 3, cars: map[int]*tyreCar{}}
+}
+
+// Advice returns the current estimate, false when neither the laps nor the
+// forecast point to a change.
+func (c *Crossover) Advice() (CrossoverAdvice, bool) {
+	return c.advice, c.ok
+}
+
+// Process updates the cars' tyres and pace and returns a TyreCrossover
+// event when the crossover comes within AdviseLaps for the first time
+// since the player's last stop.
+func (c *Crossover) Process(snap Snapshot) []Event {
+	if snap.Session == nil {
+		return nil
+	}
+	now := snap.Session.CurrentEventTime
+	if now < c.last {
+		// new session
+		c.cars, c.laps, c.advised, c.stops = map[int]*tyreCar{}, nil, "", 0
+	}
+	c.last = now
+	wetness := snap.Session.AveragePathWetness
+	best := classBest(snap.Standings)
+
+	for _, s := range snap.Standings {
+		slot := int(s.SlotID)
+		car := c.cars[slot]
+		if car == nil {
+			c.cars[slot] = &tyreCar{wet: wetness > crossoverWet, lap: s.LapsCompleted, stops: s.Pitstops, stopLap: -2}
+			continue
+		}
+		if s.Pitstops > car.stops {
+			car.stops, car.stopLap, car.before, car.known, car.pending = s.Pitstops, s.LapsCompleted, car.pace, car.paced, true
+		}
+		if s.LapsCompleted <= car.lap {
+			continue
+		}
+		car.lap = s.LapsCompleted
+		// The in-lap and out-lap say nothing about the tyres
+		if s.LapsCompleted <= car.stopLap+1 || s.LastLapTime <= 0 || best[s.CarClass] <= 0 || s.Pitting {
+			continue
+		}
+		ratio := s.LastLapTime / best[s.CarClass]
+		car.pace, car.paced = ratio-c.classPace(slot, s.CarClass, now), true
+		if car.pending {
+			car.pending = false
+			if car.known && math.Abs(car.pace-car.before) > crossoverPace {
+				car.wet = !car.wet
+			}
+		}
+		c.laps = append(c.laps, classLap{slot: slot, class: s.CarClass, t: now, ratio: ratio, wet: car.wet})
+	}
+	c.prune(now)
+
+	p, ok := snap.player()
+	if !ok {
+		c.ok = false
+		return nil
+	}
+	if p.Pitstops != c.stops {
+		c.stops, c.advised = p.Pitstops, ""
+	}
+	c.advice, c.ok = c.estimate(snap, p)
+	if !c.ok || c.advice.Laps > c.AdviseLaps || c.advised == c.advice.Tyres() {
+		return nil
+	}
+	c.advised = c.advice.Tyres()
+	e := newEvent(TyreCrossover, snap, p)
+	e.Value, e.Detail = math.Round(c.advice.Laps), c.advised
+	return []Event{e}
+}
+
+// estimate compares the pace trends of the player's tyres and the others in
+// the class, or failing that reads the forecast.
+func (c *Crossover) estimate(snap Snapshot, p lib.RestWatchStandingsResponseItem) (CrossoverAdvice, bool) {
+	car := c.cars[int(p.SlotID)]
+	if car == nil || car.pending {
+		return CrossoverAdvice{}, false
+	}
+	now := snap.Session.CurrentEventTime
+	lapTime := p.LastLapTime
+	if lapTime <= 0 {
+		lapTime = p.EstimatedLapTime
+	}
+	if lapTime <= 0 {
+		return CrossoverAdvice{}, false
+	}
+	a := CrossoverAdvice{Wets: !car.wet}
+
+	mine, okMine := c.trend(p.CarClass, car.wet)
+	other, okOther := c.trend(p.CarClass, !car.wet)
+	if okMine && okOther {
+		// gap is how much slower the other tyres are, as a share of the
+		// class's best lap
+		gap := other.at(now) - mine.at(now)
+		if gap <= 0 {
+			return a, true
+		}
+		closing := mine.slope - other.slope
+		if closing <= 0 {
+			return CrossoverAdvice{}, false
+		}
+		a.Laps = gap / closing / lapTime
+		return a, true
+	}
+
+	if snap.Forecast == nil {
+		return CrossoverAdvice{}, false
+	}
+	tl := snap.Forecast.Timeline(now, lib.ConditionsOf(snap.Session), time.Hour, time.Minute)
+	for _, b := range tl.Buckets {
+		if car.wet && b.Wetness < crossoverDry || !car.wet && b.Wetness > crossoverWet {
+			a.Laps, a.Forecast = b.Minute*60/lapTime, true
+			return a, true
+		}
+	}
+	return CrossoverAdvice{}, false
+}
+
+// line is a least-squares fit of lap ratio over session time.
+type line struct {
+	t0, y0, slope float64
+}
+
+func (l line) at(t float64) float64 {
+	return l.y0 + (t-l.t0)*l.slope
+}
+
+// trend fits the class's recent laps on one kind of tyres. It needs three
+// laps.
+func (c *Crossover) trend(class string, wet bool) (line, bool) {
+	var n, st, sy float64
+	for _, l := range c.laps {
+		if l.class == class && l.wet == wet {
+			n, st, sy = n+1, st+l.t, sy+l.ratio
+		}
+	}
+	if n < 3 {
+		return line{}, false
+	}
+	fit := line{t0: st / n, y0: sy / n}
+	var stt, sty float64
+	for _, l := range c.laps {
+		if l.class == class && l.wet == wet {
+			stt += (l.t - fit.t0) * (l.t - fit.t0)
+			sty += (l.t - fit.t0) * (l.ratio - fit.y0)
+		}
+	}
+	if stt == 0 {
+		return line{}, false
+	}
+	fit.slope = sty / stt
+	return fit, true
+}
+
+// classPace is the mean lap ratio of the other cars in the class on their
+// latest laps, 0 without any.
+func (c *Crossover) classPace(slot int, class string, now float64) float64 {
+	var n, sum float64
+	seen := map[int]bool{slot: true}
+	for i := len(c.laps) - 1; i >= 0; i-- {
+		l := c.laps[i]
+		if l.class == class && !seen[l.slot] && now-l.t <= crossoverWindow {
+			seen[l.slot] = true
+			n, sum = n+1, sum+l.ratio
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return sum / n
+}
+
+// prune drops laps older than the window.
+func (c *Crossover) prune(now float64) {
+	i := 0
+	for i < len(c.laps) && now-c.laps[i].t > crossoverWindow {
+		i++
+	}
+	c.laps = c.laps[i:]
+}
+
+// classBest returns the best lap time in each class.
+func classBest(standings []lib.RestWatchStandingsResponseItem) map[string]float64 {
+	best := map[string]float64{}
+	for _, s := range standings {
+		if s.BestLapTime > 0 && (best[s.CarClass] == 0 || s.BestLapTime < best[s.CarClass]) {
+			best[s.CarClass] = s.BestLapTime
+		}
+	}
+	return best
+}
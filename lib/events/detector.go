@@ -0,0 +1,246 @@
+package events
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/strategy"
+)
+
+// Thresholds tune the detector rules.
+type Thresholds struct {
+	FuelLowLaps      float64 // warn when the tank lasts fewer laps than this
+	FuelCriticalLaps float64
+	RainWarnMinutes  float64 // look this far ahead in the forecast
+	RainChance       float64 // percent at which a forecast node counts as rain
+}
+
+// DefaultThresholds are reasonable values for endurance racing.
+var DefaultThresholds = Thresholds{
+	FuelLowLaps:      3,
+	FuelCriticalLaps: 1.5,
+	RainWarnMinutes:  5,
+	RainChance:       50,
+}
+
+// Detector compares each snapshot with the previous one and emits events for
+// the player car and its direct class rivals. It is not safe for concurrent use.
+type Detector struct {
+	Thresholds Thresholds
+
+	prev      *Snapshot
+	fuelStage int // 0 ok, 1 low warned, 2 critical warned
+	window    bool
+	lastLevel float64
+	rainNodes map[float64]bool
+}
+
+func NewDetector() *Detector {
+	return &Detector{Thresholds: DefaultThresholds, rainNodes: map[float64]bool{}}
+}
+
+// Process returns the events that happened between the previous snapshot
+// and this one. The first snapshot only primes the detector.
+func (d *Detector) Process(snap Snapshot) []Event {
+	prev := d.prev
+	d.prev = &snap
+	if prev == nil {
+		if snap.Tank != nil {
+			d.lastLevel = snap.Tank.Level
+		}
+		return nil
+	}
+	if snap.Session != nil && prev.Session != nil && snap.Session.CurrentEventTime < prev.Session.CurrentEventTime {
+		// new session: forget per-session state
+		d.fuelStage, d.window, d.rainNodes = 0, false, map[float64]bool{}
+		return nil
+	}
+
+	var out []Event
+	out = append(out, d.positions(*prev, snap)...)
+	out = append(out, d.rivals(*prev, snap)...)
+	out = append(out, d.fuel(snap)...)
+	out = append(out, d.rain(snap)...)
+	return out
+}
+
+func bySlot(standings []lib.RestWatchStandingsResponseItem) map[int]lib.RestWatchStandingsResponseItem {
+	m := make(map[int]lib.RestWatchStandingsResponseItem, len(standings))
+	for _, s := range standings {
+		m[int(s.SlotID)] = s
+	}
+	return m
+}
+
+// classOrder returns the cars of one class sorted by position.
+func classOrder(standings []lib.RestWatchStandingsResponseItem, class string) []lib.RestWatchStandingsResponseItem {
+	var out []lib.RestWatchStandingsResponseItem
+	for _, s := range standings {
+		if s.CarClass == class {
+			out = append(out, s)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Position < out[j].Position })
+	return out
+}
+
+func classPosition(standings []lib.RestWatchStandingsResponseItem, slot int, class string) int {
+	for i, s := range classOrder(standings, class) {
+		if int(s.SlotID) == slot {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func (d *Detector) positions(prev, snap Snapshot) []Event {
+	p, ok := snap.player()
+	if !ok {
+		return nil
+	}
+	var out []Event
+	before := bySlot(prev.Standings)
+	if old, ok := before[int(p.SlotID)]; ok {
+		was := classPosition(prev.Standings, int(p.SlotID), old.CarClass)
+		now := classPosition(snap.Standings, int(p.SlotID), p.CarClass)
+		if was > 0 && now > 0 && was != now {
+			kind := PositionGained
+			if now > was {
+				kind = PositionLost
+			}
+			e := newEvent(kind, snap, p)
+			e.Value = float64(now)
+			// The car now directly behind was passed; the one directly
+			// ahead did the passing
+			order := classOrder(snap.Standings, p.CarClass)
+			other := now
+			if kind == PositionLost {
+				other = now - 2
+			}
+			if other >= 0 && other < len(order) {
+				e.Involved = []string{order[other].CarNumber}
+			}
+			out = append(out, e)
+		}
+		if p.BestLapTime > 0 && p.LastLapTime == p.BestLapTime && p.LapsCompleted > old.LapsCompleted &&
+			(old.BestLapTime <= 0 || p.BestLapTime < old.BestLapTime) {
+			e := newEvent(PersonalBest, snap, p)
+			e.Value = p.BestLapTime
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// rivals reports pit entries of the cars directly ahead of and behind the
+// player in class.
+func (d *Detector) rivals(prev, snap Snapshot) []Event {
+	p, ok := snap.player()
+	if !ok {
+		return nil
+	}
+	order := classOrder(snap.Standings, p.CarClass)
+	before := bySlot(prev.Standings)
+	var out []Event
+	for i, s := range order {
+		if int(s.SlotID) != int(p.SlotID) {
+			continue
+		}
+		for _, j := range []int{i - 1, i + 1} {
+			if j < 0 || j >= len(order) {
+				continue
+			}
+			r := order[j]
+			old, ok := before[int(r.SlotID)]
+			if !ok {
+				continue
+			}
+			if r.Pitstops > old.Pitstops || (r.Pitting && !old.Pitting) {
+				e := newEvent(RivalPitted, snap, r)
+				e.Value = float64(j + 1)
+				if j < i {
+					e.Detail = "ahead"
+				} else {
+					e.Detail = "behind"
+				}
+				out = append(out, e)
+			}
+		}
+	}
+	return out
+}
+
+// fuel warns once per stint at the low and critical thresholds and when the
+// pit window opens, meaning a stop now no longer costs an extra stop later.
+func (d *Detector) fuel(snap Snapshot) []Event {
+	t := snap.Tank
+	p, ok := snap.player()
+	if t == nil || !ok || t.PerLap <= 0 {
+		return nil
+	}
+	if t.Level > d.lastLevel+t.PerLap/2 {
+		// refuelled: new stint
+		d.fuelStage, d.window = 0, false
+	}
+	d.lastLevel = t.Level
+
+	var out []Event
+	left := t.LapsLeft()
+	unit := "fuel"
+	if t.Unit == "%" {
+		unit = "energy"
+	}
+	if left < d.Thresholds.FuelCriticalLaps && d.fuelStage < 2 {
+		d.fuelStage = 2
+		e := newEvent(FuelCritical, snap, p)
+		e.Value, e.Detail = left, unit
+		out = append(out, e)
+	} else if left < d.Thresholds.FuelLowLaps && d.fuelStage < 1 {
+		d.fuelStage = 1
+		e := newEvent(FuelLow, snap, p)
+		e.Value, e.Detail = left, unit
+		out = append(out, e)
+	}
+
+	if !d.window && snap.Session != nil && strings.Contains(strings.ToUpper(snap.Session.Session), "RACE") {
+		pace := p.LastLapTime
+		if pace <= 0 {
+			pace = p.EstimatedLapTime
+		}
+		remaining := strategy.RemainingLaps(snap.Session, p.LapsCompleted, pace)
+		plan := strategy.Plan(*t, remaining, 0)
+		if plan.Stops > 0 && remaining <= float64(plan.Stops)*plan.LapsPerStint {
+			d.window = true
+			e := newEvent(PitWindowOpen, snap, p)
+			e.Value = left
+			e.Detail = fmt.Sprintf("%d stop(s) to go", plan.Stops)
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// rain warns once per forecast node that brings rain within the look-ahead
+// while it is currently dry.
+func (d *Detector) rain(snap Snapshot) []Event {
+	if snap.Forecast == nil || snap.Session == nil || snap.Session.Raining > 0 {
+		return nil
+	}
+	now := snap.Session.CurrentEventTime
+	horizon := now + d.Thresholds.RainWarnMinutes*60
+	p, _ := snap.player()
+	var out []Event
+	for _, n := range snap.Forecast.Nodes {
+		if n.StartTime <= now || n.StartTime > horizon || n.RainChance < d.Thresholds.RainChance || d.rainNodes[n.StartTime] {
+			continue
+		}
+		d.rainNodes[n.StartTime] = true
+		e := newEvent(RainSoon, snap, p)
+		e.Value = (n.StartTime - now) / 60
+		e.Detail = fmt.Sprintf("%.0f%%", n.RainChance)
+		out = append(out, e)
+	}
+	return out
+}
@@ -0,0 +1,102 @@
+// Package events turns successive snapshots of the watch endpoints into
+// discrete race events (pit window open, rival pitted, rain coming, fuel
+// critical) for announcers, notifiers and loggers.
+package events
+
+import (
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/strategy"
+	"github.com/snipem/go-lmu-api/lib/timesync"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// Kind identifies the type of an event.
+type Kind string
+
+const (
+	PitWindowOpen  Kind = "pit_window_open"
+	FuelLow        Kind = "fuel_low"
+	FuelCritical   Kind = "fuel_critical"
+	RivalPitted    Kind = "rival_pitted"
+	RainSoon       Kind = "rain_soon"
+	PositionGained Kind = "position_gained"
+	PositionLost   Kind = "position_lost"
+	PersonalBest   Kind = "personal_best"
+	Alert          Kind = "alert"
+)
+
+// Event is one detected occurrence. Value carries the kind's main number:
+// laps left for fuel events, minutes to rain, the new class position.
+type Event struct {
+	Kind        Kind      `json:"kind"`
+	At          time.Time `json:"at"`
+	SessionTime float64   `json:"sessionTime"`
+	SlotID      int       `json:"slotID"`
+	Lap         int       `json:"lap,omitempty"` // the lap the car is on; the leader's for session-wide events
+	CarNumber   string    `json:"carNumber,omitempty"`
+	Driver      string    `json:"driver,omitempty"`
+	CarClass    string    `json:"carClass,omitempty"`
+	Value       float64   `json:"value,omitempty"`
+	Detail      string    `json:"detail,omitempty"`
+	Rule        string    `json:"rule,omitempty"`     // Alert: the rule's name
+	Priority    string    `json:"priority,omitempty"` // Alert: the rule's priority
+	Involved    []string  `json:"involved,omitempty"` // Incident: numbers of the cars close by; position changes: the car passed or passing
+}
+
+// Snapshot is everything the detector looks at for one tick. Only Standings
+// is required; the other sections enable their rules when present.
+type Snapshot struct {
+	At        time.Time
+	Standings []lib.RestWatchStandingsResponseItem
+	Session   *lib.RestWatchSessionInfoResponse
+	Tank      *strategy.Tank  // the player car's fuel or energy
+	Wheels    *lib.WheelTemps // the player car's brake and tyre temperatures
+	Forecast  *lib.Forecast
+	History   map[string][]lib.RestWatchStandingsHistoryResponseItemItem // keyed by SlotID as the API returns it
+	Meta      watch.FrameMeta                                            // zero when the producer does not number its frames
+	// Clock fits the session clock to the wall clock from the session info
+	// polls. When set, events get the session time at At from it rather
+	// than Session's currentEventTime, which the game only updates now and
+	// then.
+	Clock *timesync.Clock
+	// Errors holds the sections that failed to load this tick, by name
+	// (see Collector). A failed section is nil or, while the collector's
+	// budget lasts, the value of the last tick that had it.
+	Errors map[string]error
+}
+
+// sessionTime returns the snapshot's session clock, or 0 without session info.
+func (s Snapshot) sessionTime() float64 {
+	if t, ok := s.Clock.SessionTime(s.At); ok {
+		return t
+	}
+	if s.Session == nil {
+		return 0
+	}
+	return s.Session.CurrentEventTime
+}
+
+func (s Snapshot) player() (lib.RestWatchStandingsResponseItem, bool) {
+	for _, c := range s.Standings {
+		if c.Player {
+			return c, true
+		}
+	}
+	return lib.RestWatchStandingsResponseItem{}, false
+}
+
+// newEvent fills the car fields of an event from a standings entry.
+func newEvent(kind Kind, snap Snapshot, car lib.RestWatchStandingsResponseItem) Event {
+	return Event{
+		Kind:        kind,
+		At:          snap.At,
+		SessionTime: snap.sessionTime(),
+		SlotID:      int(car.SlotID),
+		Lap:         int(car.LapsCompleted) + 1,
+		CarNumber:   car.CarNumber,
+		Driver:      car.DriverName,
+		CarClass:    car.CarClass,
+	}
+}
@@ -0,0 +1,161 @@
+package events
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// Incident is a likely off, spin or contact. Value: the speed in km/h before
+// a stop, or the positions lost in one sector. Involved lists the car numbers
+// of the cars close by on track.
+const Incident Kind = "incident"
+
+// IncidentThresholds tune the incident heuristics.
+type IncidentThresholds struct {
+	FromSpeed    float64 // km/h a car must have been doing before a stop
+	StopSpeed    float64 // km/h below which a car counts as stopped
+	PositionLoss int     // positions lost within one sector, in races
+	Nearby       float64 // metres around the car in which others count as involved
+}
+
+// DefaultIncidentThresholds catch offs and spins at a 1s poll interval
+// without flagging slow corners.
+var DefaultIncidentThresholds = IncidentThresholds{
+	FromSpeed:    80,
+	StopSpeed:    10,
+	PositionLoss: 3,
+	Nearby:       100,
+}
+
+// Incidents detects likely incidents from speed and position anomalies: a
+// car on track dropping from speed to a near standstill between two frames,
+// and, in races, a car losing several positions within one sector without
+// pitting. The API reports neither contact nor damage events for other
+// cars, so these are heuristics: a stop can be a mechanical failure and a
+// position loss a very slow sector; treat the events as notes to review, not
+// rulings. Cars in the pit lane and the garage, and frames outside the green
+// phase, are ignored.
+type Incidents struct {
+	Thresholds IncidentThresholds
+
+	slots watch.SlotTracker
+	cars  map[int]*incidentCar
+	prev  map[int]lib.RestWatchStandingsResponseItem
+}
+
+type incidentCar struct {
+	sector   string
+	position float64 // at the start of the sector
+	pitted   bool    // pitted during the sector
+	stopped  bool    // reported stopped, until it moves again
+}
+
+func NewIncidents() *Incidents {
+	return &Incidents{Thresholds: DefaultIncidentThresholds, cars: map[int]*incidentCar{}}
+}
+
+// Process returns the incidents since the previous snapshot. The first
+// snapshot of a session only primes the detector.
+func (d *Incidents) Process(snap Snapshot) []Event {
+	c := d.slots.Update(snap.Standings, snap.Session)
+	if c.NewSession {
+		d.cars, d.prev = map[int]*incidentCar{}, nil
+	} else if c.Reshuffled {
+		d.cars = watch.Remap(d.cars, c)
+		d.prev = watch.Remap(d.prev, c)
+	}
+	prev := d.prev
+	d.prev = bySlot(snap.Standings)
+	if prev == nil || snap.Session == nil || lib.PhaseOf(snap.Session) != lib.PhaseGreen {
+		return nil
+	}
+	race := strings.Contains(strings.ToUpper(snap.Session.Session), "RACE")
+	th := d.Thresholds
+
+	var out []Event
+	for _, s := range snap.Standings {
+		slot := int(s.SlotID)
+		car, ok := d.cars[slot]
+		if !ok {
+			car = &incidentCar{sector: s.Sector, position: s.Position}
+			d.cars[slot] = car
+		}
+		old, seen := prev[slot]
+		onTrack := !s.Pitting && !s.InGarageStall && (s.PitState == "" || s.PitState == "NONE") &&
+			(s.FinishStatus == "" || s.FinishStatus == "FSTAT_NONE" || s.FinishStatus == "NONE")
+		if !onTrack {
+			car.pitted = true
+		}
+
+		speed := s.CarVelocity.Velocity * 3.6
+		if car.stopped && speed > th.FromSpeed/2 {
+			car.stopped = false
+		}
+		if seen && onTrack && !car.stopped {
+			before := old.CarVelocity.Velocity * 3.6
+			if before >= th.FromSpeed && speed <= th.StopSpeed {
+				car.stopped = true
+				e := newEvent(Incident, snap, s)
+				e.Value = math.Round(before)
+				e.Detail = "stopped on track"
+				out = append(out, d.involve(e, s, snap))
+			}
+		}
+
+		if s.Sector != car.sector {
+			lost := int(s.Position - car.position)
+			if race && seen && !car.pitted && onTrack && th.PositionLoss > 0 && lost >= th.PositionLoss {
+				e := newEvent(Incident, snap, s)
+				e.Value = float64(lost)
+				e.Detail = fmt.Sprintf("lost %d positions in %s", lost, sectorName(car.sector))
+				out = append(out, d.involve(e, s, snap))
+			}
+			car.sector, car.position, car.pitted = s.Sector, s.Position, !onTrack
+		}
+	}
+	return out
+}
+
+// involve adds the cars within the nearby distance of s to e.
+func (d *Incidents) involve(e Event, s lib.RestWatchStandingsResponseItem, snap Snapshot) Event {
+	length := snap.Session.LapDistance
+	type near struct {
+		number string
+		dist   float64
+	}
+	var cars []near
+	for _, o := range snap.Standings {
+		if o.SlotID == s.SlotID || o.Pitting || o.InGarageStall {
+			continue
+		}
+		dist := math.Abs(o.LapDistance - s.LapDistance)
+		if length > 0 {
+			dist = math.Min(dist, length-dist)
+		}
+		if dist <= d.Thresholds.Nearby {
+			cars = append(cars, near{o.CarNumber, dist})
+		}
+	}
+	sort.Slice(cars, func(i, j int) bool { return cars[i].dist < cars[j].dist })
+	for _, c := range cars {
+		e.Involved = append(e.Involved, c.number)
+	}
+	return e
+}
+
+func sectorName(sector string) string {
+	switch sector {
+	case "SECTOR1":
+		return "sector 1"
+	case "SECTOR2":
+		return "sector 2"
+	case "SECTOR3", "SECTOR0":
+		return "sector 3"
+	}
+	return "one sector"
+}
@@ -0,0 +1,113 @@
+package events
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/timesync"
+)
+
+// Log is an append-only event log in JSON Lines that every tool detecting
+// events writes to, so the engineer, the standings, the ticker and the
+// recorder leave one trail of what they saw and when, e.g. for settling a
+// dispute after the race. Each line is a LogEntry. Several processes can
+// share the file: lines are appended with one write each and never
+// rewritten. A nil *Log discards everything.
+type Log struct {
+	mu     sync.Mutex
+	f      *os.File
+	source string
+	clock  *timesync.Clock
+}
+
+// LogEntry is one line of a Log: the event with the tool that detected it.
+// The event's At is the wall-clock time and its SessionTime the session
+// clock when it was detected, from the tool's timesync.Clock when it has
+// one (see Snapshot.Clock).
+type LogEntry struct {
+	Source string `json:"source"`
+	Event
+}
+
+// DefaultLogPath is the event log next to the user config file.
+func DefaultLogPath() string {
+	return filepath.Join(filepath.Dir(config.DefaultPath()), "events.log")
+}
+
+// AddLogFlag registers -events-log on fs, defaulting to $LMU_EVENTS_LOG or
+// DefaultLogPath. An empty path turns the log off.
+func AddLogFlag(fs *flag.FlagSet) *string {
+	def := os.Getenv("LMU_EVENTS_LOG")
+	if def == "" {
+		def = DefaultLogPath()
+	}
+	return fs.String("events-log", def, `Append every detected event to this shared JSON Lines log ("" = off)`)
+}
+
+// OpenLog opens the log at path for appending, creating it if need be, and
+// names the entries' source. An empty path returns a nil *Log.
+func OpenLog(path, source string) (*Log, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &Log{f: f, source: source}, nil
+}
+
+// Sync makes Write take session times from c, the clock the tool fits
+// from its session info polls.
+func (l *Log) Sync(c *timesync.Clock) {
+	if l != nil {
+		l.mu.Lock()
+		l.clock = c
+		l.mu.Unlock()
+	}
+}
+
+// Write appends evs. Events without a time are stamped now, and with
+// Sync those without a session time too.
+func (l *Log) Write(evs ...Event) error {
+	if l == nil || len(evs) == 0 {
+		return nil
+	}
+	l.mu.Lock()
+	clock := l.clock
+	l.mu.Unlock()
+	var buf []byte
+	for _, e := range evs {
+		if e.At.IsZero() {
+			e.At = time.Now()
+		}
+		if t, ok := clock.SessionTime(e.At); ok && e.SessionTime == 0 {
+			e.SessionTime = t
+		}
+		line, err := json.Marshal(LogEntry{Source: l.source, Event: e})
+		if err != nil {
+			return err
+		}
+		buf = append(append(buf, line...), '\n')
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err := l.f.Write(buf)
+	return err
+}
+
+// Close closes the file.
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+	return l.f.Close()
+}
@@ -0,0 +1,66 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/snipem/go-lmu-api/lib/config"
+)
+
+// PitSpeeding is the player car going faster than the pit speed limit in
+// the pit lane, which the game penalizes. Value: the speed in km/h; Detail:
+// the limit, e.g. "60 km/h".
+const PitSpeeding Kind = "pit_speeding"
+
+// DefaultPitSpeedLimit is the pit lane limit at most WEC tracks, in km/h.
+const DefaultPitSpeedLimit = 60
+
+// PitLane watches the player's speed in the pit lane. It warns once per
+// pit lane visit, as soon as a frame shows the car over the limit, so the
+// driver can lift before the penalty.
+type PitLane struct {
+	// Limit is the pit speed limit in km/h.
+	Limit float64
+	// Tolerance is how far above the limit a car may go before a warning.
+	Tolerance float64
+
+	over   bool
+	warned bool
+}
+
+// NewPitLane uses override, the server's pit speed override, when it is set
+// and the configured track limit otherwise.
+func NewPitLane(cfg config.PitLane, override float64) *PitLane {
+	p := &PitLane{Limit: cfg.SpeedLimit, Tolerance: cfg.Tolerance}
+	if override > 0 {
+		p.Limit = override
+	}
+	if p.Limit <= 0 {
+		p.Limit = DefaultPitSpeedLimit
+	}
+	return p
+}
+
+// Over reports whether the player was over the limit in the pit lane in
+// the last snapshot.
+func (p *PitLane) Over() bool {
+	return p.over
+}
+
+// Process returns a PitSpeeding event when the player first exceeds the
+// limit during a pit lane visit.
+func (p *PitLane) Process(snap Snapshot) []Event {
+	car, ok := snap.player()
+	if !ok || !car.Pitting || car.InGarageStall {
+		p.over, p.warned = false, false
+		return nil
+	}
+	speed := car.CarVelocity.Velocity * 3.6
+	p.over = speed > p.Limit+p.Tolerance
+	if !p.over || p.warned {
+		return nil
+	}
+	p.warned = true
+	e := newEvent(PitSpeeding, snap, car)
+	e.Value, e.Detail = speed, fmt.Sprintf("%.0f km/h", p.Limit)
+	return []Event{e}
+}
@@ -0,0 +1,76 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/vcr"
+)
+
+// testdata/race.jsonl is three polls of a race seen from the player's car
+// while spectating the garage screens: the rival ahead pits, the player
+// passes it and sets a personal best.
+func TestReplayRace(t *testing.T) {
+	rec, err := vcr.Open("testdata/race.jsonl", vcr.Replay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rec.Close()
+	client := lib.NewClient("http://localhost:6397")
+	client.HTTPClient = rec.Client()
+
+	col := NewCollector(client)
+	col.ForecastEvery = 0
+	det := NewDetector()
+	type seen struct {
+		Kind     Kind
+		Car      string
+		Value    float64
+		Detail   string
+		Involved string
+	}
+	var got [][]seen
+	start := time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		snap, err := col.Collect(start.Add(time.Duration(i) * 4 * time.Second))
+		if err != nil {
+			t.Fatalf("tick %d: %v", i, err)
+		}
+		if len(snap.Standings) != 4 || snap.Session == nil || snap.History == nil {
+			t.Fatalf("tick %d: incomplete snapshot: %d cars, session %v, history %v", i, len(snap.Standings), snap.Session, snap.History)
+		}
+		if snap.Errors[SectionEnergy] == nil || snap.Errors[SectionWheels] == nil || len(snap.Errors) != 2 {
+			t.Fatalf("tick %d: errors %v, want energy and wheels only", i, snap.Errors)
+		}
+		var evs []seen
+		for _, e := range det.Process(snap) {
+			s := seen{Kind: e.Kind, Car: e.CarNumber, Value: e.Value, Detail: e.Detail}
+			if len(e.Involved) > 0 {
+				s.Involved = e.Involved[0]
+			}
+			evs = append(evs, s)
+		}
+		got = append(got, evs)
+	}
+
+	want := [][]seen{
+		nil,
+		{{Kind: RivalPitted, Car: "50", Value: 2, Detail: "ahead"}},
+		{
+			{Kind: PositionGained, Car: "8", Value: 2, Involved: "50"},
+			{Kind: PersonalBest, Car: "8", Value: 210.6},
+		},
+	}
+	for i := range want {
+		if len(got[i]) != len(want[i]) {
+			t.Errorf("tick %d: events %+v, want %+v", i, got[i], want[i])
+			continue
+		}
+		for j := range want[i] {
+			if got[i][j] != want[i][j] {
+				t.Errorf("tick %d: event %+v, want %+v", i, got[i][j], want[i][j])
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Start sequence events. They are session-wide and carry SlotID -1.
+const (
+	FormationLap Kind = "formation_lap" // the formation lap began
+	StartLights  Kind = "start_lights"  // Value: lights lit; Detail: "3 of 5"
+	// GreenFlag is the race start, Detail "start", or a restart after a full
+	// course yellow or red flag, Detail "restart". At is the estimated
+	// moment the phase changed rather than the frame's time, and Value is
+	// the session time at that moment.
+	GreenFlag Kind = "green_flag"
+)
+
+// Start follows the start sequence of a race: formation lap, start lights
+// and green flag. The API reports the phase, not when it changed, so the
+// green flag is placed between the frame that saw it and the one before:
+// at the end of the lights phase when its remaining time was known, else
+// halfway. Poll quickly around the start (see watch.Pace) for a tight T0.
+type Start struct {
+	prev *Snapshot
+	lit  int
+	// T0 is the wall-clock time of the last race start, zero before one
+	// was seen.
+	T0 time.Time
+}
+
+func NewStart() *Start {
+	return &Start{}
+}
+
+// Process returns the start sequence events since the previous snapshot.
+func (s *Start) Process(snap Snapshot) []Event {
+	if snap.Session == nil {
+		return nil
+	}
+	prev := s.prev
+	s.prev = &snap
+	if prev == nil || prev.Session == nil {
+		return nil
+	}
+	si, psi := snap.Session, prev.Session
+	if si.CurrentEventTime < psi.CurrentEventTime || si.Session != psi.Session {
+		s.lit, s.T0 = 0, time.Time{}
+		return nil
+	}
+	phase, before := lib.PhaseOf(si), lib.PhaseOf(psi)
+
+	var out []Event
+	emit := func(kind Kind, at time.Time, value float64, detail string) {
+		p, _ := snap.player()
+		e := newEvent(kind, snap, p)
+		e.At, e.SlotID, e.Value, e.Detail = at, -1, value, detail
+		e.Lap = 0
+		if leader, ok := leaderOf(snap.Standings); ok {
+			e.Lap = int(leader.LapsCompleted) + 1
+		}
+		out = append(out, e)
+	}
+
+	if phase == lib.PhaseFormation && before != lib.PhaseFormation {
+		emit(FormationLap, snap.At, 0, "")
+	}
+	if lit, total := lib.StartLightsOf(si); lit > s.lit {
+		s.lit = lit
+		emit(StartLights, snap.At, float64(lit), fmt.Sprintf("%d of %d", lit, total))
+	}
+	if phase != lib.PhaseGreen || before == lib.PhaseGreen {
+		return out
+	}
+	s.lit = 0
+	switch before {
+	case lib.PhaseFormation, lib.PhaseCountdown:
+		at, et := greenAt(*prev, snap)
+		s.T0 = at
+		emit(GreenFlag, at, et, "start")
+	case lib.PhaseFullCourse, lib.PhaseStopped:
+		at, et := greenAt(*prev, snap)
+		emit(GreenFlag, at, et, "restart")
+	}
+	return out
+}
+
+// greenAt estimates when the phase turned green between two frames, as
+// wall-clock and session time.
+func greenAt(prev, snap Snapshot) (time.Time, float64) {
+	gap := snap.At.Sub(prev.At)
+	d := gap / 2
+	if r := time.Duration(prev.Session.TimeRemainingInGamePhase * float64(time.Second)); r > 0 && r <= gap {
+		d = r
+	}
+	et := prev.Session.CurrentEventTime + d.Seconds()
+	return prev.At.Add(d), et
+}
@@ -0,0 +1,96 @@
+package events
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+const (
+	Penalty       Kind = "penalty"        // Value: penalties outstanding after it was issued
+	PenaltyServed Kind = "penalty_served" // Value: penalties still outstanding
+	PitInfraction Kind = "pit_infraction" // a stop outside the regulated pit window
+)
+
+// Stewards reports the race control side of every car for the record:
+// penalties issued and served, from the standings' outstanding penalty
+// count, and in races pit stops made outside the regulated pit window, when
+// one is configured (see config.PitWindow). Stops with a penalty outstanding
+// are taken as serving it.
+type Stewards struct {
+	window config.PitWindow
+
+	slots watch.SlotTracker
+	prev  map[int]lib.RestWatchStandingsResponseItem
+}
+
+func NewStewards(window config.PitWindow) *Stewards {
+	return &Stewards{window: window}
+}
+
+// Process returns the penalties and infractions since the previous
+// snapshot. The first snapshot of a session only primes it.
+func (st *Stewards) Process(snap Snapshot) []Event {
+	c := st.slots.Update(snap.Standings, snap.Session)
+	if c.NewSession {
+		st.prev = nil
+	} else if c.Reshuffled {
+		st.prev = watch.Remap(st.prev, c)
+	}
+	prev := st.prev
+	st.prev = bySlot(snap.Standings)
+	if prev == nil {
+		return nil
+	}
+
+	var out []Event
+	leader, _ := leaderOf(snap.Standings)
+	race := snap.Session != nil && strings.Contains(strings.ToUpper(snap.Session.Session), "RACE")
+	for _, s := range snap.Standings {
+		old, ok := prev[int(s.SlotID)]
+		if !ok {
+			continue
+		}
+		switch {
+		case s.Penalties > old.Penalties:
+			e := newEvent(Penalty, snap, s)
+			e.Value = s.Penalties
+			out = append(out, e)
+		case s.Penalties < old.Penalties:
+			e := newEvent(PenaltyServed, snap, s)
+			e.Value = s.Penalties
+			out = append(out, e)
+		}
+		if race && s.Pitting && !old.Pitting && old.Penalties == 0 { // a stop with a penalty outstanding serves it
+			if why := st.outsideWindow(leader, snap.Session); why != "" {
+				e := newEvent(PitInfraction, snap, s)
+				e.Value = s.LapsCompleted + 1
+				e.Detail = why
+				out = append(out, e)
+			}
+		}
+	}
+	return out
+}
+
+// outsideWindow tells why a stop now is outside the regulated window, or
+// returns "" when it is inside or no window is configured.
+func (st *Stewards) outsideWindow(leader lib.RestWatchStandingsResponseItem, si *lib.RestWatchSessionInfoResponse) string {
+	w := st.window
+	openAt, closeAt := time.Duration(w.Open).Seconds(), time.Duration(w.Close).Seconds()
+	switch {
+	case w.OpenLap > 0 && leader.LapsCompleted < float64(w.OpenLap):
+		return fmt.Sprintf("pit window opens on lap %d", w.OpenLap)
+	case w.CloseLap > 0 && leader.LapsCompleted >= float64(w.CloseLap):
+		return fmt.Sprintf("pit window closed on lap %d", w.CloseLap)
+	case openAt > 0 && si.CurrentEventTime < openAt:
+		return fmt.Sprintf("pit window opens at %s", time.Duration(w.Open))
+	case closeAt > 0 && si.CurrentEventTime >= closeAt:
+		return fmt.Sprintf("pit window closed at %s", time.Duration(w.Close))
+	}
+	return ""
+}
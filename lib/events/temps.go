@@ -0,0 +1,138 @@
+package events
+
+import (
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/config"
+)
+
+// Temperature warnings for the player car. Value: the temperature in °C;
+// Detail: the wheel, e.g. "FL".
+const (
+	BrakesHot Kind = "brakes_hot"
+	TyresCold Kind = "tyres_cold"
+	TyresHot  Kind = "tyres_hot"
+)
+
+// DefaultTempLimits are the warning limits by class name. Carbon brakes of
+// the prototypes run far hotter than the GT cars' steel ones.
+var DefaultTempLimits = map[string]config.TempLimits{
+	"Hypercar": {BrakeMax: 1000, TyreMin: 70, TyreMax: 110},
+	"LMP2":     {BrakeMax: 950, TyreMin: 70, TyreMax: 110},
+	"LMP3":     {BrakeMax: 800, TyreMin: 70, TyreMax: 105},
+	"LMGTE":    {BrakeMax: 800, TyreMin: 75, TyreMax: 110},
+	"LMGT3":    {BrakeMax: 750, TyreMin: 75, TyreMax: 110},
+}
+
+// defaultSustain is how long a temperature must stay out of range.
+const defaultSustain = 5 * time.Second
+
+// coldGrace is how long after leaving the pits cold tyres are expected.
+const coldGrace = 90 * time.Second
+
+// Temps warns when the player's brakes overheat or a tyre stays outside its
+// temperature window. Each wheel warns once per excursion: the reading must
+// stay out of range for the class's Sustain and return before the next
+// warning. It needs the snapshot's Wheels.
+type Temps struct {
+	limits map[string]config.TempLimits
+
+	since  map[string]time.Time // first out-of-range reading by kind and wheel
+	warned map[string]bool
+	outAt  time.Time // when the player last left the pits
+	pit    bool
+}
+
+// NewTemps applies the overrides, keyed by class name or alias, on top of
+// DefaultTempLimits.
+func NewTemps(overrides map[string]config.TempLimits) *Temps {
+	t := &Temps{limits: map[string]config.TempLimits{}, since: map[string]time.Time{}, warned: map[string]bool{}}
+	for k, v := range DefaultTempLimits {
+		t.limits[k] = v
+	}
+	for k, o := range overrides {
+		name := carclass.Lookup(k).Name
+		l := t.limits[name]
+		if o.BrakeMax > 0 {
+			l.BrakeMax = o.BrakeMax
+		}
+		if o.TyreMin > 0 {
+			l.TyreMin = o.TyreMin
+		}
+		if o.TyreMax > 0 {
+			l.TyreMax = o.TyreMax
+		}
+		if o.Sustain > 0 {
+			l.Sustain = o.Sustain
+		}
+		t.limits[name] = l
+	}
+	return t
+}
+
+// Limits returns the limits for a class, falling back to the GT3 ones for
+// classes without defaults.
+func (t *Temps) Limits(class string) config.TempLimits {
+	l, ok := t.limits[carclass.Lookup(class).Name]
+	if !ok {
+		l = t.limits["LMGT3"]
+	}
+	if l.Sustain <= 0 {
+		l.Sustain = config.Duration(defaultSustain)
+	}
+	return l
+}
+
+// Process returns the warnings that became due with this snapshot.
+func (t *Temps) Process(snap Snapshot) []Event {
+	car, ok := snap.player()
+	if !ok || snap.Wheels == nil {
+		return nil
+	}
+	if car.Pitting || car.InGarageStall {
+		t.pit = true
+		return nil
+	}
+	if t.pit || t.outAt.IsZero() {
+		t.pit, t.outAt = false, snap.At
+	}
+	l := t.Limits(car.CarClass)
+
+	var out []Event
+	check := func(kind Kind, wheel string, v float64, beyond bool) {
+		key := string(kind) + wheel
+		if !beyond {
+			delete(t.since, key)
+			t.warned[key] = false
+			return
+		}
+		first, ok := t.since[key]
+		if !ok {
+			t.since[key] = snap.At
+			first = snap.At
+		}
+		if t.warned[key] || snap.At.Sub(first) < time.Duration(l.Sustain) {
+			return
+		}
+		t.warned[key] = true
+		e := newEvent(kind, snap, car)
+		e.Value, e.Detail = v, wheel
+		out = append(out, e)
+	}
+	for i, v := range snap.Wheels.Brake {
+		if i < len(lib.Wheels) && v > 0 {
+			check(BrakesHot, lib.Wheels[i], v, l.BrakeMax > 0 && v > l.BrakeMax)
+		}
+	}
+	warmedUp := snap.At.Sub(t.outAt) >= coldGrace
+	for i, v := range snap.Wheels.Tyre {
+		if i >= len(lib.Wheels) || v <= 0 {
+			continue
+		}
+		check(TyresHot, lib.Wheels[i], v, l.TyreMax > 0 && v > l.TyreMax)
+		check(TyresCold, lib.Wheels[i], v, warmedUp && l.TyreMin > 0 && v < l.TyreMin)
+	}
+	return out
+}
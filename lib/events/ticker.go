@@ -0,0 +1,142 @@
+package events
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/snipem/go-lmu-api/lib/locale"
+)
+
+// TickerTemplates are the built-in ticker lines by event kind, written for
+// spectators rather than the driver. They are text/template strings
+// executed with the Event.
+var TickerTemplates = map[Kind]string{
+	PitWindowOpen:      `Pit window open{{with .Detail}}, {{.}}{{end}}.`,
+	PitWindowClose:     `Pit window closed.`,
+	FuelLow:            `#{{.CarNumber}} {{.Driver}} has {{laps .Value}} of {{.Detail}} left.`,
+	FuelCritical:       `#{{.CarNumber}} {{.Driver}} is critical on {{.Detail}}, {{laps .Value}} left.`,
+	RivalPitted:        `#{{.CarNumber}} {{.Driver}} comes into the pits.`,
+	RainSoon:           `Rain expected in {{printf "%.0f" .Value}} minutes ({{.Detail}} chance).`,
+	PositionGained:     `#{{.CarNumber}} takes P{{printf "%.0f" .Value}} in {{.CarClass}}{{with .Involved}} from #{{index . 0}}{{end}}.`,
+	PositionLost:       `#{{.CarNumber}} drops to P{{printf "%.0f" .Value}} in {{.CarClass}}{{with .Involved}}, passed by #{{index . 0}}{{end}}.`,
+	PersonalBest:       `#{{.CarNumber}} {{.Driver}} sets a personal best, {{laptime .Value}}.`,
+	Alert:              `{{.Detail}}`,
+	TimeToGo:           `{{if eq .Value 1.0}}One minute{{else}}{{printf "%.0f" .Value}} minutes{{end}} to go.`,
+	LapsToGo:           `{{if eq .Value 1.0}}Final lap.{{else}}{{printf "%.0f" .Value}} laps to go.{{end}}`,
+	TrackLimitsWarning: `Track limits warning for #{{.CarNumber}} {{.Driver}} ({{.Detail}}).`,
+	Incident:           `Incident: #{{.CarNumber}} {{.Driver}} {{.Detail}}{{with .Involved}}, #{{join . ", #"}} close by{{end}}.`,
+	Penalty:            `Penalty for #{{.CarNumber}} {{.Driver}}.`,
+	PenaltyServed:      `#{{.CarNumber}} {{.Driver}} serves a penalty.`,
+	PitInfraction:      `#{{.CarNumber}} {{.Driver}} pits outside the window: {{.Detail}}.`,
+	FormationLap:       `The formation lap is under way.`,
+	StartLights:        `Lights: {{.Detail}}.`,
+	GreenFlag:          `{{if eq .Detail "restart"}}Green flag, racing resumes.{{else}}Green flag, the race is on!{{end}}`,
+	TyreCrossover:      `Tyre crossover in {{.CarClass}}: {{.Detail}} {{if eq .Value 0.0}}are now faster{{else}}faster in ~{{laps .Value}}{{end}}.`,
+}
+
+// tickerTranslations are TickerTemplates in other languages. Event details
+// written by the detectors, such as an incident's description, stay in
+// English.
+var tickerTranslations = map[string]map[Kind]string{
+	"de": {
+		PitWindowOpen:      `Boxenfenster offen.`,
+		PitWindowClose:     `Boxenfenster geschlossen.`,
+		FuelLow:            `#{{.CarNumber}} {{.Driver}} hat noch {{laps .Value}} {{if eq .Detail "energy"}}Energie{{else}}Sprit{{end}}.`,
+		FuelCritical:       `#{{.CarNumber}} {{.Driver}}: {{if eq .Detail "energy"}}Energie{{else}}Sprit{{end}} kritisch, noch {{laps .Value}}.`,
+		RivalPitted:        `#{{.CarNumber}} {{.Driver}} kommt an die Box.`,
+		RainSoon:           `Regen in {{printf "%.0f" .Value}} Minuten erwartet ({{.Detail}} Wahrscheinlichkeit).`,
+		PositionGained:     `#{{.CarNumber}} übernimmt P{{printf "%.0f" .Value}} in der {{.CarClass}}{{with .Involved}} von #{{index . 0}}{{end}}.`,
+		PositionLost:       `#{{.CarNumber}} fällt auf P{{printf "%.0f" .Value}} in der {{.CarClass}} zurück{{with .Involved}}, überholt von #{{index . 0}}{{end}}.`,
+		PersonalBest:       `#{{.CarNumber}} {{.Driver}} mit persönlicher Bestzeit, {{laptime .Value}}.`,
+		Alert:              `{{.Detail}}`,
+		TimeToGo:           `Noch {{if eq .Value 1.0}}eine Minute{{else}}{{printf "%.0f" .Value}} Minuten{{end}}.`,
+		LapsToGo:           `{{if eq .Value 1.0}}Letzte Runde.{{else}}Noch {{printf "%.0f" .Value}} Runden.{{end}}`,
+		TrackLimitsWarning: `Track-Limits-Verwarnung für #{{.CarNumber}} {{.Driver}} ({{.Detail}}).`,
+		Incident:           `Zwischenfall: #{{.CarNumber}} {{.Driver}} {{.Detail}}{{with .Involved}}, #{{join . ", #"}} in der Nähe{{end}}.`,
+		Penalty:            `Strafe für #{{.CarNumber}} {{.Driver}}.`,
+		PenaltyServed:      `#{{.CarNumber}} {{.Driver}} leistet eine Strafe ab.`,
+		PitInfraction:      `#{{.CarNumber}} {{.Driver}} stoppt außerhalb des Boxenfensters: {{.Detail}}.`,
+		FormationLap:       `Die Formationsrunde läuft.`,
+		StartLights:        `Startampel: {{.Detail}}.`,
+		GreenFlag:          `{{if eq .Detail "restart"}}Grüne Flagge, das Rennen geht weiter.{{else}}Grüne Flagge, das Rennen läuft!{{end}}`,
+		TyreCrossover:      `Reifenwechsel-Punkt in der {{.CarClass}}: {{if eq .Detail "wets"}}Regenreifen{{else}}Slicks{{end}} {{if eq .Value 0.0}}sind jetzt schneller{{else}}in ~{{laps .Value}} schneller{{end}}.`,
+	},
+	"fr": {
+		PitWindowOpen:      `Fenêtre de ravitaillement ouverte.`,
+		PitWindowClose:     `Fenêtre de ravitaillement fermée.`,
+		FuelLow:            `#{{.CarNumber}} {{.Driver}} : encore {{laps .Value}} {{if eq .Detail "energy"}}d'énergie{{else}}de carburant{{end}}.`,
+		FuelCritical:       `#{{.CarNumber}} {{.Driver}} : {{if eq .Detail "energy"}}énergie{{else}}carburant{{end}} critique, {{laps .Value}} restant.`,
+		RivalPitted:        `#{{.CarNumber}} {{.Driver}} rentre aux stands.`,
+		RainSoon:           `Pluie attendue dans {{printf "%.0f" .Value}} minutes ({{.Detail}} de probabilité).`,
+		PositionGained:     `#{{.CarNumber}} prend la P{{printf "%.0f" .Value}} en {{.CarClass}}{{with .Involved}} à la #{{index . 0}}{{end}}.`,
+		PositionLost:       `#{{.CarNumber}} recule en P{{printf "%.0f" .Value}} en {{.CarClass}}{{with .Involved}}, dépassée par la #{{index . 0}}{{end}}.`,
+		PersonalBest:       `#{{.CarNumber}} {{.Driver}} signe son meilleur tour, {{laptime .Value}}.`,
+		Alert:              `{{.Detail}}`,
+		TimeToGo:           `Plus que {{if eq .Value 1.0}}une minute{{else}}{{printf "%.0f" .Value}} minutes{{end}}.`,
+		LapsToGo:           `{{if eq .Value 1.0}}Dernier tour.{{else}}Plus que {{printf "%.0f" .Value}} tours.{{end}}`,
+		TrackLimitsWarning: `Avertissement limites de piste pour #{{.CarNumber}} {{.Driver}} ({{.Detail}}).`,
+		Incident:           `Incident : #{{.CarNumber}} {{.Driver}} {{.Detail}}{{with .Involved}}, #{{join . ", #"}} à proximité{{end}}.`,
+		Penalty:            `Pénalité pour #{{.CarNumber}} {{.Driver}}.`,
+		PenaltyServed:      `#{{.CarNumber}} {{.Driver}} purge une pénalité.`,
+		PitInfraction:      `#{{.CarNumber}} {{.Driver}} s'arrête hors de la fenêtre : {{.Detail}}.`,
+		FormationLap:       `Le tour de formation est lancé.`,
+		StartLights:        `Feux : {{.Detail}}.`,
+		GreenFlag:          `{{if eq .Detail "restart"}}Drapeau vert, la course reprend.{{else}}Drapeau vert, la course est lancée !{{end}}`,
+		TyreCrossover:      `Croisement des pneus en {{.CarClass}} : {{if eq .Detail "wets"}}les pneus pluie{{else}}les slicks{{end}} {{if eq .Value 0.0}}sont désormais plus rapides{{else}}plus rapides dans ~{{laps .Value}}{{end}}.`,
+	},
+}
+
+// Ticker renders events as one-line live ticker text, e.g.
+// "LAP 34: #8 takes P2 in Hypercar from #7.", for overlays, chat bots and
+// live blogs.
+type Ticker struct {
+	locale    locale.Locale
+	templates map[Kind]*template.Template
+}
+
+// NewTicker compiles the built-in templates in the locale's language plus
+// any overrides keyed by event kind. An empty override silences the kind.
+func NewTicker(loc locale.Locale, overrides map[string]string) (*Ticker, error) {
+	src := map[Kind]string{}
+	for k, v := range TickerTemplates {
+		src[k] = v
+	}
+	for k, v := range tickerTranslations[loc.Lang] {
+		src[k] = v
+	}
+	for k, v := range overrides {
+		src[Kind(k)] = v
+	}
+	t := &Ticker{locale: loc, templates: map[Kind]*template.Template{}}
+	funcs := template.FuncMap{"laps": loc.Laps, "laptime": loc.LapTime, "join": strings.Join}
+	for k, v := range src {
+		if v == "" {
+			continue
+		}
+		tmpl, err := template.New(string(k)).Funcs(funcs).Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("ticker template %s: %w", k, err)
+		}
+		t.templates[k] = tmpl
+	}
+	return t, nil
+}
+
+// Line renders an event, prefixed with its lap when known. It returns ""
+// for kinds without a template.
+func (t *Ticker) Line(e Event) (string, error) {
+	tmpl, ok := t.templates[e.Kind]
+	if !ok {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	if e.Lap > 0 {
+		buf.WriteString(t.locale.T("LAP %d: ", e.Lap))
+	}
+	if err := tmpl.Execute(&buf, e); err != nil {
+		return "", fmt.Errorf("ticker template %s: %w", e.Kind, err)
+	}
+	return strings.Join(strings.Fields(buf.String()), " "), nil
+}
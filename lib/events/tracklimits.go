@@ -0,0 +1,86 @@
+package events
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/snipem/go-lmu-api/lib/analysis"
+	"github.com/snipem/go-lmu-api/lib/config"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// TrackLimitsWarning is a new track limits warning for a car. Value: the
+// car's warnings so far; Detail counts them against the allowance.
+const TrackLimitsWarning Kind = "track_limits"
+
+// TrackLimits counts track limits warnings per car. The API exposes the
+// session's cuts allowed setting but no per-driver counter, so a warning is
+// a lap lib/analysis takes to be a cut (see analysis.Validity) in the
+// snapshot's History.
+type TrackLimits struct {
+	// Allowed is the number of warnings before a penalty, 0 when unknown.
+	Allowed int
+	// WarnWithin is how many warnings before the penalty a car counts as
+	// near it.
+	WarnWithin int
+
+	slots  watch.SlotTracker
+	counts map[int]int
+	primed bool
+}
+
+func NewTrackLimits(cfg config.TrackLimits) *TrackLimits {
+	t := &TrackLimits{Allowed: cfg.Allowed, WarnWithin: cfg.WarnWithin, counts: map[int]int{}}
+	if t.WarnWithin <= 0 {
+		t.WarnWithin = 1
+	}
+	return t
+}
+
+// Count returns a slot's warnings in the current session.
+func (t *TrackLimits) Count(slot int) int {
+	return t.counts[slot]
+}
+
+// Near reports whether a count is within WarnWithin of the penalty, or at
+// or past it.
+func (t *TrackLimits) Near(count int) bool {
+	return t.Allowed > 0 && count > 0 && count >= t.Allowed-t.WarnWithin
+}
+
+// Process counts the warnings in the snapshot's history and returns an
+// event per new one. Warnings already in the history when the session is
+// first seen are counted without events.
+func (t *TrackLimits) Process(snap Snapshot) []Event {
+	c := t.slots.Update(snap.Standings, snap.Session)
+	if c.NewSession {
+		t.counts, t.primed = map[int]int{}, false
+	} else if c.Reshuffled {
+		t.counts = watch.Remap(t.counts, c)
+	}
+	if snap.History == nil {
+		return nil
+	}
+	primed := t.primed
+	t.primed = true
+
+	var out []Event
+	for _, s := range snap.Standings {
+		slot := int(s.SlotID)
+		n := len(analysis.DefaultValidity.Cuts(analysis.FromHistory(snap.History[strconv.Itoa(slot)])))
+		before := t.counts[slot]
+		t.counts[slot] = n
+		if !primed || n <= before {
+			continue
+		}
+		e := newEvent(TrackLimitsWarning, snap, s)
+		e.Value = float64(n)
+		if t.Allowed > 0 {
+			e.Detail = fmt.Sprintf("%d of %d", n, t.Allowed)
+		} else {
+			e.Detail = strconv.Itoa(n)
+		}
+		out = append(out, e)
+	}
+	return out
+}
@@ -0,0 +1,143 @@
+// Package feed turns live frames into streams for external timing systems:
+// a normalized completed-lap stream with a stable JSON schema, and a
+// broadcaster that fans lines out to TCP clients.
+package feed
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/analysis"
+	"github.com/snipem/go-lmu-api/lib/identity"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// SchemaVersion is the version of the Lap layout. Fields are only added
+// within a version; a removal or change of meaning bumps it.
+const SchemaVersion = 1
+
+// Lap is one completed lap of one car. Times are in seconds, 0 when
+// unknown. Gaps and positions are taken from the standings of the frame in
+// which the lap was seen completed, so they can be up to one poll late.
+type Lap struct {
+	Schema      int       `json:"schema"`
+	Type        string    `json:"type"` // always "lap"
+	At          time.Time `json:"at"`
+	Track       string    `json:"track"`
+	Session     string    `json:"session"`
+	SessionTime float64   `json:"sessionTime"`
+
+	Car    Car    `json:"car"`
+	Driver Driver `json:"driver"`
+
+	Lap     int        `json:"lap"` // 1-based
+	LapTime float64    `json:"lapTime"`
+	Sectors [3]float64 `json:"sectors"` // sector durations
+	Pit     bool       `json:"pit"`     // the lap ended in the pit lane
+
+	Position         int     `json:"position"`
+	ClassPosition    int     `json:"classPosition"`
+	GapToLeader      float64 `json:"gapToLeader"`
+	GapToNext        float64 `json:"gapToNext"`
+	LapsBehindLeader int     `json:"lapsBehindLeader"`
+}
+
+// Car identifies the car.
+type Car struct {
+	Number  string `json:"number"`
+	Class   string `json:"class"`
+	Vehicle string `json:"vehicle"`
+	Team    string `json:"team"`
+	Slot    int    `json:"slot"` // the game's SlotID, only stable within a session
+}
+
+// Driver identifies the driver. ID is a stable identity (see lib/identity).
+type Driver struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// LapDetector finds the laps completed since the previous frame.
+type LapDetector struct {
+	// Backlog emits the laps already in the history on the first frame of a
+	// session; otherwise they are skipped.
+	Backlog bool
+
+	slots watch.SlotTracker
+	seen  map[int]int // history entries already emitted per slot
+	fresh bool        // first frame of the session
+}
+
+func NewLapDetector() *LapDetector {
+	return &LapDetector{seen: make(map[int]int), fresh: true}
+}
+
+// Frame returns the laps completed in this frame. history is keyed by
+// SlotID as the API returns it.
+func (d *LapDetector) Frame(at time.Time, si *lib.RestWatchSessionInfoResponse, standings []lib.RestWatchStandingsResponseItem, history map[string][]lib.RestWatchStandingsHistoryResponseItemItem) []Lap {
+	if si == nil {
+		return nil
+	}
+	if c := d.slots.Update(standings, si); c.NewSession {
+		d.seen, d.fresh = make(map[int]int), true
+	} else if c.Reshuffled {
+		d.seen = watch.Remap(d.seen, c)
+	}
+	fresh := d.fresh
+	d.fresh = false
+
+	classPos := make(map[int]int)
+	perClass := make(map[string]int)
+	for _, s := range standings { // sanitized standings are in position order
+		perClass[s.CarClass]++
+		classPos[int(s.SlotID)] = perClass[s.CarClass]
+	}
+
+	var out []Lap
+	for _, s := range standings {
+		slot := int(s.SlotID)
+		laps := history[strconv.Itoa(slot)]
+		start := d.seen[slot]
+		if fresh && !d.Backlog {
+			start = len(laps)
+		}
+		d.seen[slot] = len(laps)
+		if start >= len(laps) {
+			continue
+		}
+		al := analysis.FromHistory(laps)
+		for i := start; i < len(laps); i++ {
+			pos := int(laps[i].Position)
+			if pos <= 0 {
+				pos = int(s.Position)
+			}
+			out = append(out, Lap{
+				Schema:      SchemaVersion,
+				Type:        "lap",
+				At:          at,
+				Track:       si.TrackName,
+				Session:     si.Session,
+				SessionTime: si.CurrentEventTime,
+				Car: Car{
+					Number:  s.CarNumber,
+					Class:   s.CarClass,
+					Vehicle: s.VehicleName,
+					Team:    s.FullTeamName,
+					Slot:    slot,
+				},
+				Driver:           Driver{Name: identity.NormalizeName(s.DriverName), ID: string(identity.Of(s))},
+				Lap:              i + 1,
+				LapTime:          laps[i].LapTime,
+				Sectors:          [3]float64{al[i].S1, al[i].S2, al[i].S3},
+				Pit:              laps[i].Pitting,
+				Position:         pos,
+				ClassPosition:    classPos[slot],
+				GapToLeader:      s.TimeBehindLeader,
+				GapToNext:        s.TimeBehindNext,
+				LapsBehindLeader: int(s.LapsBehindLeader),
+			})
+		}
+	}
+	return out
+}
@@ -0,0 +1,224 @@
+package feed
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/analysis"
+	"github.com/snipem/go-lmu-api/lib/strategy"
+)
+
+// RMonitor converts frames into the Orbits RMonitor protocol, the CSV-line
+// live timing feed that RaceMonitor, club timing screens and scoreboard apps
+// read from TCP port 50000. Competitors are keyed by car number (the
+// registration number) and the SlotID is sent as the transponder number.
+//
+// Records sent:
+//
+//	$I  init, on a new session, clears the displays
+//	$B  run (session) info
+//	$C  class info
+//	$E  track name and length
+//	$A, $COMP  competitor info, when a car is first seen
+//	$F  heartbeat with laps and time to go and the flag, every frame
+//	$G  race position and laps, when they change
+//	$H  best lap, when it or the position changes
+//	$J  passing, when a car completes a lap
+//
+// It is safe for concurrent use, so Greeting can be served to new clients
+// while frames arrive.
+type RMonitor struct {
+	mu      sync.Mutex
+	session string // track and session of the current run
+	run     int
+	si      *lib.RestWatchSessionInfoResponse
+	classes map[string]int
+	cars    map[string]*rmCar
+}
+
+type rmCar struct {
+	s       lib.RestWatchStandingsResponseItem
+	class   int
+	bestLap int // lap number of the best lap, 0 when unknown
+}
+
+func NewRMonitor() *RMonitor {
+	return &RMonitor{classes: make(map[string]int), cars: make(map[string]*rmCar)}
+}
+
+// Frame returns the records for one frame.
+func (r *RMonitor) Frame(at time.Time, si *lib.RestWatchSessionInfoResponse, standings []lib.RestWatchStandingsResponseItem) []byte {
+	if si == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var b bytes.Buffer
+
+	key := si.TrackName + "\x00" + si.Session
+	restarted := r.si != nil && si.CurrentEventTime < r.si.CurrentEventTime
+	r.si = si
+	if key != r.session || restarted {
+		r.session = key
+		r.run++
+		r.classes = make(map[string]int)
+		r.cars = make(map[string]*rmCar)
+		line(&b, "$I", quote(at.Format("15:04:05.000")), quote(at.Format("02 Jan 06")))
+		r.header(&b)
+	}
+
+	for _, s := range standings {
+		reg := regNumber(s)
+		c, ok := r.cars[reg]
+		if !ok {
+			c = &rmCar{class: r.class(&b, s.CarClass)}
+			r.cars[reg] = c
+			r.competitor(&b, s, c)
+			c.s = s
+			r.position(&b, c)
+			continue
+		}
+		prev := c.s
+		c.s = s
+		if s.LapsCompleted > prev.LapsCompleted {
+			if s.LastLapTime > 0 && s.LastLapTime == s.BestLapTime {
+				c.bestLap = int(s.LapsCompleted)
+			}
+			line(&b, "$J", quote(reg), quote(clock(s.LastLapTime)), quote(clock(s.LapStartET)))
+		}
+		if s.Position != prev.Position || s.LapsCompleted != prev.LapsCompleted || s.BestLapTime != prev.BestLapTime {
+			r.position(&b, c)
+		}
+	}
+	r.heartbeat(&b, at, standings)
+	return b.Bytes()
+}
+
+// Greeting returns the current state for a client that just connected: run,
+// classes, track, competitors and positions.
+func (r *RMonitor) Greeting() []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var b bytes.Buffer
+	if r.si == nil {
+		return nil
+	}
+	r.header(&b)
+	names := make([]string, len(r.classes))
+	for name, n := range r.classes {
+		names[n-1] = name
+	}
+	for i, name := range names {
+		line(&b, "$C", fmt.Sprint(i+1), quote(name))
+	}
+	for _, c := range r.cars {
+		r.competitor(&b, c.s, c)
+		r.position(&b, c)
+	}
+	return b.Bytes()
+}
+
+func (r *RMonitor) header(b *bytes.Buffer) {
+	line(b, "$B", fmt.Sprint(r.run), quote(r.si.Session))
+	line(b, "$E", quote("TRACKNAME"), quote(r.si.TrackName))
+	if r.si.LapDistance > 0 {
+		line(b, "$E", quote("TRACKLENGTH"), quote(fmt.Sprintf("%.3f", r.si.LapDistance/1609.344)))
+	}
+}
+
+// class returns the number of a class, announcing new ones.
+func (r *RMonitor) class(b *bytes.Buffer, name string) int {
+	if n, ok := r.classes[name]; ok {
+		return n
+	}
+	n := len(r.classes) + 1
+	r.classes[name] = n
+	line(b, "$C", fmt.Sprint(n), quote(name))
+	return n
+}
+
+func (r *RMonitor) competitor(b *bytes.Buffer, s lib.RestWatchStandingsResponseItem, c *rmCar) {
+	reg := regNumber(s)
+	first, last := splitName(s.DriverName)
+	line(b, "$A", quote(reg), quote(s.CarNumber), fmt.Sprint(int(s.SlotID)), quote(first), quote(last), quote(""), fmt.Sprint(c.class))
+	line(b, "$COMP", quote(reg), quote(s.CarNumber), fmt.Sprint(c.class), quote(first), quote(last), quote(""), quote(s.FullTeamName))
+}
+
+func (r *RMonitor) position(b *bytes.Buffer, c *rmCar) {
+	s := c.s
+	reg := regNumber(s)
+	line(b, "$G", fmt.Sprint(int(s.Position)), quote(reg), fmt.Sprint(int(s.LapsCompleted)), quote(clock(s.LapStartET)))
+	line(b, "$H", fmt.Sprint(int(s.Position)), quote(reg), fmt.Sprint(c.bestLap), quote(clock(s.BestLapTime)))
+}
+
+// heartbeat writes the $F record: laps to go (9999 in timed sessions), time
+// to go, time of day, session time and the flag.
+func (r *RMonitor) heartbeat(b *bytes.Buffer, at time.Time, standings []lib.RestWatchStandingsResponseItem) {
+	si := r.si
+	lapsToGo := 9999
+	if si.MaximumLaps > 0 && si.MaximumLaps < 10000 {
+		for _, s := range standings {
+			if s.Position == 1 {
+				lapsToGo = int(strategy.RemainingLaps(si, s.LapsCompleted, 0))
+			}
+		}
+	}
+	toGo := 0.0
+	if si.EndEventTime > 0 {
+		toGo = math.Max(0, si.EndEventTime-si.CurrentEventTime)
+	}
+	line(b, "$F", fmt.Sprint(lapsToGo), quote(clock(toGo)[:8]), quote(at.Format("15:04:05")), quote(clock(si.CurrentEventTime)[:8]), quote(flag(si)))
+}
+
+// flag maps the game phase to the RMonitor flag state.
+func flag(si *lib.RestWatchSessionInfoResponse) string {
+	switch {
+	case lib.PhaseOf(si) == lib.PhaseOver:
+		return "Finish"
+	case lib.PhaseOf(si) == lib.PhaseStopped:
+		return "Red   "
+	case lib.PhaseOf(si) == lib.PhaseFullCourse, analysis.FullCourseYellow(si.YellowFlagState):
+		return "Yellow"
+	case lib.PhaseOf(si) == lib.PhaseGreen:
+		return "Green "
+	}
+	return "      "
+}
+
+func regNumber(s lib.RestWatchStandingsResponseItem) string {
+	if s.CarNumber != "" {
+		return s.CarNumber
+	}
+	return fmt.Sprintf("slot%d", int(s.SlotID))
+}
+
+func splitName(name string) (first, last string) {
+	name = strings.TrimSpace(name)
+	if i := strings.LastIndex(name, " "); i > 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// clock formats seconds as HH:MM:SS.sss.
+func clock(sec float64) string {
+	if sec < 0 {
+		sec = 0
+	}
+	ms := int64(math.Round(sec * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+func quote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "'") + `"`
+}
+
+func line(b *bytes.Buffer, fields ...string) {
+	b.WriteString(strings.Join(fields, ","))
+	b.WriteString("\r\n")
+}
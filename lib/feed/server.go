@@ -0,0 +1,95 @@
+package feed
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// Server fans lines out to every connected TCP client. A client that cannot
+// take a line within WriteTimeout is dropped, so one stuck display does not
+// hold up the others.
+type Server struct {
+	WriteTimeout time.Duration
+
+	ln       net.Listener
+	mu       sync.Mutex
+	clients  map[net.Conn]struct{}
+	greeting func() []byte
+}
+
+// Listen starts accepting clients on addr, e.g. ":5000".
+func Listen(addr string) (*Server, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{WriteTimeout: 2 * time.Second, ln: ln, clients: make(map[net.Conn]struct{})}
+	go s.accept()
+	return s, nil
+}
+
+func (s *Server) accept() {
+	for {
+		c, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		if s.greeting != nil {
+			c.SetWriteDeadline(time.Now().Add(s.WriteTimeout))
+			if _, err := c.Write(s.greeting()); err != nil {
+				c.Close()
+				s.mu.Unlock()
+				continue
+			}
+		}
+		s.clients[c] = struct{}{}
+		s.mu.Unlock()
+	}
+}
+
+// SetGreeting sets a function whose output is sent to each new client
+// before the stream, e.g. the current state of a stateful protocol.
+func (s *Server) SetGreeting(f func() []byte) {
+	s.mu.Lock()
+	s.greeting = f
+	s.mu.Unlock()
+}
+
+// Addr returns the listening address.
+func (s *Server) Addr() net.Addr { return s.ln.Addr() }
+
+// Clients returns the number of connected clients.
+func (s *Server) Clients() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.clients)
+}
+
+// Write sends p to every client. It never fails; clients that do are
+// disconnected.
+func (s *Server) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for c := range s.clients {
+		c.SetWriteDeadline(time.Now().Add(s.WriteTimeout))
+		if _, err := c.Write(p); err != nil {
+			c.Close()
+			delete(s.clients, c)
+		}
+	}
+	return len(p), nil
+}
+
+// Close stops listening and disconnects every client.
+func (s *Server) Close() error {
+	err := s.ln.Close()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for c := range s.clients {
+		c.Close()
+		delete(s.clients, c)
+	}
+	return err
+}
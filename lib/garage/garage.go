@@ -0,0 +1,230 @@
+// Package garage is a typed model of an LMU car setup that can be exported
+// to JSON, loaded back into the game and compared setting by setting.
+package garage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Setting is one setup value. Index is what the game stores and what
+// Apply sends back; Text is the readable value for humans and diffs.
+type Setting struct {
+	Index float64 `json:"index"`
+	Text  string  `json:"text"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+}
+
+// Setup is a complete car setup together with the car and track it was
+// made for.
+type Setup struct {
+	Name     string             `json:"name,omitempty"`
+	Car      string             `json:"car"`
+	Track    string             `json:"track"`
+	Exported time.Time          `json:"exported"`
+	Settings map[string]Setting `json:"settings"`
+}
+
+// Current reads the setup currently loaded in the garage.
+func Current(c *lib.Client) (*Setup, error) {
+	values, err := c.CarSetup()
+	if err != nil {
+		return nil, err
+	}
+	s := &Setup{Exported: time.Now().UTC(), Settings: make(map[string]Setting, len(values))}
+	for key, v := range values {
+		if !v.Available {
+			continue
+		}
+		s.Settings[key] = Setting{Index: v.Value, Text: v.StringValue, Min: v.MinValue, Max: v.MaxValue}
+	}
+	if sum, err := c.RestGarageSummary(); err == nil {
+		s.Name = sum.ActiveSetup
+		s.Car = sum.Car.Name
+		s.Track = sum.Track.Name
+	}
+	return s, nil
+}
+
+// Load reads a setup exported with Save.
+func Load(path string) (*Setup, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s Setup
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &s, nil
+}
+
+// Save writes the setup as indented JSON.
+func (s *Setup) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := s.Encode(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Encode writes the setup as indented JSON to w.
+func (s *Setup) Encode(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// Apply loads s into the garage, changing only the settings that differ
+// from the current setup. It returns the changes that were sent. Settings
+// the current car does not have are skipped, and indexes outside the car's
+// range are an error so a setup for another car is not half applied.
+func Apply(c *lib.Client, s *Setup) ([]Change, error) {
+	cur, err := Current(c)
+	if err != nil {
+		return nil, err
+	}
+	changes := Diff(cur, s)
+	var apply []Change
+	for _, ch := range changes {
+		if ch.From == nil || ch.To == nil {
+			continue
+		}
+		if ch.To.Index < ch.From.Min || ch.To.Index > ch.From.Max {
+			return nil, fmt.Errorf("%s: index %g outside %g..%g, setup is for a different car?", ch.Key, ch.To.Index, ch.From.Min, ch.From.Max)
+		}
+		apply = append(apply, ch)
+	}
+	for i, ch := range apply {
+		if err := c.SetGarageValue(ch.Key, ch.To.Index); err != nil {
+			return apply[:i], fmt.Errorf("set %s: %w", ch.Key, err)
+		}
+	}
+	return apply, nil
+}
+
+// Change is one setting that differs between two setups. From or To is nil
+// when the setting only exists on one side.
+type Change struct {
+	Key      string
+	Category string
+	From     *Setting
+	To       *Setting
+}
+
+// Diff lists the settings that differ between a and b, grouped by category
+// and sorted by key within a category.
+func Diff(a, b *Setup) []Change {
+	var out []Change
+	for key, av := range a.Settings {
+		av := av
+		bv, ok := b.Settings[key]
+		switch {
+		case !ok:
+			out = append(out, Change{Key: key, Category: Category(key), From: &av})
+		case av.Index != bv.Index:
+			out = append(out, Change{Key: key, Category: Category(key), From: &av, To: &bv})
+		}
+	}
+	for key, bv := range b.Settings {
+		bv := bv
+		if _, ok := a.Settings[key]; !ok {
+			out = append(out, Change{Key: key, Category: Category(key), To: &bv})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		ci, cj := categoryOrder(out[i].Category), categoryOrder(out[j].Category)
+		if ci != cj {
+			return ci < cj
+		}
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
+
+// Categories in display order.
+var Categories = []string{"pressures", "geometry", "aero", "suspension", "brakes", "drivetrain", "electronics", "fuel", "other"}
+
+// categoryRules map setting key fragments to categories; the first match wins.
+var categoryRules = []struct {
+	fragment string
+	category string
+}{
+	{"TIRE_PRESSURE", "pressures"},
+	{"PRESSURE_", "pressures"},
+	{"TIRE_COMPOUND", "pressures"},
+	{"CAMBER", "geometry"},
+	{"TOE", "geometry"},
+	{"CASTER", "geometry"},
+	{"RIDE_HEIGHT", "geometry"},
+	{"WHEEL_TRACK", "geometry"},
+	{"TRACK_BAR", "geometry"},
+	{"STEER_LOCK", "geometry"},
+	{"WING", "aero"},
+	{"FENDER", "aero"},
+	{"RADIATOR", "aero"},
+	{"BRAKE_DUCTS", "aero"},
+	{"SPRING", "suspension"},
+	{"BUMP", "suspension"},
+	{"REBOUND", "suspension"},
+	{"PACKER", "suspension"},
+	{"ANTISWAY", "suspension"},
+	{"CHASSIS_ADJ", "suspension"},
+	{"WEIGHT", "suspension"},
+	{"BRAKE", "brakes"},
+	{"GEAR", "drivetrain"},
+	{"DIFF", "drivetrain"},
+	{"RATIO", "drivetrain"},
+	{"TORQUE_SPLIT", "drivetrain"},
+	{"TRACTION", "electronics"},
+	{"ANTILOCK", "electronics"},
+	{"ENGINE", "electronics"},
+	{"MOTOR", "electronics"},
+	{"REGEN", "electronics"},
+	{"REV_LIMITER", "electronics"},
+	{"FUEL", "fuel"},
+	{"VIRTUAL_ENERGY", "fuel"},
+	{"PITSTOP", "fuel"},
+}
+
+// Category groups a setting key (VM_FRONT_WING → "aero").
+func Category(key string) string {
+	k := strings.ToUpper(key)
+	for _, r := range categoryRules {
+		if strings.Contains(k, r.fragment) {
+			return r.category
+		}
+	}
+	return "other"
+}
+
+func categoryOrder(c string) int {
+	for i, name := range Categories {
+		if name == c {
+			return i
+		}
+	}
+	return len(Categories)
+}
+
+// Label turns a setting key into a readable name (VM_FRONT_WING → "Front wing").
+func Label(key string) string {
+	s := strings.ToLower(strings.TrimPrefix(key, "VM_"))
+	s = strings.ReplaceAll(s, "_", " ")
+	if s == "" {
+		return key
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,285 @@
+package garage
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Setups are shared between teammates through a small HTTP service:
+//
+//	GET    /setups                          list (optional ?track=&car=)
+//	GET    /setups/{track}/{car}/{name}     fetch one setup
+//	PUT    /setups/{track}/{car}/{name}     store one setup
+//
+// Setups are stored as JSON files under dir/track/car/name.json, each part
+// escaped by fileName. When a token is configured every request must carry
+// it as a bearer token.
+
+// Entry describes one stored setup in a listing.
+type Entry struct {
+	Track    string    `json:"track"`
+	Car      string    `json:"car"`
+	Name     string    `json:"name"`
+	Modified time.Time `json:"modified"`
+}
+
+// Server stores setups on disk and serves them over HTTP.
+type Server struct {
+	Dir   string
+	Token string
+
+	mu sync.Mutex
+}
+
+// NewServer returns a server storing setups below dir.
+func NewServer(dir, token string) *Server {
+	return &Server{Dir: dir, Token: token}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.Token != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+s.Token)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	// Split before unescaping, so an escaped slash in a name stays in it
+	rest, ok := strings.CutPrefix(r.URL.EscapedPath(), "/setups")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	rest = strings.Trim(rest, "/")
+	if rest == "" {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		s.list(w, r)
+		return
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) != 3 {
+		http.NotFound(w, r)
+		return
+	}
+	for i, p := range parts {
+		p, err := url.PathUnescape(p)
+		if err != nil || p == "" {
+			http.Error(w, "invalid path", http.StatusBadRequest)
+			return
+		}
+		parts[i] = fileName(p)
+	}
+	path := filepath.Join(s.Dir, parts[0], parts[1], parts[2]+".json")
+	switch r.Method {
+	case http.MethodGet:
+		s.get(w, r, path)
+	case http.MethodPut:
+		s.put(w, r, path)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// get serves the file itself; http.ServeFile would refuse a name of ".."
+// in the request path although it is stored escaped.
+func (s *Server) get(w http.ResponseWriter, r *http.Request, path string) {
+	f, err := os.Open(path)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	http.ServeContent(w, r, path, fi.ModTime(), f)
+}
+
+func (s *Server) put(w http.ResponseWriter, r *http.Request, path string) {
+	var setup Setup
+	if err := json.NewDecoder(io.LimitReader(r.Body, 1<<20)).Decode(&setup); err != nil {
+		http.Error(w, "invalid setup: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := setup.Save(path); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request) {
+	track, car := r.URL.Query().Get("track"), r.URL.Query().Get("car")
+	entries := []Entry{}
+	files, _ := filepath.Glob(filepath.Join(s.Dir, "*", "*", "*.json"))
+	for _, f := range files {
+		rel, _ := filepath.Rel(s.Dir, f)
+		p := strings.Split(filepath.ToSlash(rel), "/")
+		e := Entry{Track: nameOf(p[0]), Car: nameOf(p[1]), Name: nameOf(strings.TrimSuffix(p[2], ".json"))}
+		if (track != "" && !strings.EqualFold(track, e.Track)) || (car != "" && !strings.EqualFold(car, e.Car)) {
+			continue
+		}
+		if fi, err := os.Stat(f); err == nil {
+			e.Modified = fi.ModTime().UTC()
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Track != b.Track {
+			return a.Track < b.Track
+		}
+		if a.Car != b.Car {
+			return a.Car < b.Car
+		}
+		return a.Name < b.Name
+	})
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(entries)
+}
+
+// fileName escapes a track, car or setup name into a file name that stays
+// in its directory on every system: bytes other than letters, digits and a
+// few punctuation marks become %XX, as does a leading dot, so "GT3 #2/wet"
+// is stored as "GT3 %232%2Fwet". Plain names are stored as they are.
+func fileName(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == ' ' || c == '-' || c == '_' || c == '(' || c == ')' || c == '+' || c == ',',
+			c == '.' && i > 0:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// nameOf reverses fileName.
+func nameOf(file string) string {
+	if s, err := url.PathUnescape(file); err == nil {
+		return s
+	}
+	return file
+}
+
+// Remote is a client of a setup sync server.
+type Remote struct {
+	BaseURL    string
+	Token      string
+	HTTPClient *http.Client
+}
+
+// NewRemote returns a client for the sync server at baseURL.
+func NewRemote(baseURL, token string) *Remote {
+	return &Remote{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		Token:      token,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// List returns the stored setups, optionally filtered by track and car.
+func (r *Remote) List(track, car string) ([]Entry, error) {
+	q := url.Values{}
+	if track != "" {
+		q.Set("track", track)
+	}
+	if car != "" {
+		q.Set("car", car)
+	}
+	data, err := r.do("GET", "/setups?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("decode setup list: %w", err)
+	}
+	return entries, nil
+}
+
+// Push stores s on the server under its track, car and name.
+func (r *Remote) Push(s *Setup, name string) error {
+	var buf bytes.Buffer
+	if err := s.Encode(&buf); err != nil {
+		return err
+	}
+	_, err := r.do("PUT", setupPath(s.Track, s.Car, name), &buf)
+	return err
+}
+
+// Pull fetches a named setup for a track and car.
+func (r *Remote) Pull(track, car, name string) (*Setup, error) {
+	data, err := r.do("GET", setupPath(track, car, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	var s Setup
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("decode setup: %w", err)
+	}
+	return &s, nil
+}
+
+func setupPath(track, car, name string) string {
+	path := "/setups"
+	for _, p := range []string{track, car, name} {
+		p = url.PathEscape(p)
+		// Escaped, "." and ".." are not cleaned away on the way
+		if strings.Trim(p, ".") == "" {
+			p = strings.ReplaceAll(p, ".", "%2E")
+		}
+		path += "/" + p
+	}
+	return path
+}
+
+func (r *Remote) do(method, path string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, r.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if r.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.Token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return data, fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return data, nil
+}
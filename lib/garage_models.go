@@ -1,152 +1,6 @@
 // Code generated by cmd/generate. DO NOT EDIT.
 package lib
 
-type NavigationGetLoadingScreenResponse struct {
-	SelectedCar NavigationGetLoadingScreenResponseSelectedCar `json:"selectedCar"`
-	TrackInfo   NavigationGetLoadingScreenResponseTrackInfo   `json:"trackInfo"`
-}
-
-type NavigationGetLoadingScreenResponseSelectedCar struct {
-	Classes          []string                                                   `json:"classes"`
-	ClassesOverride  string                                                     `json:"classesOverride"`
-	Desc             string                                                     `json:"desc"`
-	DlcAppID         float64                                                    `json:"dlcAppID"`
-	Drivers          []NavigationGetLoadingScreenResponseSelectedCarDriversItem `json:"drivers"`
-	Engine           string                                                     `json:"engine"`
-	FullPathTree     string                                                     `json:"fullPathTree"`
-	FullTeam         string                                                     `json:"fullTeam"`
-	Id               string                                                     `json:"id"`
-	IsOwned          bool                                                       `json:"isOwned"`
-	LiveryName       string                                                     `json:"liveryName"`
-	Manufacturer     string                                                     `json:"manufacturer"`
-	Number           string                                                     `json:"number"`
-	PremID           float64                                                    `json:"premID"`
-	PrimaryVehFile   bool                                                       `json:"primaryVehFile"`
-	Sig              string                                                     `json:"sig"`
-	Team             string                                                     `json:"team"`
-	TeamFounded      string                                                     `json:"teamFounded"`
-	TeamHeadquarters string                                                     `json:"teamHeadquarters"`
-	VehFile          string                                                     `json:"vehFile"`
-	Vehicle          string                                                     `json:"vehicle"`
-}
-
-type NavigationGetLoadingScreenResponseSelectedCarDriversItem struct {
-	Name        string `json:"name"`
-	Nationality string `json:"nationality"`
-	Skill       string `json:"skill"`
-}
-
-type NavigationGetLoadingScreenResponseTrackInfo struct {
-	CmpName                  string                                                                  `json:"cmpName"`
-	Corners                  string                                                                  `json:"corners"`
-	CountryCode              string                                                                  `json:"countryCode"`
-	DefaultPracticeStartTime float64                                                                 `json:"defaultPracticeStartTime"`
-	DefaultPracticeWeather   []NavigationGetLoadingScreenResponseTrackInfoDefaultPracticeWeatherItem `json:"defaultPracticeWeather"`
-	DefaultQualifyStartTime  float64                                                                 `json:"defaultQualifyStartTime"`
-	DefaultQualifyWeather    []NavigationGetLoadingScreenResponseTrackInfoDefaultQualifyWeatherItem  `json:"defaultQualifyWeather"`
-	DefaultRaceLengthLaps    float64                                                                 `json:"defaultRaceLengthLaps"`
-	DefaultRaceLengthTime    float64                                                                 `json:"defaultRaceLengthTime"`
-	DefaultRaceStartTime     float64                                                                 `json:"defaultRaceStartTime"`
-	DefaultRaceWeather       []NavigationGetLoadingScreenResponseTrackInfoDefaultRaceWeatherItem     `json:"defaultRaceWeather"`
-	DlcAppID                 float64                                                                 `json:"dlcAppID"`
-	EventName                string                                                                  `json:"eventName"`
-	GrandPrixName            string                                                                  `json:"grandPrixName"`
-	Id                       string                                                                  `json:"id"`
-	Image                    string                                                                  `json:"image"`
-	IsOwned                  bool                                                                    `json:"isOwned"`
-	Location                 string                                                                  `json:"location"`
-	OfficialEvent            bool                                                                    `json:"officialEvent"`
-	OpeningYear              string                                                                  `json:"openingYear"`
-	PremId                   float64                                                                 `json:"premId"`
-	ProperTrackName          string                                                                  `json:"properTrackName"`
-	SceneDesc                string                                                                  `json:"sceneDesc"`
-	SceneSig                 string                                                                  `json:"sceneSig"`
-	Thumbnail                string                                                                  `json:"thumbnail"`
-	TrackLength              string                                                                  `json:"trackLength"`
-	TrackName                string                                                                  `json:"trackName"`
-	Type                     string                                                                  `json:"type"`
-	Version                  string                                                                  `json:"version"`
-}
-
-type NavigationGetLoadingScreenResponseTrackInfoDefaultPracticeWeatherItem struct {
-	Duration      float64 `json:"Duration"`
-	Humidity      float64 `json:"Humidity"`
-	RainChance    float64 `json:"RainChance"`
-	Sky           float64 `json:"Sky"`
-	StartTime     float64 `json:"StartTime"`
-	Temperature   float64 `json:"Temperature"`
-	WindDirection float64 `json:"WindDirection"`
-	WindSpeed     float64 `json:"WindSpeed"`
-}
-
-type NavigationGetLoadingScreenResponseTrackInfoDefaultQualifyWeatherItem struct {
-	Duration      float64 `json:"Duration"`
-	Humidity      float64 `json:"Humidity"`
-	RainChance    float64 `json:"RainChance"`
-	Sky           float64 `json:"Sky"`
-	StartTime     float64 `json:"StartTime"`
-	Temperature   float64 `json:"Temperature"`
-	WindDirection float64 `json:"WindDirection"`
-	WindSpeed     float64 `json:"WindSpeed"`
-}
-
-type NavigationGetLoadingScreenResponseTrackInfoDefaultRaceWeatherItem struct {
-	Duration      float64 `json:"Duration"`
-	Humidity      float64 `json:"Humidity"`
-	RainChance    float64 `json:"RainChance"`
-	Sky           float64 `json:"Sky"`
-	StartTime     float64 `json:"StartTime"`
-	Temperature   float64 `json:"Temperature"`
-	WindDirection float64 `json:"WindDirection"`
-	WindSpeed     float64 `json:"WindSpeed"`
-}
-
-type NavigationGetReferrerResponse struct {
-	Referrer string `json:"referrer"`
-}
-
-type NavigationStateResponse struct {
-	LoadingStatus NavigationStateResponseLoadingStatus `json:"loadingStatus"`
-	State         NavigationStateResponseState         `json:"state"`
-}
-
-type NavigationStateResponseLoadingStatus struct {
-	Loading     bool                                      `json:"loading"`
-	LoadingData string                                    `json:"loadingData"`
-	Percentage  float64                                   `json:"percentage"`
-	Track       NavigationStateResponseLoadingStatusTrack `json:"track"`
-}
-
-type NavigationStateResponseLoadingStatusTrack struct {
-	DisplayProperties map[string]interface{} `json:"displayProperties"`
-	DlcappID          float64                `json:"dlcappID"`
-	Length            string                 `json:"length"`
-	Owned             bool                   `json:"owned"`
-	PremId            float64                `json:"premId"`
-	SceneDesc         string                 `json:"sceneDesc"`
-	Sig               string                 `json:"sig"`
-	Track             string                 `json:"track"`
-	Type              string                 `json:"type"`
-	Venue             string                 `json:"venue"`
-}
-
-type NavigationStateResponseState struct {
-	AppBuild            float64                          `json:"appBuild"`
-	GamePhase           string                           `json:"gamePhase"`
-	GameSession         string                           `json:"gameSession"`
-	GameState           string                           `json:"gameState"`
-	InternalStateCode   string                           `json:"internalStateCode"`
-	NavigationState     string                           `json:"navigationState"`
-	SettingMode         string                           `json:"settingMode"`
-	SteamBetaBranchName string                           `json:"steamBetaBranchName"`
-	User                NavigationStateResponseStateUser `json:"user"`
-}
-
-type NavigationStateResponseStateUser struct {
-	Admin     bool   `json:"admin"`
-	UserState string `json:"userState"`
-}
-
 type RestGarageGetPlayerGarageDataResponse struct {
 	VMANTILOCKBRAKESYSTEMMAP      RestGarageGetPlayerGarageDataResponseVMANTILOCKBRAKESYSTEMMAP      `json:"VM_ANTILOCKBRAKESYSTEMMAP"`
 	VMANTILOCKBRAKES              RestGarageGetPlayerGarageDataResponseVMANTILOCKBRAKES              `json:"VM_ANTILOCK_BRAKES"`
@@ -6983,5686 +6837,3 @@ type RestGarageUIScreenTireManagementResponseWheelInfoWheelLocsItem struct {
 	TirePressure float64 `json:"tirePressure"`
 	TireTemp     float64 `json:"tireTemp"`
 }
-
-type RestHudResponse struct {
-	Chat     bool `json:"chat"`
-	Mfd      bool `json:"mfd"`
-	Speedo   bool `json:"speedo"`
-	Timing   bool `json:"timing"`
-	TrackMap bool `json:"trackMap"`
-}
-
-type RestMaterialeditorLiveryeditorGetCustomSkinInfoResponse struct {
-	AlbedoTexture string `json:"albedo_texture"`
-	RegionTexture string `json:"region_texture"`
-}
-
-type RestOptionsAssignChangestatusResponse struct {
-	Info   interface{} `json:"Info"`
-	Status float64     `json:"Status"`
-}
-
-type RestOptionsCommandlineResponse struct {
-	CommandLine string `json:"commandLine"`
-}
-
-type RestOptionsDisplayResponse struct {
-	GAMEOPTEnablePlugins              RestOptionsDisplayResponseGAMEOPTEnablePlugins              `json:"GAMEOPT_enable_plugins"`
-	GAMEOPTKph                        RestOptionsDisplayResponseGAMEOPTKph                        `json:"GAMEOPT_kph"`
-	GAMEOPTTransparentTrainerLap      RestOptionsDisplayResponseGAMEOPTTransparentTrainerLap      `json:"GAMEOPT_transparent_trainer_lap"`
-	GAMEOPTTransparentTrainerLeadTime RestOptionsDisplayResponseGAMEOPTTransparentTrainerLeadTime `json:"GAMEOPT_transparent_trainer_lead_time"`
-	GAMEOPTUnits                      RestOptionsDisplayResponseGAMEOPTUnits                      `json:"GAMEOPT_units"`
-	GRAPHOPTTextureFilter             RestOptionsDisplayResponseGRAPHOPTTextureFilter             `json:"GRAPHOPT_TextureFilter"`
-	GRAPHOPTDriverOverlayHud          RestOptionsDisplayResponseGRAPHOPTDriverOverlayHud          `json:"GRAPHOPT_driver_overlay_hud"`
-	GRAPHOPTEnvReflections            RestOptionsDisplayResponseGRAPHOPTEnvReflections            `json:"GRAPHOPT_env_reflections"`
-	GRAPHOPTOpponentDetail            RestOptionsDisplayResponseGRAPHOPTOpponentDetail            `json:"GRAPHOPT_opponent_detail"`
-	GRAPHOPTPlayerDetail              RestOptionsDisplayResponseGRAPHOPTPlayerDetail              `json:"GRAPHOPT_player_detail"`
-	GRAPHOPTRainDrops                 RestOptionsDisplayResponseGRAPHOPTRainDrops                 `json:"GRAPHOPT_rain_drops"`
-	GRAPHOPTRearview                  RestOptionsDisplayResponseGRAPHOPTRearview                  `json:"GRAPHOPT_rearview"`
-	GRAPHOPTRoadReflections           RestOptionsDisplayResponseGRAPHOPTRoadReflections           `json:"GRAPHOPT_road_reflections"`
-	GRAPHOPTShadowBlur                RestOptionsDisplayResponseGRAPHOPTShadowBlur                `json:"GRAPHOPT_shadow_blur"`
-	GRAPHOPTShadows                   RestOptionsDisplayResponseGRAPHOPTShadows                   `json:"GRAPHOPT_shadows"`
-	GRAPHOPTSoftParticles             RestOptionsDisplayResponseGRAPHOPTSoftParticles             `json:"GRAPHOPT_soft_particles"`
-	GRAPHOPTSpecialfx                 RestOptionsDisplayResponseGRAPHOPTSpecialfx                 `json:"GRAPHOPT_specialfx"`
-	GRAPHOPTStabilizeHorizon          RestOptionsDisplayResponseGRAPHOPTStabilizeHorizon          `json:"GRAPHOPT_stabilize_horizon"`
-	GRAPHOPTStartingView              RestOptionsDisplayResponseGRAPHOPTStartingView              `json:"GRAPHOPT_starting_view"`
-	GRAPHOPTSteeringWheel             RestOptionsDisplayResponseGRAPHOPTSteeringWheel             `json:"GRAPHOPT_steering_wheel"`
-	GRAPHOPTTexture                   RestOptionsDisplayResponseGRAPHOPTTexture                   `json:"GRAPHOPT_texture"`
-	GRAPHOPTTextureStreaming          RestOptionsDisplayResponseGRAPHOPTTextureStreaming          `json:"GRAPHOPT_texture_streaming"`
-	GRAPHOPTTrack                     RestOptionsDisplayResponseGRAPHOPTTrack                     `json:"GRAPHOPT_track"`
-	GRAPHOPTVfov                      RestOptionsDisplayResponseGRAPHOPTVfov                      `json:"GRAPHOPT_vfov"`
-	GRAPHOPTVisibleVehicles           RestOptionsDisplayResponseGRAPHOPTVisibleVehicles           `json:"GRAPHOPT_visible_vehicles"`
-	MULTIDownloadCustomSkins          RestOptionsDisplayResponseMULTIDownloadCustomSkins          `json:"MULTI_download_custom_skins"`
-}
-
-type RestOptionsDisplayResponseGAMEOPTEnablePlugins struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGAMEOPTKph struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGAMEOPTTransparentTrainerLap struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGAMEOPTTransparentTrainerLeadTime struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGAMEOPTUnits struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTDriverOverlayHud struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTEnvReflections struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTOpponentDetail struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTPlayerDetail struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTRainDrops struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTRearview struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTRoadReflections struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTShadowBlur struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTShadows struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTSoftParticles struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTSpecialfx struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTStabilizeHorizon struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTStartingView struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTSteeringWheel struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTTexture struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTTextureFilter struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTTextureStreaming struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTTrack struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTVfov struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseGRAPHOPTVisibleVehicles struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsDisplayResponseMULTIDownloadCustomSkins struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsGetAllResolutionsResponseItem struct {
-	Height      float64   `json:"Height"`
-	RefreshRate []float64 `json:"RefreshRate"`
-	Width       float64   `json:"Width"`
-}
-
-type RestOptionsGetLanguageResponse struct {
-	Language string `json:"language"`
-}
-
-type RestOptionsLiveInputsResponse struct {
-	LiveInputs RestOptionsLiveInputsResponseLiveInputs `json:"liveInputs"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputs struct {
-	Di              []RestOptionsLiveInputsResponseLiveInputsDiItem        `json:"di"`
-	Gamepad         RestOptionsLiveInputsResponseLiveInputsGamepad         `json:"gamepad"`
-	Keyboard        RestOptionsLiveInputsResponseLiveInputsKeyboard        `json:"keyboard"`
-	ProcessedInputs RestOptionsLiveInputsResponseLiveInputsProcessedInputs `json:"processed inputs"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsDiItem struct {
-	Minmax    RestOptionsLiveInputsResponseLiveInputsDiItemMinmax    `json:"minmax"`
-	RawInputs RestOptionsLiveInputsResponseLiveInputsDiItemRawInputs `json:"raw inputs"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsDiItemMinmax struct {
-	Brakes         RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxBrakes         `json:"brakes"`
-	Clutch         RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxClutch         `json:"clutch"`
-	Handbrake      RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxHandbrake      `json:"handbrake"`
-	Handfrontbrake RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxHandfrontbrake `json:"handfrontbrake"`
-	SteerLeft      RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxSteerLeft      `json:"steerLeft"`
-	SteerRight     RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxSteerRight     `json:"steerRight"`
-	Throttle       RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxThrottle       `json:"throttle"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxBrakes struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxClutch struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxHandbrake struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxHandfrontbrake struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxSteerLeft struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxSteerRight struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsDiItemMinmaxThrottle struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsDiItemRawInputs struct {
-	Brakes            float64 `json:"brakes"`
-	Clutch            float64 `json:"clutch"`
-	DirectManualShift float64 `json:"directManualShift"`
-	Handbrake         float64 `json:"handbrake"`
-	Handfrontbrake    float64 `json:"handfrontbrake"`
-	LaunchControl     bool    `json:"launchControl"`
-	ShiftDown         bool    `json:"shiftDown"`
-	ShiftToNeutral    bool    `json:"shiftToNeutral"`
-	ShiftUp           bool    `json:"shiftUp"`
-	SteerLeft         float64 `json:"steerLeft"`
-	SteerRight        float64 `json:"steerRight"`
-	TcOverride        bool    `json:"tcOverride"`
-	Throttle          float64 `json:"throttle"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsGamepad struct {
-	Minmax    RestOptionsLiveInputsResponseLiveInputsGamepadMinmax    `json:"minmax"`
-	RawInputs RestOptionsLiveInputsResponseLiveInputsGamepadRawInputs `json:"raw inputs"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsGamepadMinmax struct {
-	Brakes         RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxBrakes         `json:"brakes"`
-	Clutch         RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxClutch         `json:"clutch"`
-	Handbrake      RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxHandbrake      `json:"handbrake"`
-	Handfrontbrake RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxHandfrontbrake `json:"handfrontbrake"`
-	SteerLeft      RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxSteerLeft      `json:"steerLeft"`
-	SteerRight     RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxSteerRight     `json:"steerRight"`
-	Throttle       RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxThrottle       `json:"throttle"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxBrakes struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxClutch struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxHandbrake struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxHandfrontbrake struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxSteerLeft struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxSteerRight struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsGamepadMinmaxThrottle struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsGamepadRawInputs struct {
-	Brakes            float64 `json:"brakes"`
-	Clutch            float64 `json:"clutch"`
-	DirectManualShift float64 `json:"directManualShift"`
-	Handbrake         float64 `json:"handbrake"`
-	Handfrontbrake    float64 `json:"handfrontbrake"`
-	LaunchControl     bool    `json:"launchControl"`
-	ShiftDown         bool    `json:"shiftDown"`
-	ShiftToNeutral    bool    `json:"shiftToNeutral"`
-	ShiftUp           bool    `json:"shiftUp"`
-	SteerLeft         float64 `json:"steerLeft"`
-	SteerRight        float64 `json:"steerRight"`
-	TcOverride        bool    `json:"tcOverride"`
-	Throttle          float64 `json:"throttle"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsKeyboard struct {
-	Minmax    RestOptionsLiveInputsResponseLiveInputsKeyboardMinmax    `json:"minmax"`
-	RawInputs RestOptionsLiveInputsResponseLiveInputsKeyboardRawInputs `json:"raw inputs"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsKeyboardMinmax struct {
-	Brakes         RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxBrakes         `json:"brakes"`
-	Clutch         RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxClutch         `json:"clutch"`
-	Handbrake      RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxHandbrake      `json:"handbrake"`
-	Handfrontbrake RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxHandfrontbrake `json:"handfrontbrake"`
-	SteerLeft      RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxSteerLeft      `json:"steerLeft"`
-	SteerRight     RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxSteerRight     `json:"steerRight"`
-	Throttle       RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxThrottle       `json:"throttle"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxBrakes struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxClutch struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxHandbrake struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxHandfrontbrake struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxSteerLeft struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxSteerRight struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsKeyboardMinmaxThrottle struct {
-	Max float64 `json:"max"`
-	Min float64 `json:"min"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsKeyboardRawInputs struct {
-	Brakes            float64 `json:"brakes"`
-	Clutch            float64 `json:"clutch"`
-	DirectManualShift float64 `json:"directManualShift"`
-	Handbrake         float64 `json:"handbrake"`
-	Handfrontbrake    float64 `json:"handfrontbrake"`
-	LaunchControl     bool    `json:"launchControl"`
-	ShiftDown         bool    `json:"shiftDown"`
-	ShiftToNeutral    bool    `json:"shiftToNeutral"`
-	ShiftUp           bool    `json:"shiftUp"`
-	SteerLeft         float64 `json:"steerLeft"`
-	SteerRight        float64 `json:"steerRight"`
-	TcOverride        bool    `json:"tcOverride"`
-	Throttle          float64 `json:"throttle"`
-}
-
-type RestOptionsLiveInputsResponseLiveInputsProcessedInputs struct {
-	Brakes            float64 `json:"brakes"`
-	Clutch            float64 `json:"clutch"`
-	DirectManualShift float64 `json:"directManualShift"`
-	Handbrake         float64 `json:"handbrake"`
-	Handfrontbrake    float64 `json:"handfrontbrake"`
-	LaunchControl     bool    `json:"launchControl"`
-	ShiftDown         bool    `json:"shiftDown"`
-	ShiftToNeutral    bool    `json:"shiftToNeutral"`
-	ShiftUp           bool    `json:"shiftUp"`
-	SteerLeft         float64 `json:"steerLeft"`
-	SteerRight        float64 `json:"steerRight"`
-	TcOverride        bool    `json:"tcOverride"`
-	Throttle          float64 `json:"throttle"`
-}
-
-type RestOptionsSettingsResponse struct {
-	CONTROLAutoReverse                        RestOptionsSettingsResponseCONTROLAutoReverse                        `json:"CONTROL_auto_reverse"`
-	CONTROLTrackIr                            RestOptionsSettingsResponseCONTROLTrackIr                            `json:"CONTROL_track_ir"`
-	COPYPractice1Time                         interface{}                                                          `json:"COPY_practice1_time"`
-	COPYQualifyLaps                           interface{}                                                          `json:"COPY_qualify_laps"`
-	COPYQualifyTime                           interface{}                                                          `json:"COPY_qualify_time"`
-	COPYWarmupTime                            interface{}                                                          `json:"COPY_warmup_time"`
-	DISPLAYOPTShowroom                        RestOptionsSettingsResponseDISPLAYOPTShowroom                        `json:"DISPLAYOPT_showroom"`
-	DRIVEAIDSAntilockBrakes                   RestOptionsSettingsResponseDRIVEAIDSAntilockBrakes                   `json:"DRIVEAIDS_antilock_brakes"`
-	DRIVEAIDSAutoBlip                         RestOptionsSettingsResponseDRIVEAIDSAutoBlip                         `json:"DRIVEAIDS_auto_blip"`
-	DRIVEAIDSAutoClutch                       RestOptionsSettingsResponseDRIVEAIDSAutoClutch                       `json:"DRIVEAIDS_auto_clutch"`
-	DRIVEAIDSAutoHeadlights                   RestOptionsSettingsResponseDRIVEAIDSAutoHeadlights                   `json:"DRIVEAIDS_auto_headlights"`
-	DRIVEAIDSAutoLift                         RestOptionsSettingsResponseDRIVEAIDSAutoLift                         `json:"DRIVEAIDS_auto_lift"`
-	DRIVEAIDSAutoWipers                       RestOptionsSettingsResponseDRIVEAIDSAutoWipers                       `json:"DRIVEAIDS_auto_wipers"`
-	DRIVEAIDSAutomaticPitSpeedLimit           RestOptionsSettingsResponseDRIVEAIDSAutomaticPitSpeedLimit           `json:"DRIVEAIDS_automatic_pit_speed_limit"`
-	DRIVEAIDSAutopit                          RestOptionsSettingsResponseDRIVEAIDSAutopit                          `json:"DRIVEAIDS_autopit"`
-	DRIVEAIDSBrakeHelp                        RestOptionsSettingsResponseDRIVEAIDSBrakeHelp                        `json:"DRIVEAIDS_brake_help"`
-	DRIVEAIDSHoldBrakes                       RestOptionsSettingsResponseDRIVEAIDSHoldBrakes                       `json:"DRIVEAIDS_hold_brakes"`
-	DRIVEAIDSHoldClutch                       RestOptionsSettingsResponseDRIVEAIDSHoldClutch                       `json:"DRIVEAIDS_hold_clutch"`
-	DRIVEAIDSInvulnerable                     RestOptionsSettingsResponseDRIVEAIDSInvulnerable                     `json:"DRIVEAIDS_invulnerable"`
-	DRIVEAIDSOppositeLock                     RestOptionsSettingsResponseDRIVEAIDSOppositeLock                     `json:"DRIVEAIDS_opposite_lock"`
-	DRIVEAIDSRepeatShifts                     RestOptionsSettingsResponseDRIVEAIDSRepeatShifts                     `json:"DRIVEAIDS_repeat_shifts"`
-	DRIVEAIDSShiftMode                        RestOptionsSettingsResponseDRIVEAIDSShiftMode                        `json:"DRIVEAIDS_shift_mode"`
-	DRIVEAIDSSpinRecovery                     RestOptionsSettingsResponseDRIVEAIDSSpinRecovery                     `json:"DRIVEAIDS_spin_recovery"`
-	DRIVEAIDSStabilityControl                 RestOptionsSettingsResponseDRIVEAIDSStabilityControl                 `json:"DRIVEAIDS_stability_control"`
-	DRIVEAIDSStartEngine                      RestOptionsSettingsResponseDRIVEAIDSStartEngine                      `json:"DRIVEAIDS_start_engine"`
-	DRIVEAIDSSteeringHelp                     RestOptionsSettingsResponseDRIVEAIDSSteeringHelp                     `json:"DRIVEAIDS_steering_help"`
-	DRIVEAIDSThrottleControl                  RestOptionsSettingsResponseDRIVEAIDSThrottleControl                  `json:"DRIVEAIDS_throttle_control"`
-	DRIVEAIDSVisFastLine                      RestOptionsSettingsResponseDRIVEAIDSVisFastLine                      `json:"DRIVEAIDS_vis_fast_line"`
-	GAMEOPTMULTIRaceFinishCriteria            interface{}                                                          `json:"GAMEOPT_MULTI_race_finish_criteria"`
-	GAMEOPTMULTIRaceLaps                      interface{}                                                          `json:"GAMEOPT_MULTI_race_laps"`
-	GAMEOPTMULTIRaceTime                      interface{}                                                          `json:"GAMEOPT_MULTI_race_time"`
-	GAMEOPTAiAggression                       RestOptionsSettingsResponseGAMEOPTAiAggression                       `json:"GAMEOPT_ai_aggression"`
-	GAMEOPTAiDriverstrength                   RestOptionsSettingsResponseGAMEOPTAiDriverstrength                   `json:"GAMEOPT_ai_driverstrength"`
-	GAMEOPTBroadcastCustomCameras             RestOptionsSettingsResponseGAMEOPTBroadcastCustomCameras             `json:"GAMEOPT_broadcast_custom_cameras"`
-	GAMEOPTBroadcastGroupLap1range            RestOptionsSettingsResponseGAMEOPTBroadcastGroupLap1range            `json:"GAMEOPT_broadcast_group_lap1range"`
-	GAMEOPTBroadcastGroupMode                 RestOptionsSettingsResponseGAMEOPTBroadcastGroupMode                 `json:"GAMEOPT_broadcast_group_mode"`
-	GAMEOPTBroadcastGroupRange                RestOptionsSettingsResponseGAMEOPTBroadcastGroupRange                `json:"GAMEOPT_broadcast_group_range"`
-	GAMEOPTBroadcastGroupZoommax              RestOptionsSettingsResponseGAMEOPTBroadcastGroupZoommax              `json:"GAMEOPT_broadcast_group_zoommax"`
-	GAMEOPTBroadcastGroupZoomstrength         RestOptionsSettingsResponseGAMEOPTBroadcastGroupZoomstrength         `json:"GAMEOPT_broadcast_group_zoomstrength"`
-	GAMEOPTBroadcastPersistentZoom            RestOptionsSettingsResponseGAMEOPTBroadcastPersistentZoom            `json:"GAMEOPT_broadcast_persistent_zoom"`
-	GAMEOPTBroadcastTrackingchangeBlend       RestOptionsSettingsResponseGAMEOPTBroadcastTrackingchangeBlend       `json:"GAMEOPT_broadcast_trackingchange_blend"`
-	GAMEOPTBroadcastTrackingchangeMaxtimediff RestOptionsSettingsResponseGAMEOPTBroadcastTrackingchangeMaxtimediff `json:"GAMEOPT_broadcast_trackingchange_maxtimediff"`
-	GAMEOPTBroadcastTrackingchangeTimedelay   RestOptionsSettingsResponseGAMEOPTBroadcastTrackingchangeTimedelay   `json:"GAMEOPT_broadcast_trackingchange_timedelay"`
-	GAMEOPTDamagemultiplier                   RestOptionsSettingsResponseGAMEOPTDamagemultiplier                   `json:"GAMEOPT_damagemultiplier"`
-	GAMEOPTDeleteSaveDataRaceEnd              RestOptionsSettingsResponseGAMEOPTDeleteSaveDataRaceEnd              `json:"GAMEOPT_delete_save_data_race_end"`
-	GAMEOPTEnablePlugins                      RestOptionsSettingsResponseGAMEOPTEnablePlugins                      `json:"GAMEOPT_enable_plugins"`
-	GAMEOPTKph                                RestOptionsSettingsResponseGAMEOPTKph                                `json:"GAMEOPT_kph"`
-	GAMEOPTKw                                 RestOptionsSettingsResponseGAMEOPTKw                                 `json:"GAMEOPT_kw"`
-	GAMEOPTRememberOnboardChanges             RestOptionsSettingsResponseGAMEOPTRememberOnboardChanges             `json:"GAMEOPT_remember_onboard_changes"`
-	GAMEOPTSpotterDetail                      RestOptionsSettingsResponseGAMEOPTSpotterDetail                      `json:"GAMEOPT_spotter_detail"`
-	GAMEOPTSpotterLaptimes                    RestOptionsSettingsResponseGAMEOPTSpotterLaptimes                    `json:"GAMEOPT_spotter_laptimes"`
-	GAMEOPTTelemetry                          RestOptionsSettingsResponseGAMEOPTTelemetry                          `json:"GAMEOPT_telemetry"`
-	GAMEOPTTransparentTrainerLap              RestOptionsSettingsResponseGAMEOPTTransparentTrainerLap              `json:"GAMEOPT_transparent_trainer_lap"`
-	GAMEOPTTransparentTrainerLeadTime         RestOptionsSettingsResponseGAMEOPTTransparentTrainerLeadTime         `json:"GAMEOPT_transparent_trainer_lead_time"`
-	GAMEOPTUnits                              RestOptionsSettingsResponseGAMEOPTUnits                              `json:"GAMEOPT_units"`
-	GRAPHOPTHUDUltrawideRatio                 RestOptionsSettingsResponseGRAPHOPTHUDUltrawideRatio                 `json:"GRAPHOPT_HUD_ultrawide_ratio"`
-	GRAPHOPTTextureFilter                     RestOptionsSettingsResponseGRAPHOPTTextureFilter                     `json:"GRAPHOPT_TextureFilter"`
-	GRAPHOPTVRIPDScale                        RestOptionsSettingsResponseGRAPHOPTVRIPDScale                        `json:"GRAPHOPT_VR_IPD_scale"`
-	GRAPHOPTVRHudDepth                        RestOptionsSettingsResponseGRAPHOPTVRHudDepth                        `json:"GRAPHOPT_VR_hud_depth"`
-	GRAPHOPTVRHudScale                        RestOptionsSettingsResponseGRAPHOPTVRHudScale                        `json:"GRAPHOPT_VR_hud_scale"`
-	GRAPHOPTVRMenuDepth                       RestOptionsSettingsResponseGRAPHOPTVRMenuDepth                       `json:"GRAPHOPT_VR_menu_depth"`
-	GRAPHOPTVRMenuScale                       RestOptionsSettingsResponseGRAPHOPTVRMenuScale                       `json:"GRAPHOPT_VR_menu_scale"`
-	GRAPHOPTCarVibrationMult1                 RestOptionsSettingsResponseGRAPHOPTCarVibrationMult1                 `json:"GRAPHOPT_car_vibration_mult1"`
-	GRAPHOPTDriverLabels                      RestOptionsSettingsResponseGRAPHOPTDriverLabels                      `json:"GRAPHOPT_driver_labels"`
-	GRAPHOPTDriverOverlayFlags                RestOptionsSettingsResponseGRAPHOPTDriverOverlayFlags                `json:"GRAPHOPT_driver_overlay_flags"`
-	GRAPHOPTDriverOverlayHud                  RestOptionsSettingsResponseGRAPHOPTDriverOverlayHud                  `json:"GRAPHOPT_driver_overlay_hud"`
-	GRAPHOPTDriverOverlayInfoCar              RestOptionsSettingsResponseGRAPHOPTDriverOverlayInfoCar              `json:"GRAPHOPT_driver_overlay_info_car"`
-	GRAPHOPTDriverOverlayInfoTiming           RestOptionsSettingsResponseGRAPHOPTDriverOverlayInfoTiming           `json:"GRAPHOPT_driver_overlay_info_timing"`
-	GRAPHOPTDriverOverlayNotificationsChat    RestOptionsSettingsResponseGRAPHOPTDriverOverlayNotificationsChat    `json:"GRAPHOPT_driver_overlay_notifications_chat"`
-	GRAPHOPTDriverOverlayNotificationsGame    RestOptionsSettingsResponseGRAPHOPTDriverOverlayNotificationsGame    `json:"GRAPHOPT_driver_overlay_notifications_game"`
-	GRAPHOPTDriverOverlayNotificationsRace    RestOptionsSettingsResponseGRAPHOPTDriverOverlayNotificationsRace    `json:"GRAPHOPT_driver_overlay_notifications_race"`
-	GRAPHOPTDriverOverlayRadar                RestOptionsSettingsResponseGRAPHOPTDriverOverlayRadar                `json:"GRAPHOPT_driver_overlay_radar"`
-	GRAPHOPTDriverOverlayStandings            RestOptionsSettingsResponseGRAPHOPTDriverOverlayStandings            `json:"GRAPHOPT_driver_overlay_standings"`
-	GRAPHOPTDriverOverlayTrackmap             RestOptionsSettingsResponseGRAPHOPTDriverOverlayTrackmap             `json:"GRAPHOPT_driver_overlay_trackmap"`
-	GRAPHOPTEnvReflections                    RestOptionsSettingsResponseGRAPHOPTEnvReflections                    `json:"GRAPHOPT_env_reflections"`
-	GRAPHOPTExaggerateYaw                     RestOptionsSettingsResponseGRAPHOPTExaggerateYaw                     `json:"GRAPHOPT_exaggerate_yaw"`
-	GRAPHOPTExtraVisibleVehicles              RestOptionsSettingsResponseGRAPHOPTExtraVisibleVehicles              `json:"GRAPHOPT_extra_visible_vehicles"`
-	GRAPHOPTHeadPhysics                       RestOptionsSettingsResponseGRAPHOPTHeadPhysics                       `json:"GRAPHOPT_head_physics"`
-	GRAPHOPTLiveTvDisplays                    RestOptionsSettingsResponseGRAPHOPTLiveTvDisplays                    `json:"GRAPHOPT_live_tv_displays"`
-	GRAPHOPTLookaheadRadians                  RestOptionsSettingsResponseGRAPHOPTLookaheadRadians                  `json:"GRAPHOPT_lookahead_radians"`
-	GRAPHOPTMaxFramerate                      RestOptionsSettingsResponseGRAPHOPTMaxFramerate                      `json:"GRAPHOPT_max_framerate"`
-	GRAPHOPTMotionblur                        RestOptionsSettingsResponseGRAPHOPTMotionblur                        `json:"GRAPHOPT_motionblur"`
-	GRAPHOPTMultiviewCenterBezelGap           RestOptionsSettingsResponseGRAPHOPTMultiviewCenterBezelGap           `json:"GRAPHOPT_multiview_center_BezelGap"`
-	GRAPHOPTMultiviewCenterEyeDistance        RestOptionsSettingsResponseGRAPHOPTMultiviewCenterEyeDistance        `json:"GRAPHOPT_multiview_center_EyeDistance"`
-	GRAPHOPTMultiviewCenterScreenHeight       RestOptionsSettingsResponseGRAPHOPTMultiviewCenterScreenHeight       `json:"GRAPHOPT_multiview_center_ScreenHeight"`
-	GRAPHOPTMultiviewCenterScreenWidth        RestOptionsSettingsResponseGRAPHOPTMultiviewCenterScreenWidth        `json:"GRAPHOPT_multiview_center_ScreenWidth"`
-	GRAPHOPTMultiviewCenterSideAngle          RestOptionsSettingsResponseGRAPHOPTMultiviewCenterSideAngle          `json:"GRAPHOPT_multiview_center_SideAngle"`
-	GRAPHOPTMultiviewLeftBezelGap             RestOptionsSettingsResponseGRAPHOPTMultiviewLeftBezelGap             `json:"GRAPHOPT_multiview_left_BezelGap"`
-	GRAPHOPTMultiviewLeftEyeDistance          RestOptionsSettingsResponseGRAPHOPTMultiviewLeftEyeDistance          `json:"GRAPHOPT_multiview_left_EyeDistance"`
-	GRAPHOPTMultiviewLeftScreenHeight         RestOptionsSettingsResponseGRAPHOPTMultiviewLeftScreenHeight         `json:"GRAPHOPT_multiview_left_ScreenHeight"`
-	GRAPHOPTMultiviewLeftScreenWidth          RestOptionsSettingsResponseGRAPHOPTMultiviewLeftScreenWidth          `json:"GRAPHOPT_multiview_left_ScreenWidth"`
-	GRAPHOPTMultiviewLeftSideAngle            RestOptionsSettingsResponseGRAPHOPTMultiviewLeftSideAngle            `json:"GRAPHOPT_multiview_left_SideAngle"`
-	GRAPHOPTMultiviewMode                     RestOptionsSettingsResponseGRAPHOPTMultiviewMode                     `json:"GRAPHOPT_multiview_mode"`
-	GRAPHOPTMultiviewRightBezelGap            RestOptionsSettingsResponseGRAPHOPTMultiviewRightBezelGap            `json:"GRAPHOPT_multiview_right_BezelGap"`
-	GRAPHOPTMultiviewRightEyeDistance         RestOptionsSettingsResponseGRAPHOPTMultiviewRightEyeDistance         `json:"GRAPHOPT_multiview_right_EyeDistance"`
-	GRAPHOPTMultiviewRightScreenHeight        RestOptionsSettingsResponseGRAPHOPTMultiviewRightScreenHeight        `json:"GRAPHOPT_multiview_right_ScreenHeight"`
-	GRAPHOPTMultiviewRightScreenWidth         RestOptionsSettingsResponseGRAPHOPTMultiviewRightScreenWidth         `json:"GRAPHOPT_multiview_right_ScreenWidth"`
-	GRAPHOPTMultiviewRightSideAngle           RestOptionsSettingsResponseGRAPHOPTMultiviewRightSideAngle           `json:"GRAPHOPT_multiview_right_SideAngle"`
-	GRAPHOPTMultiviewSharedBezelGap           RestOptionsSettingsResponseGRAPHOPTMultiviewSharedBezelGap           `json:"GRAPHOPT_multiview_shared_BezelGap"`
-	GRAPHOPTMultiviewSharedEyeDistance        RestOptionsSettingsResponseGRAPHOPTMultiviewSharedEyeDistance        `json:"GRAPHOPT_multiview_shared_EyeDistance"`
-	GRAPHOPTMultiviewSharedScreenHeight       RestOptionsSettingsResponseGRAPHOPTMultiviewSharedScreenHeight       `json:"GRAPHOPT_multiview_shared_ScreenHeight"`
-	GRAPHOPTMultiviewSharedScreenWidth        RestOptionsSettingsResponseGRAPHOPTMultiviewSharedScreenWidth        `json:"GRAPHOPT_multiview_shared_ScreenWidth"`
-	GRAPHOPTMultiviewSharedSideAngle          RestOptionsSettingsResponseGRAPHOPTMultiviewSharedSideAngle          `json:"GRAPHOPT_multiview_shared_SideAngle"`
-	GRAPHOPTOpponentDetail                    RestOptionsSettingsResponseGRAPHOPTOpponentDetail                    `json:"GRAPHOPT_opponent_detail"`
-	GRAPHOPTPlayerDetail                      RestOptionsSettingsResponseGRAPHOPTPlayerDetail                      `json:"GRAPHOPT_player_detail"`
-	GRAPHOPTRainDrops                         RestOptionsSettingsResponseGRAPHOPTRainDrops                         `json:"GRAPHOPT_rain_drops"`
-	GRAPHOPTRearview                          RestOptionsSettingsResponseGRAPHOPTRearview                          `json:"GRAPHOPT_rearview"`
-	GRAPHOPTRoadReflections                   RestOptionsSettingsResponseGRAPHOPTRoadReflections                   `json:"GRAPHOPT_road_reflections"`
-	GRAPHOPTScreenshotDepthAlpha              RestOptionsSettingsResponseGRAPHOPTScreenshotDepthAlpha              `json:"GRAPHOPT_screenshot_depth_alpha"`
-	GRAPHOPTScreenshotFileType                RestOptionsSettingsResponseGRAPHOPTScreenshotFileType                `json:"GRAPHOPT_screenshot_file_type"`
-	GRAPHOPTShadowBlur                        RestOptionsSettingsResponseGRAPHOPTShadowBlur                        `json:"GRAPHOPT_shadow_blur"`
-	GRAPHOPTShadows                           RestOptionsSettingsResponseGRAPHOPTShadows                           `json:"GRAPHOPT_shadows"`
-	GRAPHOPTSoftParticles                     RestOptionsSettingsResponseGRAPHOPTSoftParticles                     `json:"GRAPHOPT_soft_particles"`
-	GRAPHOPTSpecialfx                         RestOptionsSettingsResponseGRAPHOPTSpecialfx                         `json:"GRAPHOPT_specialfx"`
-	GRAPHOPTSsao                              RestOptionsSettingsResponseGRAPHOPTSsao                              `json:"GRAPHOPT_ssao"`
-	GRAPHOPTStabilizeHorizon                  RestOptionsSettingsResponseGRAPHOPTStabilizeHorizon                  `json:"GRAPHOPT_stabilize_horizon"`
-	GRAPHOPTStartingView                      RestOptionsSettingsResponseGRAPHOPTStartingView                      `json:"GRAPHOPT_starting_view"`
-	GRAPHOPTSteeringWheel                     RestOptionsSettingsResponseGRAPHOPTSteeringWheel                     `json:"GRAPHOPT_steering_wheel"`
-	GRAPHOPTTexture                           RestOptionsSettingsResponseGRAPHOPTTexture                           `json:"GRAPHOPT_texture"`
-	GRAPHOPTTextureStreaming                  RestOptionsSettingsResponseGRAPHOPTTextureStreaming                  `json:"GRAPHOPT_texture_streaming"`
-	GRAPHOPTTrack                             RestOptionsSettingsResponseGRAPHOPTTrack                             `json:"GRAPHOPT_track"`
-	GRAPHOPTVfov                              RestOptionsSettingsResponseGRAPHOPTVfov                              `json:"GRAPHOPT_vfov"`
-	GRAPHOPTVisibleVehicles                   RestOptionsSettingsResponseGRAPHOPTVisibleVehicles                   `json:"GRAPHOPT_visible_vehicles"`
-	MPNETWORKConnectionType                   RestOptionsSettingsResponseMPNETWORKConnectionType                   `json:"MP_NETWORK_connection_type"`
-	MPNETWORKDownstream                       RestOptionsSettingsResponseMPNETWORKDownstream                       `json:"MP_NETWORK_downstream"`
-	MPNETWORKUpstream                         RestOptionsSettingsResponseMPNETWORKUpstream                         `json:"MP_NETWORK_upstream"`
-	MULTIDownloadCustomSkins                  RestOptionsSettingsResponseMULTIDownloadCustomSkins                  `json:"MULTI_download_custom_skins"`
-	REPLAYOPTInstantReplay                    RestOptionsSettingsResponseREPLAYOPTInstantReplay                    `json:"REPLAYOPT_instant_replay"`
-	REPLAYOPTRecordHotlaps                    RestOptionsSettingsResponseREPLAYOPTRecordHotlaps                    `json:"REPLAYOPT_record_hotlaps"`
-	REPLAYOPTRecordReplays                    RestOptionsSettingsResponseREPLAYOPTRecordReplays                    `json:"REPLAYOPT_record_replays"`
-	REPLAYOPTReplayFidelity                   RestOptionsSettingsResponseREPLAYOPTReplayFidelity                   `json:"REPLAYOPT_replay_fidelity"`
-	SERVEROPTHTTPServerDocumentPath           RestOptionsSettingsResponseSERVEROPTHTTPServerDocumentPath           `json:"SERVEROPT_HTTP_server_document_path"`
-	SERVEROPTHTTPServerMaxFileSize            RestOptionsSettingsResponseSERVEROPTHTTPServerMaxFileSize            `json:"SERVEROPT_HTTP_server_max_file_size"`
-	SERVEROPTHTTPServerSendRate               RestOptionsSettingsResponseSERVEROPTHTTPServerSendRate               `json:"SERVEROPT_HTTP_server_send_rate"`
-	SERVEROPTAdminFunc                        RestOptionsSettingsResponseSERVEROPTAdminFunc                        `json:"SERVEROPT_admin_func"`
-	SERVEROPTAdminPassword                    RestOptionsSettingsResponseSERVEROPTAdminPassword                    `json:"SERVEROPT_admin_password"`
-	SERVEROPTAidMax                           interface{}                                                          `json:"SERVEROPT_aid_max"`
-	SERVEROPTAllowAiToggling                  RestOptionsSettingsResponseSERVEROPTAllowAiToggling                  `json:"SERVEROPT_allow_ai_toggling"`
-	SERVEROPTAllowAnyEvent                    RestOptionsSettingsResponseSERVEROPTAllowAnyEvent                    `json:"SERVEROPT_allow_any_event"`
-	SERVEROPTAllowHotlapCompletion            RestOptionsSettingsResponseSERVEROPTAllowHotlapCompletion            `json:"SERVEROPT_allow_hotlap_completion"`
-	SERVEROPTAllowLooseContentTransfer        RestOptionsSettingsResponseSERVEROPTAllowLooseContentTransfer        `json:"SERVEROPT_allow_loose_content_transfer"`
-	SERVEROPTAllowRaceRejoin                  RestOptionsSettingsResponseSERVEROPTAllowRaceRejoin                  `json:"SERVEROPT_allow_race_rejoin"`
-	SERVEROPTAllowsDriverHotswap              RestOptionsSettingsResponseSERVEROPTAllowsDriverHotswap              `json:"SERVEROPT_allows_driver_hotswap"`
-	SERVEROPTAllowsSpectatorChat              RestOptionsSettingsResponseSERVEROPTAllowsSpectatorChat              `json:"SERVEROPT_allows_spectator_chat"`
-	SERVEROPTAssignParking                    RestOptionsSettingsResponseSERVEROPTAssignParking                    `json:"SERVEROPT_assign_parking"`
-	SERVEROPTClientFuelVisible                RestOptionsSettingsResponseSERVEROPTClientFuelVisible                `json:"SERVEROPT_client_fuel_visible"`
-	SERVEROPTClientWait                       RestOptionsSettingsResponseSERVEROPTClientWait                       `json:"SERVEROPT_client_wait"`
-	SERVEROPTClosedQualifySession             RestOptionsSettingsResponseSERVEROPTClosedQualifySession             `json:"SERVEROPT_closed_qualify_session"`
-	SERVEROPTClosedRaceSession                RestOptionsSettingsResponseSERVEROPTClosedRaceSession                `json:"SERVEROPT_closed_race_session"`
-	SERVEROPTCollThreshold                    RestOptionsSettingsResponseSERVEROPTCollThreshold                    `json:"SERVEROPT_coll_threshold"`
-	SERVEROPTDedicatedLoadingPrio             RestOptionsSettingsResponseSERVEROPTDedicatedLoadingPrio             `json:"SERVEROPT_dedicated_loading_prio"`
-	SERVEROPTDedicatedLoadingSleep            RestOptionsSettingsResponseSERVEROPTDedicatedLoadingSleep            `json:"SERVEROPT_dedicated_loading_sleep"`
-	SERVEROPTDefaultName                      RestOptionsSettingsResponseSERVEROPTDefaultName                      `json:"SERVEROPT_default_name"`
-	SERVEROPTDelayAfterRace                   RestOptionsSettingsResponseSERVEROPTDelayAfterRace                   `json:"SERVEROPT_delay_after_race"`
-	SERVEROPTDelayBetweenSessions             RestOptionsSettingsResponseSERVEROPTDelayBetweenSessions             `json:"SERVEROPT_delay_between_sessions"`
-	SERVEROPTEnableAutodownloads              RestOptionsSettingsResponseSERVEROPTEnableAutodownloads              `json:"SERVEROPT_enable_autodownloads"`
-	SERVEROPTEnforceRealName                  RestOptionsSettingsResponseSERVEROPTEnforceRealName                  `json:"SERVEROPT_enforce_real_name"`
-	SERVEROPTForceDrivingView                 RestOptionsSettingsResponseSERVEROPTForceDrivingView                 `json:"SERVEROPT_force_driving_view"`
-	SERVEROPTIsolationCode                    RestOptionsSettingsResponseSERVEROPTIsolationCode                    `json:"SERVEROPT_isolation_code"`
-	SERVEROPTJoinPassword                     RestOptionsSettingsResponseSERVEROPTJoinPassword                     `json:"SERVEROPT_join_password"`
-	SERVEROPTLessenRestrictions               RestOptionsSettingsResponseSERVEROPTLessenRestrictions               `json:"SERVEROPT_lessen_restrictions"`
-	SERVEROPTMaxClients                       RestOptionsSettingsResponseSERVEROPTMaxClients                       `json:"SERVEROPT_max_clients"`
-	SERVEROPTMaxKbpsPerClient                 RestOptionsSettingsResponseSERVEROPTMaxKbpsPerClient                 `json:"SERVEROPT_max_kbps_per_client"`
-	SERVEROPTMaxVehicles                      RestOptionsSettingsResponseSERVEROPTMaxVehicles                      `json:"SERVEROPT_max_vehicles"`
-	SERVEROPTMaximumAI                        RestOptionsSettingsResponseSERVEROPTMaximumAI                        `json:"SERVEROPT_maximum_AI"`
-	SERVEROPTMinimumAI                        RestOptionsSettingsResponseSERVEROPTMinimumAI                        `json:"SERVEROPT_minimum_AI"`
-	SERVEROPTMustBeStopped                    RestOptionsSettingsResponseSERVEROPTMustBeStopped                    `json:"SERVEROPT_must_be_stopped"`
-	SERVEROPTPauseIfNoHumans                  RestOptionsSettingsResponseSERVEROPTPauseIfNoHumans                  `json:"SERVEROPT_pause_if_no_humans"`
-	SERVEROPTPauseStartOfFirstSession         RestOptionsSettingsResponseSERVEROPTPauseStartOfFirstSession         `json:"SERVEROPT_pause_start_of_first_session"`
-	SERVEROPTPitSpeedOverride                 RestOptionsSettingsResponseSERVEROPTPitSpeedOverride                 `json:"SERVEROPT_pit_speed_override"`
-	SERVEROPTPluginHeartbeatRate              RestOptionsSettingsResponseSERVEROPTPluginHeartbeatRate              `json:"SERVEROPT_plugin_heartbeat_rate"`
-	SERVEROPTPractice1Time                    RestOptionsSettingsResponseSERVEROPTPractice1Time                    `json:"SERVEROPT_practice1_time"`
-	SERVEROPTQualifyLaps                      RestOptionsSettingsResponseSERVEROPTQualifyLaps                      `json:"SERVEROPT_qualify_laps"`
-	SERVEROPTQualifyTime                      RestOptionsSettingsResponseSERVEROPTQualifyTime                      `json:"SERVEROPT_qualify_time"`
-	SERVEROPTSessionEndTimeout                RestOptionsSettingsResponseSERVEROPTSessionEndTimeout                `json:"SERVEROPT_session_end_timeout"`
-	SERVEROPTSuperadminPassword               RestOptionsSettingsResponseSERVEROPTSuperadminPassword               `json:"SERVEROPT_superadmin_password"`
-	SERVEROPTTestDay                          RestOptionsSettingsResponseSERVEROPTTestDay                          `json:"SERVEROPT_test_day"`
-	SERVEROPTUniqueVehicles                   RestOptionsSettingsResponseSERVEROPTUniqueVehicles                   `json:"SERVEROPT_unique_vehicles"`
-	SERVEROPTUnthrottleId                     interface{}                                                          `json:"SERVEROPT_unthrottle_id"`
-	SERVEROPTUnthrottlePrefix                 RestOptionsSettingsResponseSERVEROPTUnthrottlePrefix                 `json:"SERVEROPT_unthrottle_prefix"`
-	SERVEROPTVoteMaxRaceRestarts              RestOptionsSettingsResponseSERVEROPTVoteMaxRaceRestarts              `json:"SERVEROPT_vote_max_race_restarts"`
-	SERVEROPTVoteMinVoters                    RestOptionsSettingsResponseSERVEROPTVoteMinVoters                    `json:"SERVEROPT_vote_min_voters"`
-	SERVEROPTVotePctAddai                     RestOptionsSettingsResponseSERVEROPTVotePctAddai                     `json:"SERVEROPT_vote_pct_addai"`
-	SERVEROPTVotePctNextsession               RestOptionsSettingsResponseSERVEROPTVotePctNextsession               `json:"SERVEROPT_vote_pct_nextsession"`
-	SERVEROPTVotePctOther                     RestOptionsSettingsResponseSERVEROPTVotePctOther                     `json:"SERVEROPT_vote_pct_other"`
-	SERVEROPTWarmupTime                       RestOptionsSettingsResponseSERVEROPTWarmupTime                       `json:"SERVEROPT_warmup_time"`
-	SOUNDOPTChannels                          RestOptionsSettingsResponseSOUNDOPTChannels                          `json:"SOUNDOPT_Channels"`
-	SOUNDOPTEngineVol                         RestOptionsSettingsResponseSOUNDOPTEngineVol                         `json:"SOUNDOPT_engine_vol"`
-	SOUNDOPTExternalVolRatio                  RestOptionsSettingsResponseSOUNDOPTExternalVolRatio                  `json:"SOUNDOPT_external_vol_ratio"`
-	SOUNDOPTHrtf                              RestOptionsSettingsResponseSOUNDOPTHrtf                              `json:"SOUNDOPT_hrtf"`
-	SOUNDOPTMasterVol                         RestOptionsSettingsResponseSOUNDOPTMasterVol                         `json:"SOUNDOPT_master_vol"`
-	SOUNDOPTMaxEffects                        RestOptionsSettingsResponseSOUNDOPTMaxEffects                        `json:"SOUNDOPT_max_effects"`
-	SOUNDOPTMicMode                           RestOptionsSettingsResponseSOUNDOPTMicMode                           `json:"SOUNDOPT_mic_mode"`
-	SOUNDOPTOnboardVol                        RestOptionsSettingsResponseSOUNDOPTOnboardVol                        `json:"SOUNDOPT_onboard_vol"`
-	SOUNDOPTOptionsVol                        RestOptionsSettingsResponseSOUNDOPTOptionsVol                        `json:"SOUNDOPT_options_vol"`
-	SOUNDOPTPlayerVolRatio                    RestOptionsSettingsResponseSOUNDOPTPlayerVolRatio                    `json:"SOUNDOPT_player_vol_ratio"`
-	SOUNDOPTSoundfxVol                        RestOptionsSettingsResponseSOUNDOPTSoundfxVol                        `json:"SOUNDOPT_soundfx_vol"`
-	SOUNDOPTSpotterVol                        RestOptionsSettingsResponseSOUNDOPTSpotterVol                        `json:"SOUNDOPT_spotter_vol"`
-	SOUNDOPTTireVolRatio                      RestOptionsSettingsResponseSOUNDOPTTireVolRatio                      `json:"SOUNDOPT_tire_vol_ratio"`
-	VIDEOOPTAPPLYCHANGES                      interface{}                                                          `json:"VIDEOOPT_APPLY_CHANGES"`
-	VIDEOOPTMULTIVIEW                         RestOptionsSettingsResponseVIDEOOPTMULTIVIEW                         `json:"VIDEOOPT_MULTIVIEW"`
-	VIDEOOPTPOSTFXAA                          RestOptionsSettingsResponseVIDEOOPTPOSTFXAA                          `json:"VIDEOOPT_POSTFXAA"`
-	VIDEOOPTVIDEOMODE                         RestOptionsSettingsResponseVIDEOOPTVIDEOMODE                         `json:"VIDEOOPT_VIDEOMODE"`
-	VIDEOOPTVIDMaxHRes                        RestOptionsSettingsResponseVIDEOOPTVIDMaxHRes                        `json:"VIDEOOPT_VIDMaxHRes"`
-	VIDEOOPTVIDMaxVRes                        RestOptionsSettingsResponseVIDEOOPTVIDMaxVRes                        `json:"VIDEOOPT_VIDMaxVRes"`
-	VIDEOOPTVIDMinHRes                        RestOptionsSettingsResponseVIDEOOPTVIDMinHRes                        `json:"VIDEOOPT_VIDMinHRes"`
-	VIDEOOPTVIDMinVRes                        RestOptionsSettingsResponseVIDEOOPTVIDMinVRes                        `json:"VIDEOOPT_VIDMinVRes"`
-	VIDEOOPTVIDdriver                         RestOptionsSettingsResponseVIDEOOPTVIDdriver                         `json:"VIDEOOPT_VIDdriver"`
-	VIDEOOPTVIDfsaa                           RestOptionsSettingsResponseVIDEOOPTVIDfsaa                           `json:"VIDEOOPT_VIDfsaa"`
-	VIDEOOPTVIDrefresh                        RestOptionsSettingsResponseVIDEOOPTVIDrefresh                        `json:"VIDEOOPT_VIDrefresh"`
-	VIDEOOPTVIDvsync                          RestOptionsSettingsResponseVIDEOOPTVIDvsync                          `json:"VIDEOOPT_VIDvsync"`
-	VIDEOOPTVIDwidescreenHUD                  RestOptionsSettingsResponseVIDEOOPTVIDwidescreenHUD                  `json:"VIDEOOPT_VIDwidescreenHUD"`
-	VIDEOOPTVIDwidescreenUI                   RestOptionsSettingsResponseVIDEOOPTVIDwidescreenUI                   `json:"VIDEOOPT_VIDwidescreenUI"`
-	VIDEOOPTVIDwindowMode                     RestOptionsSettingsResponseVIDEOOPTVIDwindowMode                     `json:"VIDEOOPT_VIDwindowMode"`
-	VIDEOOPTVRSetting                         RestOptionsSettingsResponseVIDEOOPTVRSetting                         `json:"VIDEOOPT_VR_setting"`
-	VIDEOOPTPostprocessLevel                  RestOptionsSettingsResponseVIDEOOPTPostprocessLevel                  `json:"VIDEOOPT_postprocess_level"`
-}
-
-type RestOptionsSettingsResponseCONTROLAutoReverse struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseCONTROLTrackIr struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDISPLAYOPTShowroom struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSAntilockBrakes struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSAutoBlip struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSAutoClutch struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSAutoHeadlights struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSAutoLift struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSAutoWipers struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSAutomaticPitSpeedLimit struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSAutopit struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSBrakeHelp struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSHoldBrakes struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSHoldClutch struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSInvulnerable struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSOppositeLock struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSRepeatShifts struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSShiftMode struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSSpinRecovery struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSStabilityControl struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSStartEngine struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSSteeringHelp struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSThrottleControl struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseDRIVEAIDSVisFastLine struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTAiAggression struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTAiDriverstrength struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTBroadcastCustomCameras struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTBroadcastGroupLap1range struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTBroadcastGroupMode struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTBroadcastGroupRange struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTBroadcastGroupZoommax struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTBroadcastGroupZoomstrength struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTBroadcastPersistentZoom struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTBroadcastTrackingchangeBlend struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTBroadcastTrackingchangeMaxtimediff struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTBroadcastTrackingchangeTimedelay struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTDamagemultiplier struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTDeleteSaveDataRaceEnd struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTEnablePlugins struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTKph struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTKw struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTRememberOnboardChanges struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTSpotterDetail struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTSpotterLaptimes struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTTelemetry struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTTransparentTrainerLap struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTTransparentTrainerLeadTime struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGAMEOPTUnits struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTCarVibrationMult1 struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTDriverLabels struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTDriverOverlayFlags struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTDriverOverlayHud struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTDriverOverlayInfoCar struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTDriverOverlayInfoTiming struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTDriverOverlayNotificationsChat struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTDriverOverlayNotificationsGame struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTDriverOverlayNotificationsRace struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTDriverOverlayRadar struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTDriverOverlayStandings struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTDriverOverlayTrackmap struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTEnvReflections struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTExaggerateYaw struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTExtraVisibleVehicles struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTHUDUltrawideRatio struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTHeadPhysics struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTLiveTvDisplays struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTLookaheadRadians struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMaxFramerate struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMotionblur struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewCenterBezelGap struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewCenterEyeDistance struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewCenterScreenHeight struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewCenterScreenWidth struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewCenterSideAngle struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewLeftBezelGap struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewLeftEyeDistance struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewLeftScreenHeight struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewLeftScreenWidth struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewLeftSideAngle struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewMode struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewRightBezelGap struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewRightEyeDistance struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewRightScreenHeight struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewRightScreenWidth struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewRightSideAngle struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewSharedBezelGap struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewSharedEyeDistance struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewSharedScreenHeight struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewSharedScreenWidth struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTMultiviewSharedSideAngle struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTOpponentDetail struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTPlayerDetail struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTRainDrops struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTRearview struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTRoadReflections struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTScreenshotDepthAlpha struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTScreenshotFileType struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTShadowBlur struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTShadows struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTSoftParticles struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTSpecialfx struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTSsao struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTStabilizeHorizon struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTStartingView struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTSteeringWheel struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTTexture struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTTextureFilter struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTTextureStreaming struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTTrack struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTVRHudDepth struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTVRHudScale struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTVRIPDScale struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTVRMenuDepth struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTVRMenuScale struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTVfov struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseGRAPHOPTVisibleVehicles struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseMPNETWORKConnectionType struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseMPNETWORKDownstream struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseMPNETWORKUpstream struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseMULTIDownloadCustomSkins struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseREPLAYOPTInstantReplay struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseREPLAYOPTRecordHotlaps struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseREPLAYOPTRecordReplays struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseREPLAYOPTReplayFidelity struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTAdminFunc struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTAdminPassword struct {
-	StringValue string `json:"stringValue"`
-	ValueType   string `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTAllowAiToggling struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTAllowAnyEvent struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTAllowHotlapCompletion struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTAllowLooseContentTransfer struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTAllowRaceRejoin struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTAllowsDriverHotswap struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTAllowsSpectatorChat struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTAssignParking struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTClientFuelVisible struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTClientWait struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTClosedQualifySession struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTClosedRaceSession struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTCollThreshold struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTDedicatedLoadingPrio struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTDedicatedLoadingSleep struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTDefaultName struct {
-	StringValue string `json:"stringValue"`
-	ValueType   string `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTDelayAfterRace struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTDelayBetweenSessions struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTEnableAutodownloads struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTEnforceRealName struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTForceDrivingView struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTHTTPServerDocumentPath struct {
-	StringValue string `json:"stringValue"`
-	ValueType   string `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTHTTPServerMaxFileSize struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTHTTPServerSendRate struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTIsolationCode struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTJoinPassword struct {
-	StringValue string `json:"stringValue"`
-	ValueType   string `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTLessenRestrictions struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTMaxClients struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTMaxKbpsPerClient struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTMaxVehicles struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTMaximumAI struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTMinimumAI struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTMustBeStopped struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTPauseIfNoHumans struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTPauseStartOfFirstSession struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTPitSpeedOverride struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTPluginHeartbeatRate struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTPractice1Time struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTQualifyLaps struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTQualifyTime struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTSessionEndTimeout struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTSuperadminPassword struct {
-	StringValue string `json:"stringValue"`
-	ValueType   string `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTTestDay struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTUniqueVehicles struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTUnthrottlePrefix struct {
-	StringValue string `json:"stringValue"`
-	ValueType   string `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTVoteMaxRaceRestarts struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTVoteMinVoters struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTVotePctAddai struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTVotePctNextsession struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTVotePctOther struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSERVEROPTWarmupTime struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTChannels struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTEngineVol struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTExternalVolRatio struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTHrtf struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTMasterVol struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTMaxEffects struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTMicMode struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTOnboardVol struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTOptionsVol struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTPlayerVolRatio struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTSoundfxVol struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTSpotterVol struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseSOUNDOPTTireVolRatio struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTMULTIVIEW struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTPOSTFXAA struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTPostprocessLevel struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDEOMODE struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDMaxHRes struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDMaxVRes struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDMinHRes struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDMinVRes struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDdriver struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDfsaa struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDrefresh struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDvsync struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDwidescreenHUD struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDwidescreenUI struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVIDwindowMode struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsSettingsResponseVIDEOOPTVRSetting struct {
-	CurrentValue float64 `json:"currentValue"`
-	MaxValue     float64 `json:"maxValue"`
-	MinValue     float64 `json:"minValue"`
-	StepValue    float64 `json:"stepValue"`
-	StringValue  string  `json:"stringValue"`
-	ValueType    string  `json:"valueType"`
-}
-
-type RestOptionsUIScreenControlsResponse struct {
-	AllControls RestOptionsUIScreenControlsResponseAllControls `json:"allControls"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControls struct {
-	DirectInput RestOptionsUIScreenControlsResponseAllControlsDirectInput `json:"directInput"`
-	Gamepad     RestOptionsUIScreenControlsResponseAllControlsGamepad     `json:"gamepad"`
-	Global      RestOptionsUIScreenControlsResponseAllControlsGlobal      `json:"global"`
-	Keyboard    RestOptionsUIScreenControlsResponseAllControlsKeyboard    `json:"keyboard"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInput struct {
-	AlternativeInput RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInput `json:"Alternative Input"`
-	Devices          RestOptionsUIScreenControlsResponseAllControlsDirectInputDevices          `json:"Devices"`
-	Input            RestOptionsUIScreenControlsResponseAllControlsDirectInputInput            `json:"Input"`
-	Type             string                                                                    `json:"Type"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInput struct {
-	DecrementMotorMap        RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputDecrementMotorMap        `json:"Decrement Motor Map"`
-	DriverOverlayNextMFD     RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputDriverOverlayNextMFD     `json:"Driver Overlay Next MFD"`
-	DriverOverlayPreviousMFD RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputDriverOverlayPreviousMFD `json:"Driver Overlay Previous MFD"`
-	IncrementMotorMap        RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputIncrementMotorMap        `json:"Increment Motor Map"`
-	LookLeft                 RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputLookLeft                 `json:"Look Left"`
-	LookRight                RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputLookRight                `json:"Look Right"`
-	PitMenuDec               RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputPitMenuDec               `json:"Pit Menu Dec"`
-	PitMenuDown              RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputPitMenuDown              `json:"Pit Menu Down"`
-	PitMenuInc               RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputPitMenuInc               `json:"Pit Menu Inc"`
-	PitMenuUp                RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputPitMenuUp                `json:"Pit Menu Up"`
-	TractionControlDown      RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputTractionControlDown      `json:"Traction Control Down"`
-	TractionControlUp        RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputTractionControlUp        `json:"Traction Control Up"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputDecrementMotorMap struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputDriverOverlayNextMFD struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputDriverOverlayPreviousMFD struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputIncrementMotorMap struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputLookLeft struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputLookRight struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputPitMenuDec struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputPitMenuDown struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputPitMenuInc struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputPitMenuUp struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputTractionControlDown struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputAlternativeInputTractionControlUp struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevices struct {
-	FANATECWheelFSDeviceWheelDD6129E0E91B9A705E RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705E `json:"FANATEC Wheel:FSDeviceWheelDD-6129E0E91B9A705E"`
-	VJoyDevice92B70E8405695412                  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412                  `json:"vJoy Device-92B70E8405695412"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705E struct {
-	ForceFeedback       RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EForceFeedback       `json:"Force Feedback"`
-	Type                string                                                                                                                         `json:"Type"`
-	InputAxisProperties RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisProperties `json:"input axis properties"`
-	InstanceName        string                                                                                                                         `json:"instance name"`
-	Layout              RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705ELayout              `json:"layout"`
-	Options             RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EOptions             `json:"options"`
-	ProductGuid         string                                                                                                                         `json:"product guid"`
-	ProductName         string                                                                                                                         `json:"product name"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EForceFeedback struct {
-	Enabled                   bool    `json:"Enabled"`
-	InvertForceFeedback       bool    `json:"Invert Force Feedback"`
-	JoltMagnitude             float64 `json:"Jolt magnitude"`
-	SteeringBumpStopDegrees   float64 `json:"Steering bump stop degrees"`
-	SteeringEffectsStrength   float64 `json:"Steering effects strength"`
-	SteeringTorqueMinimum     float64 `json:"Steering torque minimum"`
-	SteeringTorqueSensitivity float64 `json:"Steering torque sensitivity"`
-	ConstantSteeringForce     bool    `json:"constant steering force"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisProperties struct {
-	RX  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRX  `json:"RX+"`
-	RX2 RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRX2 `json:"RX-"`
-	RY  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRY  `json:"RY+"`
-	RY2 RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRY2 `json:"RY-"`
-	RZ  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRZ  `json:"RZ+"`
-	RZ2 RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRZ2 `json:"RZ-"`
-	S0  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesS0  `json:"S0+"`
-	S02 RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesS02 `json:"S0-"`
-	S1  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesS1  `json:"S1+"`
-	S12 RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesS12 `json:"S1-"`
-	X   RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesX   `json:"X+"`
-	X2  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesX2  `json:"X-"`
-	Y   RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesY   `json:"Y+"`
-	Y2  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesY2  `json:"Y-"`
-	Z   RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesZ   `json:"Z+"`
-	Z2  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesZ2  `json:"Z-"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRX struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRX2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRY struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRY2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRZ struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesRZ2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesS0 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesS02 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesS1 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesS12 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesX struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesX2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesY struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesY2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesZ struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EInputAxisPropertiesZ2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705ELayout struct {
-	Axes    float64 `json:"axes"`
-	Buttons float64 `json:"buttons"`
-	Povs    float64 `json:"povs"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesFANATECWheelFSDeviceWheelDD6129E0E91B9A705EOptions struct {
-	BrakeBiasAxis                          bool    `json:"Brake Bias Axis"`
-	BrakeSensitivity                       float64 `json:"Brake Sensitivity"`
-	ClutchSensitivity                      float64 `json:"Clutch Sensitivity"`
-	GearSelectButtonHold                   bool    `json:"Gear Select Button Hold"`
-	Hardware3DigitDisplay                  float64 `json:"Hardware 3Digit Display"`
-	KeyboardBrake                          float64 `json:"Keyboard Brake"`
-	KeyboardClutch                         float64 `json:"Keyboard Clutch"`
-	KeyboardThrottle                       float64 `json:"Keyboard Throttle"`
-	NeverReleaseDevice                     bool    `json:"Never Release Device"`
-	SensitivityType                        float64 `json:"Sensitivity Type"`
-	SteeringAggression                     float64 `json:"Steering Aggression"`
-	SteeringRateFactor                     float64 `json:"Steering Rate Factor"`
-	SteeringReturnRateMultiplier           float64 `json:"Steering Return Rate Multiplier"`
-	SteeringSensitivity                    float64 `json:"Steering Sensitivity"`
-	SteeringSnapFactor                     float64 `json:"Steering Snap Factor"`
-	SteeringSpeedSensitivity               float64 `json:"Steering Speed Sensitivity"`
-	SteeringWheelMaximumRotation           float64 `json:"Steering Wheel Maximum Rotation"`
-	SteeringWheelMaximumRotationFromDriver bool    `json:"Steering Wheel Maximum Rotation From Driver"`
-	ThrottleSensitivity                    float64 `json:"Throttle Sensitivity"`
-	UseLeds                                bool    `json:"use leds"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412 struct {
-	ForceFeedback       RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412ForceFeedback       `json:"Force Feedback"`
-	Type                string                                                                                                        `json:"Type"`
-	InputAxisProperties RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisProperties `json:"input axis properties"`
-	InstanceName        string                                                                                                        `json:"instance name"`
-	Layout              RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412Layout              `json:"layout"`
-	Options             RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412Options             `json:"options"`
-	ProductGuid         string                                                                                                        `json:"product guid"`
-	ProductName         string                                                                                                        `json:"product name"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412ForceFeedback struct {
-	Enabled                   bool    `json:"Enabled"`
-	InvertForceFeedback       bool    `json:"Invert Force Feedback"`
-	JoltMagnitude             float64 `json:"Jolt magnitude"`
-	SteeringBumpStopDegrees   float64 `json:"Steering bump stop degrees"`
-	SteeringEffectsStrength   float64 `json:"Steering effects strength"`
-	SteeringTorqueMinimum     float64 `json:"Steering torque minimum"`
-	SteeringTorqueSensitivity float64 `json:"Steering torque sensitivity"`
-	ConstantSteeringForce     bool    `json:"constant steering force"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisProperties struct {
-	RX  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRX  `json:"RX+"`
-	RX2 RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRX2 `json:"RX-"`
-	RY  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRY  `json:"RY+"`
-	RY2 RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRY2 `json:"RY-"`
-	RZ  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRZ  `json:"RZ+"`
-	RZ2 RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRZ2 `json:"RZ-"`
-	S0  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesS0  `json:"S0+"`
-	S02 RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesS02 `json:"S0-"`
-	S1  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesS1  `json:"S1+"`
-	S12 RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesS12 `json:"S1-"`
-	X   RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesX   `json:"X+"`
-	X2  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesX2  `json:"X-"`
-	Y   RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesY   `json:"Y+"`
-	Y2  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesY2  `json:"Y-"`
-	Z   RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesZ   `json:"Z+"`
-	Z2  RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesZ2  `json:"Z-"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRX struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRX2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRY struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRY2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRZ struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesRZ2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesS0 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesS02 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesS1 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesS12 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesX struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesX2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesY struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesY2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesZ struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412InputAxisPropertiesZ2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412Layout struct {
-	Axes    float64 `json:"axes"`
-	Buttons float64 `json:"buttons"`
-	Povs    float64 `json:"povs"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputDevicesVJoyDevice92B70E8405695412Options struct {
-	BrakeBiasAxis                          bool    `json:"Brake Bias Axis"`
-	BrakeSensitivity                       float64 `json:"Brake Sensitivity"`
-	ClutchSensitivity                      float64 `json:"Clutch Sensitivity"`
-	GearSelectButtonHold                   bool    `json:"Gear Select Button Hold"`
-	Hardware3DigitDisplay                  float64 `json:"Hardware 3Digit Display"`
-	KeyboardBrake                          float64 `json:"Keyboard Brake"`
-	KeyboardClutch                         float64 `json:"Keyboard Clutch"`
-	KeyboardThrottle                       float64 `json:"Keyboard Throttle"`
-	NeverReleaseDevice                     bool    `json:"Never Release Device"`
-	SensitivityType                        float64 `json:"Sensitivity Type"`
-	SteeringAggression                     float64 `json:"Steering Aggression"`
-	SteeringRateFactor                     float64 `json:"Steering Rate Factor"`
-	SteeringReturnRateMultiplier           float64 `json:"Steering Return Rate Multiplier"`
-	SteeringSensitivity                    float64 `json:"Steering Sensitivity"`
-	SteeringSnapFactor                     float64 `json:"Steering Snap Factor"`
-	SteeringSpeedSensitivity               float64 `json:"Steering Speed Sensitivity"`
-	SteeringWheelMaximumRotation           float64 `json:"Steering Wheel Maximum Rotation"`
-	SteeringWheelMaximumRotationFromDriver bool    `json:"Steering Wheel Maximum Rotation From Driver"`
-	ThrottleSensitivity                    float64 `json:"Throttle Sensitivity"`
-	UseLeds                                bool    `json:"use leds"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInput struct {
-	AlternateEsc         RestOptionsUIScreenControlsResponseAllControlsDirectInputInputAlternateEsc         `json:"Alternate Esc"`
-	BiasForward          RestOptionsUIScreenControlsResponseAllControlsDirectInputInputBiasForward          `json:"Bias Forward"`
-	BiasRearward         RestOptionsUIScreenControlsResponseAllControlsDirectInputInputBiasRearward         `json:"Bias Rearward"`
-	Brake                RestOptionsUIScreenControlsResponseAllControlsDirectInputInputBrake                `json:"Brake"`
-	ClutchIn             RestOptionsUIScreenControlsResponseAllControlsDirectInputInputClutchIn             `json:"Clutch In"`
-	DecrementMotorMap    RestOptionsUIScreenControlsResponseAllControlsDirectInputInputDecrementMotorMap    `json:"Decrement Motor Map"`
-	DriverOverlayNextMFD RestOptionsUIScreenControlsResponseAllControlsDirectInputInputDriverOverlayNextMFD `json:"Driver Overlay Next MFD"`
-	DrivingCameras       RestOptionsUIScreenControlsResponseAllControlsDirectInputInputDrivingCameras       `json:"Driving Cameras"`
-	Headlights           RestOptionsUIScreenControlsResponseAllControlsDirectInputInputHeadlights           `json:"Headlights"`
-	HeadlightsPulse      RestOptionsUIScreenControlsResponseAllControlsDirectInputInputHeadlightsPulse      `json:"Headlights Pulse"`
-	IncrementMotorMap    RestOptionsUIScreenControlsResponseAllControlsDirectInputInputIncrementMotorMap    `json:"Increment Motor Map"`
-	PitMenuDec           RestOptionsUIScreenControlsResponseAllControlsDirectInputInputPitMenuDec           `json:"Pit Menu Dec"`
-	PitMenuDown          RestOptionsUIScreenControlsResponseAllControlsDirectInputInputPitMenuDown          `json:"Pit Menu Down"`
-	PitMenuInc           RestOptionsUIScreenControlsResponseAllControlsDirectInputInputPitMenuInc           `json:"Pit Menu Inc"`
-	PitMenuUp            RestOptionsUIScreenControlsResponseAllControlsDirectInputInputPitMenuUp            `json:"Pit Menu Up"`
-	PitRequest           RestOptionsUIScreenControlsResponseAllControlsDirectInputInputPitRequest           `json:"Pit Request"`
-	RearLook             RestOptionsUIScreenControlsResponseAllControlsDirectInputInputRearLook             `json:"Rear Look"`
-	ShiftDown            RestOptionsUIScreenControlsResponseAllControlsDirectInputInputShiftDown            `json:"Shift Down"`
-	ShiftUp              RestOptionsUIScreenControlsResponseAllControlsDirectInputInputShiftUp              `json:"Shift Up"`
-	SpeedLimiter         RestOptionsUIScreenControlsResponseAllControlsDirectInputInputSpeedLimiter         `json:"Speed Limiter"`
-	SteerLeft            RestOptionsUIScreenControlsResponseAllControlsDirectInputInputSteerLeft            `json:"Steer Left"`
-	SteerRight           RestOptionsUIScreenControlsResponseAllControlsDirectInputInputSteerRight           `json:"Steer Right"`
-	TCOverride           RestOptionsUIScreenControlsResponseAllControlsDirectInputInputTCOverride           `json:"TCOverride"`
-	Throttle             RestOptionsUIScreenControlsResponseAllControlsDirectInputInputThrottle             `json:"Throttle"`
-	TractionControlDown  RestOptionsUIScreenControlsResponseAllControlsDirectInputInputTractionControlDown  `json:"Traction Control Down"`
-	TractionControlUp    RestOptionsUIScreenControlsResponseAllControlsDirectInputInputTractionControlUp    `json:"Traction Control Up"`
-	UIMouseClick         RestOptionsUIScreenControlsResponseAllControlsDirectInputInputUIMouseClick         `json:"UI : Mouse Click"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputAlternateEsc struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputBiasForward struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputBiasRearward struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputBrake struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputClutchIn struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputDecrementMotorMap struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputDriverOverlayNextMFD struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputDrivingCameras struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputHeadlights struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputHeadlightsPulse struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputIncrementMotorMap struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputPitMenuDec struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputPitMenuDown struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputPitMenuInc struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputPitMenuUp struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputPitRequest struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputRearLook struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputShiftDown struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputShiftUp struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputSpeedLimiter struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputSteerLeft struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputSteerRight struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputTCOverride struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputThrottle struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputTractionControlDown struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputTractionControlUp struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsDirectInputInputUIMouseClick struct {
-	Device string  `json:"device"`
-	Id     float64 `json:"id"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepad struct {
-	Device RestOptionsUIScreenControlsResponseAllControlsGamepadDevice `json:"Device"`
-	Input  RestOptionsUIScreenControlsResponseAllControlsGamepadInput  `json:"Input"`
-	Type   string                                                      `json:"Type"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDevice struct {
-	Connected           bool                                                                           `json:"Connected"`
-	ForceFeedback       RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceForceFeedback       `json:"Force Feedback"`
-	InputNames          []string                                                                       `json:"Input Names"`
-	InputAxisProperties RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisProperties `json:"input axis properties"`
-	Options             RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceOptions             `json:"options"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceForceFeedback struct {
-	Enabled                   bool    `json:"Enabled"`
-	InvertForceFeedback       bool    `json:"Invert Force Feedback"`
-	JoltMagnitude             float64 `json:"Jolt magnitude"`
-	SteeringBumpStopDegrees   float64 `json:"Steering bump stop degrees"`
-	SteeringEffectsStrength   float64 `json:"Steering effects strength"`
-	SteeringTorqueMinimum     float64 `json:"Steering torque minimum"`
-	SteeringTorqueSensitivity float64 `json:"Steering torque sensitivity"`
-	ConstantSteeringForce     bool    `json:"constant steering force"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisProperties struct {
-	LeftThumbX   RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesLeftThumbX   `json:"Left Thumb X+"`
-	LeftThumbX2  RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesLeftThumbX2  `json:"Left Thumb X-"`
-	LeftThumbY   RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesLeftThumbY   `json:"Left Thumb Y+"`
-	LeftThumbY2  RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesLeftThumbY2  `json:"Left Thumb Y-"`
-	LeftTrigger  RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesLeftTrigger  `json:"Left Trigger"`
-	RightThumbX  RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesRightThumbX  `json:"Right Thumb X+"`
-	RightThumbX2 RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesRightThumbX2 `json:"Right Thumb X-"`
-	RightThumbY  RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesRightThumbY  `json:"Right Thumb Y+"`
-	RightThumbY2 RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesRightThumbY2 `json:"Right Thumb Y-"`
-	RightTrigger RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesRightTrigger `json:"Right Trigger"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesLeftThumbX struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesLeftThumbX2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesLeftThumbY struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesLeftThumbY2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesLeftTrigger struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesRightThumbX struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesRightThumbX2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesRightThumbY struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesRightThumbY2 struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceInputAxisPropertiesRightTrigger struct {
-	Center float64 `json:"center"`
-	Max    float64 `json:"max"`
-	Min    float64 `json:"min"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadDeviceOptions struct {
-	BrakeBiasAxis                          bool    `json:"Brake Bias Axis"`
-	BrakeSensitivity                       float64 `json:"Brake Sensitivity"`
-	ClutchSensitivity                      float64 `json:"Clutch Sensitivity"`
-	GearSelectButtonHold                   bool    `json:"Gear Select Button Hold"`
-	Hardware3DigitDisplay                  float64 `json:"Hardware 3Digit Display"`
-	KeyboardBrake                          float64 `json:"Keyboard Brake"`
-	KeyboardClutch                         float64 `json:"Keyboard Clutch"`
-	KeyboardThrottle                       float64 `json:"Keyboard Throttle"`
-	NeverReleaseDevice                     bool    `json:"Never Release Device"`
-	SensitivityType                        float64 `json:"Sensitivity Type"`
-	SteeringAggression                     float64 `json:"Steering Aggression"`
-	SteeringRateFactor                     float64 `json:"Steering Rate Factor"`
-	SteeringReturnRateMultiplier           float64 `json:"Steering Return Rate Multiplier"`
-	SteeringSensitivity                    float64 `json:"Steering Sensitivity"`
-	SteeringSnapFactor                     float64 `json:"Steering Snap Factor"`
-	SteeringSpeedSensitivity               float64 `json:"Steering Speed Sensitivity"`
-	SteeringWheelMaximumRotation           float64 `json:"Steering Wheel Maximum Rotation"`
-	SteeringWheelMaximumRotationFromDriver bool    `json:"Steering Wheel Maximum Rotation From Driver"`
-	ThrottleSensitivity                    float64 `json:"Throttle Sensitivity"`
-	UseLeds                                bool    `json:"use leds"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGamepadInput struct {
-	AdjustSeatAft            float64 `json:"Adjust Seat Aft"`
-	AdjustSeatDown           float64 `json:"Adjust Seat Down"`
-	AdjustSeatFore           float64 `json:"Adjust Seat Fore"`
-	AdjustSeatUp             float64 `json:"Adjust Seat Up"`
-	AlternateEsc             float64 `json:"Alternate Esc"`
-	Brake                    float64 `json:"Brake"`
-	DecreaseVerticalFOV      float64 `json:"Decrease Vertical FOV"`
-	DriverOverlayNextMFD     float64 `json:"Driver Overlay Next MFD"`
-	DriverOverlayPreviousMFD float64 `json:"Driver Overlay Previous MFD"`
-	IncreaseVerticalFOV      float64 `json:"Increase Vertical FOV"`
-	LookLeft                 float64 `json:"Look Left"`
-	LookRight                float64 `json:"Look Right"`
-	PitRequest               float64 `json:"Pit Request"`
-	PushToChangeCameraView   float64 `json:"Push To change camera view"`
-	ShiftDown                float64 `json:"Shift Down"`
-	ShiftUp                  float64 `json:"Shift Up"`
-	SpeedLimiter             float64 `json:"Speed Limiter"`
-	SteerLeft                float64 `json:"Steer Left"`
-	SteerRight               float64 `json:"Steer Right"`
-	Throttle                 float64 `json:"Throttle"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGlobal struct {
-	AlternateNeutralActivation        bool                                                              `json:"Alternate Neutral Activation"`
-	AutoReverse                       bool                                                              `json:"Auto Reverse"`
-	Camera                            RestOptionsUIScreenControlsResponseAllControlsGlobalCamera        `json:"Camera"`
-	DirectInputFallback               bool                                                              `json:"DirectInput Fallback"`
-	ForceFeedback                     RestOptionsUIScreenControlsResponseAllControlsGlobalForceFeedback `json:"Force Feedback"`
-	RangeFromVehicle                  bool                                                              `json:"Range From Vehicle"`
-	SampleMarginGI                    float64                                                           `json:"Sample Margin GI"`
-	SteeringWheelRange                float64                                                           `json:"Steering Wheel Range"`
-	StopSequentialIfNeutralConfigured float64                                                           `json:"Stop Sequential if Neutral Configured"`
-	UseCustomGamepad                  bool                                                              `json:"Use Custom Gamepad"`
-	UseCustomKeyboard                 bool                                                              `json:"Use Custom Keyboard"`
-	UseCustomWheel                    bool                                                              `json:"Use Custom Wheel"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGlobalCamera struct {
-	CameraZoomAccel              float64 `json:"Camera Zoom Accel"`
-	CameraZoomDecel              float64 `json:"Camera Zoom Decel"`
-	CameraZoomSpeed              float64 `json:"Camera Zoom Speed"`
-	FreelookKeyboardPitchAccel   float64 `json:"Freelook Keyboard Pitch Accel"`
-	FreelookKeyboardPitchDecel   float64 `json:"Freelook Keyboard Pitch Decel"`
-	FreelookKeyboardPitchSpeed   float64 `json:"Freelook Keyboard Pitch Speed"`
-	FreelookKeyboardRollAccel    float64 `json:"Freelook Keyboard Roll Accel"`
-	FreelookKeyboardRollDecel    float64 `json:"Freelook Keyboard Roll Decel"`
-	FreelookKeyboardRollSpeed    float64 `json:"Freelook Keyboard Roll Speed"`
-	FreelookKeyboardYawAccel     float64 `json:"Freelook Keyboard Yaw Accel"`
-	FreelookKeyboardYawDecel     float64 `json:"Freelook Keyboard Yaw Decel"`
-	FreelookKeyboardYawSpeed     float64 `json:"Freelook Keyboard Yaw Speed"`
-	FreelookMinimumLodMultiplier float64 `json:"Freelook Minimum Lod Multiplier"`
-	FreelookMouseControl         bool    `json:"Freelook Mouse Control"`
-	FreelookMousePitchSpeed      float64 `json:"Freelook Mouse Pitch Speed"`
-	FreelookMouseYawSpeed        float64 `json:"Freelook Mouse Yaw Speed"`
-	FreemoveAccel                float64 `json:"Freemove Accel"`
-	FreemoveDecel                float64 `json:"Freemove Decel"`
-	FreemoveDownSpeed            float64 `json:"Freemove Down Speed"`
-	FreemoveForwardSpeed         float64 `json:"Freemove Forward Speed"`
-	FreemoveRightSpeed           float64 `json:"Freemove Right Speed"`
-	HMDForeAftRange              float64 `json:"HMD Fore/Aft Range"`
-	HMDLeftRightRange            float64 `json:"HMD Left/Right Range"`
-	HMDUpDownRange               float64 `json:"HMD Up/Down Range"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsGlobalForceFeedback struct {
-	SteeringTorqueExtrapBlend   float64 `json:"Steering torque extrap blend"`
-	SteeringTorqueExtrapTime    float64 `json:"Steering torque extrap time"`
-	SteeringTorqueFilter        float64 `json:"Steering torque filter"`
-	SteeringTorqueZeroSpeedMult float64 `json:"Steering torque zero-speed mult"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsKeyboard struct {
-	Device RestOptionsUIScreenControlsResponseAllControlsKeyboardDevice `json:"Device"`
-	Input  RestOptionsUIScreenControlsResponseAllControlsKeyboardInput  `json:"Input"`
-	Type   string                                                       `json:"Type"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsKeyboardDevice struct {
-	InputNames []string                                                            `json:"Input Names"`
-	Options    RestOptionsUIScreenControlsResponseAllControlsKeyboardDeviceOptions `json:"options"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsKeyboardDeviceOptions struct {
-	BrakeBiasAxis                          bool    `json:"Brake Bias Axis"`
-	BrakeSensitivity                       float64 `json:"Brake Sensitivity"`
-	ClutchSensitivity                      float64 `json:"Clutch Sensitivity"`
-	GearSelectButtonHold                   bool    `json:"Gear Select Button Hold"`
-	Hardware3DigitDisplay                  float64 `json:"Hardware 3Digit Display"`
-	KeyboardBrake                          float64 `json:"Keyboard Brake"`
-	KeyboardClutch                         float64 `json:"Keyboard Clutch"`
-	KeyboardThrottle                       float64 `json:"Keyboard Throttle"`
-	NeverReleaseDevice                     bool    `json:"Never Release Device"`
-	SensitivityType                        float64 `json:"Sensitivity Type"`
-	SteeringAggression                     float64 `json:"Steering Aggression"`
-	SteeringRateFactor                     float64 `json:"Steering Rate Factor"`
-	SteeringReturnRateMultiplier           float64 `json:"Steering Return Rate Multiplier"`
-	SteeringSensitivity                    float64 `json:"Steering Sensitivity"`
-	SteeringSnapFactor                     float64 `json:"Steering Snap Factor"`
-	SteeringSpeedSensitivity               float64 `json:"Steering Speed Sensitivity"`
-	SteeringWheelMaximumRotation           float64 `json:"Steering Wheel Maximum Rotation"`
-	SteeringWheelMaximumRotationFromDriver bool    `json:"Steering Wheel Maximum Rotation From Driver"`
-	ThrottleSensitivity                    float64 `json:"Throttle Sensitivity"`
-	UseLeds                                bool    `json:"use leds"`
-}
-
-type RestOptionsUIScreenControlsResponseAllControlsKeyboardInput struct {
-	AdjustSeatAft                   float64 `json:"Adjust Seat Aft"`
-	AdjustSeatDown                  float64 `json:"Adjust Seat Down"`
-	AdjustSeatFore                  float64 `json:"Adjust Seat Fore"`
-	AdjustSeatUp                    float64 `json:"Adjust Seat Up"`
-	AntilockBrakeSystemDown         float64 `json:"Antilock Brake System Down"`
-	AntilockBrakeSystemUp           float64 `json:"Antilock Brake System Up"`
-	Brake                           float64 `json:"Brake"`
-	CPUTime                         float64 `json:"CPU Time"`
-	DecreaseVerticalFOV             float64 `json:"Decrease Vertical FOV"`
-	DisplayVehicleLabels            float64 `json:"Display Vehicle Labels"`
-	DriverOverlayCycleStandingsData float64 `json:"Driver Overlay Cycle Standings Data"`
-	DriverOverlayCycleTrackMap      float64 `json:"Driver Overlay Cycle Track Map"`
-	DriverOverlayNextMFD            float64 `json:"Driver Overlay Next MFD"`
-	DriverOverlayPreviousMFD        float64 `json:"Driver Overlay Previous MFD"`
-	DriverOverlayToggleHUD          float64 `json:"Driver Overlay Toggle HUD"`
-	DrivingCameras                  float64 `json:"Driving Cameras"`
-	Framerate                       float64 `json:"Framerate"`
-	Headlights                      float64 `json:"Headlights"`
-	IncreaseVerticalFOV             float64 `json:"Increase Vertical FOV"`
-	InstantReplay                   float64 `json:"Instant Replay"`
-	LaunchControl                   float64 `json:"Launch Control"`
-	Neutral                         float64 `json:"Neutral"`
-	OnboardCameras                  float64 `json:"Onboard Cameras"`
-	PitMenuDec                      float64 `json:"Pit Menu Dec"`
-	PitMenuDown                     float64 `json:"Pit Menu Down"`
-	PitMenuInc                      float64 `json:"Pit Menu Inc"`
-	PitMenuUp                       float64 `json:"Pit Menu Up"`
-	PitRequest                      float64 `json:"Pit Request"`
-	QuickChat1                      float64 `json:"Quick Chat #1"`
-	QuickChat12                     float64 `json:"Quick Chat #12"`
-	QuickChat7                      float64 `json:"Quick Chat #7"`
-	ResetDeltaTargetLaptime         float64 `json:"Reset Delta Target Laptime"`
-	ResetForceFeedback              float64 `json:"Reset Force Feedback"`
-	RestartRace                     float64 `json:"Restart Race"`
-	Screenshot                      float64 `json:"Screenshot"`
-	SkipFormation                   float64 `json:"Skip Formation"`
-	SpectatorCameras                float64 `json:"Spectator Cameras"`
-	SteerLeft                       float64 `json:"Steer Left"`
-	SteerRight                      float64 `json:"Steer Right"`
-	SwingmanCamera                  float64 `json:"Swingman Camera"`
-	ToggleAIControl                 float64 `json:"Toggle AI Control"`
-	ToggleCursor                    float64 `json:"Toggle Cursor"`
-	ToggleMirror                    float64 `json:"Toggle Mirror"`
-	TrackingCameras                 float64 `json:"Tracking Cameras"`
-	TractionControl2Down            float64 `json:"Traction Control 2 Down"`
-	TractionControl2Up              float64 `json:"Traction Control 2 Up"`
-	TractionControlDown             float64 `json:"Traction Control Down"`
-	TractionControlUp               float64 `json:"Traction Control Up"`
-	TriplesAndTires                 float64 `json:"Triples And Tires"`
-	Wipers                          float64 `json:"Wipers"`
-}
-
-type RestProfileGetAuthSessionTicketResponse struct {
-	AuthSessionTicket string `json:"authSessionTicket"`
-}
-
-type RestProfileProfileInfoGetProfileInfoResponse struct {
-	Language    string `json:"language"`
-	Name        string `json:"name"`
-	Nationality string `json:"nationality"`
-	Nick        string `json:"nick"`
-	SteamID     string `json:"steamID"`
-}
-
-type RestProfileResponse struct {
-	Name    string `json:"name"`
-	Nick    string `json:"nick"`
-	SteamID string `json:"steamID"`
-}
-
-type RestRaceCarResponseItem struct {
-	DisplayProperties RestRaceCarResponseItemDisplayProperties `json:"displayProperties"`
-	DlcappID          float64                                  `json:"dlcappID"`
-	Engine            string                                   `json:"engine"`
-	FullPathTree      string                                   `json:"fullPathTree"`
-	Id                string                                   `json:"id"`
-	Image             string                                   `json:"image"`
-	Manufacturer      string                                   `json:"manufacturer"`
-	Name              string                                   `json:"name"`
-	Owned             bool                                     `json:"owned"`
-	PremId            float64                                  `json:"premId"`
-	Sig               string                                   `json:"sig"`
-	Thumbnail         string                                   `json:"thumbnail"`
-	VehFile           string                                   `json:"vehFile"`
-}
-
-type RestRaceCarResponseItemDisplayProperties struct {
-	DisplayName  string `json:"displayName"`
-	FullTreePath string `json:"fullTreePath"`
-}
-
-type RestRaceTrackResponseItem struct {
-	DisplayProperties RestRaceTrackResponseItemDisplayProperties `json:"displayProperties"`
-	DlcappID          float64                                    `json:"dlcappID"`
-	Id                string                                     `json:"id"`
-	Image             string                                     `json:"image"`
-	Length            string                                     `json:"length"`
-	Name              string                                     `json:"name"`
-	Owned             bool                                       `json:"owned"`
-	PremId            float64                                    `json:"premId"`
-	SceneDesc         string                                     `json:"sceneDesc"`
-	ShortName         string                                     `json:"shortName"`
-	Thumbnail         string                                     `json:"thumbnail"`
-	Type              string                                     `json:"type"`
-}
-
-type RestRaceTrackResponseItemDisplayProperties struct {
-	Name      string `json:"name"`
-	ShortName string `json:"shortName"`
-}
-
-type RestReplayCameraControllerGetCameraInfoResponse struct {
-	CameraName         string `json:"cameraName"`
-	CurrentCameraGroup string `json:"currentCameraGroup"`
-}
-
-type RestSessionsAmountResponse struct {
-	PRACTICE float64 `json:"PRACTICE"`
-	QUALIFY  float64 `json:"QUALIFY"`
-	RACE     float64 `json:"RACE"`
-	WARMUP   float64 `json:"WARMUP"`
-}
-
-type RestSessionsGetAllVehiclesResponseItem struct {
-	Classes          []string                                            `json:"classes"`
-	ClassesOverride  string                                              `json:"classesOverride"`
-	Desc             string                                              `json:"desc"`
-	DlcAppID         float64                                             `json:"dlcAppID"`
-	Drivers          []RestSessionsGetAllVehiclesResponseItemDriversItem `json:"drivers"`
-	Engine           string                                              `json:"engine"`
-	FullPathTree     string                                              `json:"fullPathTree"`
-	FullTeam         string                                              `json:"fullTeam"`
-	Id               string                                              `json:"id"`
-	IsOwned          bool                                                `json:"isOwned"`
-	LiveryName       string                                              `json:"liveryName"`
-	Manufacturer     string                                              `json:"manufacturer"`
-	Number           string                                              `json:"number"`
-	PremID           float64                                             `json:"premID"`
-	PrimaryVehFile   bool                                                `json:"primaryVehFile"`
-	Sig              string                                              `json:"sig"`
-	Team             string                                              `json:"team"`
-	TeamFounded      string                                              `json:"teamFounded"`
-	TeamHeadquarters string                                              `json:"teamHeadquarters"`
-	VehFile          string                                              `json:"vehFile"`
-	Vehicle          string                                              `json:"vehicle"`
-}
-
-type RestSessionsGetAllVehiclesResponseItemDriversItem struct {
-	Name        string `json:"name"`
-	Nationality string `json:"nationality"`
-	Skill       string `json:"skill"`
-}
-
-type RestSessionsGetGameStateResponse struct {
-	MultiStintState          string                                              `json:"MultiStintState"`
-	PitEntryDist             float64                                             `json:"PitEntryDist"`
-	PitState                 string                                              `json:"PitState"`
-	CloseestWeatherNode      RestSessionsGetGameStateResponseCloseestWeatherNode `json:"closeestWeatherNode"`
-	GamePhase                string                                              `json:"gamePhase"`
-	HaveILoadedAllMyTiresYet bool                                                `json:"haveILoadedAllMyTiresYet"`
-	InControlOfVehicle       bool                                                `json:"inControlOfVehicle"`
-	InMonitor                bool                                                `json:"inMonitor"`
-	IsReplayActive           bool                                                `json:"isReplayActive"`
-	PlayerVehicleLoaded      bool                                                `json:"playerVehicleLoaded"`
-	RaceFinished             bool                                                `json:"raceFinished"`
-	TeamVehicleState         string                                              `json:"teamVehicleState"`
-	TimeOfDay                float64                                             `json:"timeOfDay"`
-}
-
-type RestSessionsGetGameStateResponseCloseestWeatherNode struct {
-	Duration      float64 `json:"Duration"`
-	Humidity      float64 `json:"Humidity"`
-	RainChance    float64 `json:"RainChance"`
-	Sky           float64 `json:"Sky"`
-	StartTime     float64 `json:"StartTime"`
-	Temperature   float64 `json:"Temperature"`
-	WindDirection float64 `json:"WindDirection"`
-	WindSpeed     float64 `json:"WindSpeed"`
-}
-
-type RestSessionsGetSessionsInfoForEventResponse struct {
-	ScheduledSessions []RestSessionsGetSessionsInfoForEventResponseScheduledSessionsItem `json:"scheduledSessions"`
-}
-
-type RestSessionsGetSessionsInfoForEventResponseScheduledSessionsItem struct {
-	AirTemp    float64 `json:"airTemp"`
-	LengthTime float64 `json:"lengthTime"`
-	Name       string  `json:"name"`
-	RainChance float64 `json:"rainChance"`
-}
-
-type RestSessionsGetTracksInSeriesResponseItem struct {
-	CmpName                  string                                                                `json:"cmpName"`
-	Corners                  string                                                                `json:"corners"`
-	CountryCode              string                                                                `json:"countryCode"`
-	DefaultPracticeStartTime float64                                                               `json:"defaultPracticeStartTime"`
-	DefaultPracticeWeather   []RestSessionsGetTracksInSeriesResponseItemDefaultPracticeWeatherItem `json:"defaultPracticeWeather"`
-	DefaultQualifyStartTime  float64                                                               `json:"defaultQualifyStartTime"`
-	DefaultQualifyWeather    []RestSessionsGetTracksInSeriesResponseItemDefaultQualifyWeatherItem  `json:"defaultQualifyWeather"`
-	DefaultRaceLengthLaps    float64                                                               `json:"defaultRaceLengthLaps"`
-	DefaultRaceLengthTime    float64                                                               `json:"defaultRaceLengthTime"`
-	DefaultRaceStartTime     float64                                                               `json:"defaultRaceStartTime"`
-	DefaultRaceWeather       []RestSessionsGetTracksInSeriesResponseItemDefaultRaceWeatherItem     `json:"defaultRaceWeather"`
-	DlcAppID                 float64                                                               `json:"dlcAppID"`
-	EventName                string                                                                `json:"eventName"`
-	GrandPrixName            string                                                                `json:"grandPrixName"`
-	Id                       string                                                                `json:"id"`
-	Image                    string                                                                `json:"image"`
-	IsOwned                  bool                                                                  `json:"isOwned"`
-	Location                 string                                                                `json:"location"`
-	OfficialEvent            bool                                                                  `json:"officialEvent"`
-	OpeningYear              string                                                                `json:"openingYear"`
-	PremId                   float64                                                               `json:"premId"`
-	ProperTrackName          string                                                                `json:"properTrackName"`
-	SceneDesc                string                                                                `json:"sceneDesc"`
-	SceneSig                 string                                                                `json:"sceneSig"`
-	Thumbnail                string                                                                `json:"thumbnail"`
-	TrackLength              string                                                                `json:"trackLength"`
-	TrackName                string                                                                `json:"trackName"`
-	Type                     string                                                                `json:"type"`
-	Version                  string                                                                `json:"version"`
-}
-
-type RestSessionsGetTracksInSeriesResponseItemDefaultPracticeWeatherItem struct {
-	Duration      float64 `json:"Duration"`
-	Humidity      float64 `json:"Humidity"`
-	RainChance    float64 `json:"RainChance"`
-	Sky           float64 `json:"Sky"`
-	StartTime     float64 `json:"StartTime"`
-	Temperature   float64 `json:"Temperature"`
-	WindDirection float64 `json:"WindDirection"`
-	WindSpeed     float64 `json:"WindSpeed"`
-}
-
-type RestSessionsGetTracksInSeriesResponseItemDefaultQualifyWeatherItem struct {
-	Duration      float64 `json:"Duration"`
-	Humidity      float64 `json:"Humidity"`
-	RainChance    float64 `json:"RainChance"`
-	Sky           float64 `json:"Sky"`
-	StartTime     float64 `json:"StartTime"`
-	Temperature   float64 `json:"Temperature"`
-	WindDirection float64 `json:"WindDirection"`
-	WindSpeed     float64 `json:"WindSpeed"`
-}
-
-type RestSessionsGetTracksInSeriesResponseItemDefaultRaceWeatherItem struct {
-	Duration      float64 `json:"Duration"`
-	Humidity      float64 `json:"Humidity"`
-	RainChance    float64 `json:"RainChance"`
-	Sky           float64 `json:"Sky"`
-	StartTime     float64 `json:"StartTime"`
-	Temperature   float64 `json:"Temperature"`
-	WindDirection float64 `json:"WindDirection"`
-	WindSpeed     float64 `json:"WindSpeed"`
-}
-
-type RestSessionsOpponentsAllResponseItem struct {
-	Id   float64 `json:"id"`
-	Name string  `json:"name"`
-}
-
-type RestSessionsOpponentsResponseItem struct {
-	Id   float64 `json:"id"`
-	Name string  `json:"name"`
-}
-
-type RestSessionsResponse struct {
-	SESSSETAIAggression               RestSessionsResponseSESSSETAIAggression               `json:"SESSSET_AI_Aggression"`
-	SESSSETAIStrength                 RestSessionsResponseSESSSETAIStrength                 `json:"SESSSET_AI_Strength"`
-	SESSSETDamageMulti                RestSessionsResponseSESSSETDamageMulti                `json:"SESSSET_Damage_Multi"`
-	SESSSETFinishCriteria             RestSessionsResponseSESSSETFinishCriteria             `json:"SESSSET_Finish_Criteria"`
-	SESSSETFuelUsage                  RestSessionsResponseSESSSETFuelUsage                  `json:"SESSSET_Fuel_Usage"`
-	SESSSETGridPosition               RestSessionsResponseSESSSETGridPosition               `json:"SESSSET_Grid_Position"`
-	SESSSETMechFailures               RestSessionsResponseSESSSETMechFailures               `json:"SESSSET_Mech_Failures"`
-	SESSSETNumOpponents               RestSessionsResponseSESSSETNumOpponents               `json:"SESSSET_Num_Opponents"`
-	SESSSETPracticeLength             RestSessionsResponseSESSSETPracticeLength             `json:"SESSSET_Practice_Length"`
-	SESSSETQualifyLength              RestSessionsResponseSESSSETQualifyLength              `json:"SESSSET_Qualify_Length"`
-	SESSSETRaceLaps                   RestSessionsResponseSESSSETRaceLaps                   `json:"SESSSET_Race_Laps"`
-	SESSSETTireWear                   RestSessionsResponseSESSSETTireWear                   `json:"SESSSET_Tire_Wear"`
-	SESSSETWarmUpLength               RestSessionsResponseSESSSETWarmUpLength               `json:"SESSSET_WarmUp_Length"`
-	SESSSETAdjustFrozen               RestSessionsResponseSESSSETAdjustFrozen               `json:"SESSSET_adjust_frozen"`
-	SESSSETBlueFlags                  RestSessionsResponseSESSSETBlueFlags                  `json:"SESSSET_blue_flags"`
-	SESSSETCutRules                   RestSessionsResponseSESSSETCutRules                   `json:"SESSSET_cut_rules"`
-	SESSSETCutsAllowed                RestSessionsResponseSESSSETCutsAllowed                `json:"SESSSET_cuts_allowed"`
-	SESSSETFlagRules                  RestSessionsResponseSESSSETFlagRules                  `json:"SESSSET_flag_rules"`
-	SESSSETForceFormation             RestSessionsResponseSESSSETForceFormation             `json:"SESSSET_force_formation"`
-	SESSSETFormation                  RestSessionsResponseSESSSETFormation                  `json:"SESSSET_formation"`
-	SESSSETKeepTireInvOnTrackChange   RestSessionsResponseSESSSETKeepTireInvOnTrackChange   `json:"SESSSET_keep_tire_inv_on_track_change"`
-	SESSSETNumQualSessions            RestSessionsResponseSESSSETNumQualSessions            `json:"SESSSET_num_qual_sessions"`
-	SESSSETNumRaceSessions            RestSessionsResponseSESSSETNumRaceSessions            `json:"SESSSET_num_race_sessions"`
-	SESSSETParcFerme                  RestSessionsResponseSESSSETParcFerme                  `json:"SESSSET_parc_ferme"`
-	SESSSETPract1                     RestSessionsResponseSESSSETPract1                     `json:"SESSSET_pract1"`
-	SESSSETPract1RealroadInit         RestSessionsResponseSESSSETPract1RealroadInit         `json:"SESSSET_pract1_realroad_init"`
-	SESSSETPract1RealroadTemperatures RestSessionsResponseSESSSETPract1RealroadTemperatures `json:"SESSSET_pract1_realroad_temperatures"`
-	SESSSETPract1RealroadWet          RestSessionsResponseSESSSETPract1RealroadWet          `json:"SESSSET_pract1_realroad_wet"`
-	SESSSETPract2                     RestSessionsResponseSESSSETPract2                     `json:"SESSSET_pract2"`
-	SESSSETPract3                     RestSessionsResponseSESSSETPract3                     `json:"SESSSET_pract3"`
-	SESSSETPract4                     RestSessionsResponseSESSSETPract4                     `json:"SESSSET_pract4"`
-	SESSSETPractice1StartingTime      RestSessionsResponseSESSSETPractice1StartingTime      `json:"SESSSET_practice1_starting_time"`
-	SESSSETPrivatePrac                RestSessionsResponseSESSSETPrivatePrac                `json:"SESSSET_private_prac"`
-	SESSSETPrivateQual                RestSessionsResponseSESSSETPrivateQual                `json:"SESSSET_private_qual"`
-	SESSSETQual1RealroadInit          RestSessionsResponseSESSSETQual1RealroadInit          `json:"SESSSET_qual1_realroad_init"`
-	SESSSETQual1RealroadTemperatures  RestSessionsResponseSESSSETQual1RealroadTemperatures  `json:"SESSSET_qual1_realroad_temperatures"`
-	SESSSETQual1RealroadWet           RestSessionsResponseSESSSETQual1RealroadWet           `json:"SESSSET_qual1_realroad_wet"`
-	SESSSETQualifyStartingTime        RestSessionsResponseSESSSETQualifyStartingTime        `json:"SESSSET_qualify_starting_time"`
-	SESSSETRaceRealroadInit           RestSessionsResponseSESSSETRaceRealroadInit           `json:"SESSSET_race_realroad_init"`
-	SESSSETRaceRealroadTemperatures   RestSessionsResponseSESSSETRaceRealroadTemperatures   `json:"SESSSET_race_realroad_temperatures"`
-	SESSSETRaceRealroadWet            RestSessionsResponseSESSSETRaceRealroadWet            `json:"SESSSET_race_realroad_wet"`
-	SESSSETRaceStartingTime           RestSessionsResponseSESSSETRaceStartingTime           `json:"SESSSET_race_starting_time"`
-	SESSSETRaceTime                   RestSessionsResponseSESSSETRaceTime                   `json:"SESSSET_race_time"`
-	SESSSETRaceTimer                  RestSessionsResponseSESSSETRaceTimer                  `json:"SESSSET_race_timer"`
-	SESSSETRaceTimescale              RestSessionsResponseSESSSETRaceTimescale              `json:"SESSSET_race_timescale"`
-	SESSSETRealroadTimescalePractice  RestSessionsResponseSESSSETRealroadTimescalePractice  `json:"SESSSET_realroad_timescale_practice"`
-	SESSSETRealroadTimescaleQualify   RestSessionsResponseSESSSETRealroadTimescaleQualify   `json:"SESSSET_realroad_timescale_qualify"`
-	SESSSETRealroadTimescaleRace      RestSessionsResponseSESSSETRealroadTimescaleRace      `json:"SESSSET_realroad_timescale_race"`
-	SESSSETReconPitClosed             RestSessionsResponseSESSSETReconPitClosed             `json:"SESSSET_recon_pit_closed"`
-	SESSSETReconPitOpen               RestSessionsResponseSESSSETReconPitOpen               `json:"SESSSET_recon_pit_open"`
-	SESSSETReconTimer                 RestSessionsResponseSESSSETReconTimer                 `json:"SESSSET_recon_timer"`
-	SESSSETReconnaissance             RestSessionsResponseSESSSETReconnaissance             `json:"SESSSET_reconnaissance"`
-	SESSSETRunWarmup                  RestSessionsResponseSESSSETRunWarmup                  `json:"SESSSET_run_warmup"`
-	SESSSETSafetycarThresh            RestSessionsResponseSESSSETSafetycarThresh            `json:"SESSSET_safetycar_thresh"`
-	SESSSETSafetycarcollision         RestSessionsResponseSESSSETSafetycarcollision         `json:"SESSSET_safetycarcollision"`
-	SESSSETTimescaledWeather          RestSessionsResponseSESSSETTimescaledWeather          `json:"SESSSET_timescaled_weather"`
-	SESSSETTireWarmers                RestSessionsResponseSESSSETTireWarmers                `json:"SESSSET_tire_warmers"`
-	SESSSETTiresAvailableInGarage     RestSessionsResponseSESSSETTiresAvailableInGarage     `json:"SESSSET_tires_available_in_garage"`
-	SESSSETUnsportsmanlike            RestSessionsResponseSESSSETUnsportsmanlike            `json:"SESSSET_unsportsmanlike"`
-	SESSSETWalkthrough                RestSessionsResponseSESSSETWalkthrough                `json:"SESSSET_walkthrough"`
-	SESSSETWarmupStartingTime         RestSessionsResponseSESSSETWarmupStartingTime         `json:"SESSSET_warmup_starting_time"`
-	SESSSETWeather                    RestSessionsResponseSESSSETWeather                    `json:"SESSSET_weather"`
-}
-
-type RestSessionsResponseSESSSETAIAggression struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETAIStrength struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETAdjustFrozen struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETBlueFlags struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETCutRules struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETCutsAllowed struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETDamageMulti struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETFinishCriteria struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETFlagRules struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETForceFormation struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETFormation struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETFuelUsage struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETGridPosition struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETKeepTireInvOnTrackChange struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETMechFailures struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETNumOpponents struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETNumQualSessions struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETNumRaceSessions struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETParcFerme struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETPract1 struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETPract1RealroadInit struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETPract1RealroadTemperatures struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETPract1RealroadWet struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETPract2 struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETPract3 struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETPract4 struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETPractice1StartingTime struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETPracticeLength struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETPrivatePrac struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETPrivateQual struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETQual1RealroadInit struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETQual1RealroadTemperatures struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETQual1RealroadWet struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETQualifyLength struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETQualifyStartingTime struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRaceLaps struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRaceRealroadInit struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRaceRealroadTemperatures struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRaceRealroadWet struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRaceStartingTime struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRaceTime struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRaceTimer struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRaceTimescale struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRealroadTimescalePractice struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRealroadTimescaleQualify struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRealroadTimescaleRace struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETReconPitClosed struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETReconPitOpen struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETReconTimer struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETReconnaissance struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETRunWarmup struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETSafetycarThresh struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETSafetycarcollision struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETTimescaledWeather struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETTireWarmers struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETTireWear struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETTiresAvailableInGarage struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETUnsportsmanlike struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETWalkthrough struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETWarmUpLength struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETWarmupStartingTime struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsResponseSESSSETWeather struct {
-	CurrentValue    float64 `json:"currentValue"`
-	NumStepsTotal   float64 `json:"numStepsTotal"`
-	SettingID       float64 `json:"settingID"`
-	StringValue     string  `json:"stringValue"`
-	UiSelectionType string  `json:"uiSelectionType"`
-	ValueType       string  `json:"valueType"`
-}
-
-type RestSessionsRestartStintAvailableResponse struct {
-	IsRestartStintActionAvailable bool `json:"isRestartStintActionAvailable"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponse struct {
-	RealRoad              interface{}                                                  `json:"RealRoad"`
-	SessionPreset         RestSessionsSaveLoadGetSaveJSONResponseSessionPreset         `json:"SessionPreset"`
-	VehicleSetup          string                                                       `json:"VehicleSetup"`
-	Weather               []RestSessionsSaveLoadGetSaveJSONResponseWeatherItem         `json:"Weather"`
-	AiVehicles            []interface{}                                                `json:"aiVehicles"`
-	AllowedVehiclesFilter RestSessionsSaveLoadGetSaveJSONResponseAllowedVehiclesFilter `json:"allowedVehiclesFilter"`
-	CloudCoverage         float64                                                      `json:"cloudCoverage"`
-	Coop                  interface{}                                                  `json:"coop"`
-	CoopGameID            string                                                       `json:"coopGameID"`
-	CurrentSession        float64                                                      `json:"currentSession"`
-	CurrentTemp           float64                                                      `json:"currentTemp"`
-	EndET                 float64                                                      `json:"endET"`
-	GamePhase             float64                                                      `json:"gamePhase"`
-	GreenET               float64                                                      `json:"greenET"`
-	MaxLaps               float64                                                      `json:"maxLaps"`
-	PitExitLight          float64                                                      `json:"pitExitLight"`
-	PlayerVehicle         RestSessionsSaveLoadGetSaveJSONResponsePlayerVehicle         `json:"playerVehicle"`
-	RainIntensity         float64                                                      `json:"rainIntensity"`
-	Raining               float64                                                      `json:"raining"`
-	RedLightET            float64                                                      `json:"redLightET"`
-	SaveVersion           float64                                                      `json:"saveVersion"`
-	SessionET             float64                                                      `json:"sessionET"`
-	SessionFinished       bool                                                         `json:"sessionFinished"`
-	SessionState          float64                                                      `json:"sessionState"`
-	SessionTimescale      float64                                                      `json:"sessionTimescale"`
-	StartET               float64                                                      `json:"startET"`
-	StartTime             float64                                                      `json:"startTime"`
-	TimeOfDay             float64                                                      `json:"timeOfDay"`
-	UniqueSessionID       float64                                                      `json:"uniqueSessionID"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseAllowedVehiclesFilter struct {
-	Optional []string      `json:"Optional"`
-	Required []interface{} `json:"Required"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponsePlayerVehicle struct {
-	SlotID float64 `json:"slotID"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPreset struct {
-	Grid    []string                                                    `json:"Grid"`
-	Player  RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayer  `json:"Player"`
-	Weather RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeather `json:"Weather"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayer struct {
-	DRIVER             RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerDRIVER             `json:"DRIVER"`
-	GameOptions        RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerGameOptions        `json:"Game Options"`
-	MechanicalFailures RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerMechanicalFailures `json:"Mechanical Failures"`
-	RaceConditions     RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerRaceConditions     `json:"Race Conditions"`
-	SCENE              RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerSCENE              `json:"SCENE"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerDRIVER struct {
-	Vehicle string `json:"Vehicle"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerGameOptions struct {
-	AllowedVehicles                        RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerGameOptionsAllowedVehicles `json:"Allowed Vehicles"`
-	CrashRecovery                          float64                                                                              `json:"CrashRecovery"`
-	DamageMultiplier                       float64                                                                              `json:"Damage Multiplier"`
-	DriversPerVehicleAI                    float64                                                                              `json:"Drivers Per Vehicle AI"`
-	DriversPerVehiclePlayer                float64                                                                              `json:"Drivers Per Vehicle Player"`
-	EquippedScenarioPlans                  string                                                                               `json:"Equipped Scenario Plans"`
-	FreeSettings                           float64                                                                              `json:"FreeSettings"`
-	FuelConsumptionMultiplier              float64                                                                              `json:"Fuel Consumption Multiplier"`
-	LimitedTireRulesTiresAvailableInGarage float64                                                                              `json:"Limited Tire Rules Tires Available In Garage"`
-	MinDriveTimeAllowedFor2DriverTeams     float64                                                                              `json:"Min drive time allowed for 2 driver teams"`
-	MinDriveTimeAllowedFor3DriverTeams     float64                                                                              `json:"Min drive time allowed for 3 driver teams"`
-	MultiSessionResults                    bool                                                                                 `json:"Multi-session Results"`
-	Opponents                              float64                                                                              `json:"Opponents"`
-	QualifyingLaps                         float64                                                                              `json:"Qualifying Laps"`
-	RaceFinishCriteria                     float64                                                                              `json:"Race Finish Criteria"`
-	RaceLaps                               float64                                                                              `json:"Race Laps"`
-	RaceLength                             float64                                                                              `json:"Race Length"`
-	RaceTime                               float64                                                                              `json:"Race Time"`
-	SpeedCompensation                      float64                                                                              `json:"Speed Compensation"`
-	StartingPos                            float64                                                                              `json:"Starting Pos"`
-	StopGoPenalties                        bool                                                                                 `json:"Stop go penalties"`
-	TireWarmers                            bool                                                                                 `json:"Tire Warmers"`
-	TireWearMultiplier                     float64                                                                              `json:"Tire Wear Multiplier"`
-	VehicleClassesAffectedByDriveTime      string                                                                               `json:"Vehicle classes affected by drive time"`
-	PracticeLength                         float64                                                                              `json:"practice length"`
-	QualifyingLength                       float64                                                                              `json:"qualifying length"`
-	WarmupLength                           float64                                                                              `json:"warmup length"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerGameOptionsAllowedVehicles struct {
-	Optional []string      `json:"Optional"`
-	Required []interface{} `json:"Required"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerMechanicalFailures struct {
-	FailureRate float64 `json:"Failure Rate"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerRaceConditions struct {
-	BlueFlags                   float64 `json:"BlueFlags"`
-	FlagRules                   float64 `json:"Flag Rules"`
-	ForceFormation              float64 `json:"Force Formation"`
-	FormationLap                float64 `json:"Formation Lap"`
-	GridWalkthrough             float64 `json:"Grid Walkthrough"`
-	NumQualSessions             float64 `json:"Num Qual Sessions"`
-	NumRaceSessions             float64 `json:"Num Race Sessions"`
-	ParcFerme                   float64 `json:"ParcFerme"`
-	Practice1StartingTime       float64 `json:"Practice1StartingTime"`
-	PrivatePractice             bool    `json:"PrivatePractice"`
-	PrivateQualifying           float64 `json:"PrivateQualifying"`
-	QualifyingStartingTime      float64 `json:"QualifyingStartingTime"`
-	RaceTimer                   float64 `json:"Race Timer"`
-	RaceStartingTime            float64 `json:"RaceStartingTime"`
-	RaceTimeScale               float64 `json:"RaceTimeScale"`
-	RealRoadTimeScalePractice   float64 `json:"RealRoadTimeScalePractice"`
-	RealRoadTimeScaleQualifying float64 `json:"RealRoadTimeScaleQualifying"`
-	RealRoadTimeScaleRace       float64 `json:"RealRoadTimeScaleRace"`
-	ReconPitClosed              float64 `json:"Recon Pit Closed"`
-	ReconPitOpen                float64 `json:"Recon Pit Open"`
-	ReconTimer                  bool    `json:"Recon Timer"`
-	Reconnaissance              float64 `json:"Reconnaissance"`
-	RunPractice1                bool    `json:"Run Practice1"`
-	RunPractice2                bool    `json:"Run Practice2"`
-	RunPractice3                bool    `json:"Run Practice3"`
-	RunPractice4                bool    `json:"Run Practice4"`
-	RunWarmup                   bool    `json:"Run Warmup"`
-	SafetyCarCollidable         bool    `json:"Safety Car Collidable"`
-	SafetyCarThresh             float64 `json:"Safety Car Thresh"`
-	TimeScaledWeather           bool    `json:"TimeScaledWeather"`
-	TrackLimitsPointsAllowed    float64 `json:"Track Limits Points Allowed"`
-	TrackLimitsRules            float64 `json:"Track Limits Rules"`
-	UnsportsmanlikeSensitivity  float64 `json:"Unsportsmanlike Sensitivity"`
-	WarmupStartingTime          float64 `json:"WarmupStartingTime"`
-	Weather                     float64 `json:"Weather"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetPlayerSCENE struct {
-	AIDatabaseFile   string `json:"AI Database File"`
-	SceneDescription string `json:"Scene Description"`
-	SceneFile        string `json:"Scene File"`
-	SceneSignature   string `json:"Scene Signature"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeather struct {
-	Practice   RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherPractice   `json:"Practice"`
-	Qualifying RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherQualifying `json:"Qualifying"`
-	Race       RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherRace       `json:"Race"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherPractice struct {
-	Road    RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherPracticeRoad          `json:"Road"`
-	Weather []RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherPracticeWeatherItem `json:"Weather"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherPracticeRoad struct {
-	LoadTemperaturesFromRealRoadFile bool    `json:"LoadTemperaturesFromRealRoadFile"`
-	RealRoad                         string  `json:"RealRoad"`
-	WaterDepth                       float64 `json:"WaterDepth"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherPracticeWeatherItem struct {
-	Duration      float64 `json:"Duration"`
-	Humidity      float64 `json:"Humidity"`
-	RainChance    float64 `json:"RainChance"`
-	Sky           float64 `json:"Sky"`
-	StartTime     float64 `json:"StartTime"`
-	Temperature   float64 `json:"Temperature"`
-	WindDirection float64 `json:"WindDirection"`
-	WindSpeed     float64 `json:"WindSpeed"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherQualifying struct {
-	Road    RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherQualifyingRoad          `json:"Road"`
-	Weather []RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherQualifyingWeatherItem `json:"Weather"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherQualifyingRoad struct {
-	LoadTemperaturesFromRealRoadFile bool    `json:"LoadTemperaturesFromRealRoadFile"`
-	RealRoad                         string  `json:"RealRoad"`
-	WaterDepth                       float64 `json:"WaterDepth"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherQualifyingWeatherItem struct {
-	Duration      float64 `json:"Duration"`
-	Humidity      float64 `json:"Humidity"`
-	RainChance    float64 `json:"RainChance"`
-	Sky           float64 `json:"Sky"`
-	StartTime     float64 `json:"StartTime"`
-	Temperature   float64 `json:"Temperature"`
-	WindDirection float64 `json:"WindDirection"`
-	WindSpeed     float64 `json:"WindSpeed"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherRace struct {
-	Road    RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherRaceRoad          `json:"Road"`
-	Weather []RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherRaceWeatherItem `json:"Weather"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherRaceRoad struct {
-	LoadTemperaturesFromRealRoadFile bool    `json:"LoadTemperaturesFromRealRoadFile"`
-	RealRoad                         string  `json:"RealRoad"`
-	WaterDepth                       float64 `json:"WaterDepth"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseSessionPresetWeatherRaceWeatherItem struct {
-	Duration      float64 `json:"Duration"`
-	Humidity      float64 `json:"Humidity"`
-	RainChance    float64 `json:"RainChance"`
-	Sky           float64 `json:"Sky"`
-	StartTime     float64 `json:"StartTime"`
-	Temperature   float64 `json:"Temperature"`
-	WindDirection float64 `json:"WindDirection"`
-	WindSpeed     float64 `json:"WindSpeed"`
-}
-
-type RestSessionsSaveLoadGetSaveJSONResponseWeatherItem struct {
-	Nodes                string  `json:"Nodes"`
-	UnCompressedDataSize float64 `json:"unCompressedDataSize"`
-	UnEncodedDataSize    float64 `json:"unEncodedDataSize"`
-}
-
-type RestSessionsWeatherResponse struct {
-	PRACTICE RestSessionsWeatherResponsePRACTICE `json:"PRACTICE"`
-	QUALIFY  RestSessionsWeatherResponseQUALIFY  `json:"QUALIFY"`
-	RACE     RestSessionsWeatherResponseRACE     `json:"RACE"`
-}
-
-type RestSessionsWeatherResponsePRACTICE struct {
-	FINISH RestSessionsWeatherResponsePRACTICEFINISH `json:"FINISH"`
-	NODE25 RestSessionsWeatherResponsePRACTICENODE25 `json:"NODE_25"`
-	NODE50 RestSessionsWeatherResponsePRACTICENODE50 `json:"NODE_50"`
-	NODE75 RestSessionsWeatherResponsePRACTICENODE75 `json:"NODE_75"`
-	START  RestSessionsWeatherResponsePRACTICESTART  `json:"START"`
-}
-
-type RestSessionsWeatherResponsePRACTICEFINISH struct {
-	WNVDURATION      RestSessionsWeatherResponsePRACTICEFINISHWNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponsePRACTICEFINISHWNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponsePRACTICEFINISHWNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponsePRACTICEFINISHWNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponsePRACTICEFINISHWNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponsePRACTICEFINISHWNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponsePRACTICEFINISHWNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponsePRACTICEFINISHWNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponsePRACTICEFINISHWNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICEFINISHWNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICEFINISHWNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICEFINISHWNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICEFINISHWNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICEFINISHWNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICEFINISHWNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICEFINISHWNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE25 struct {
-	WNVDURATION      RestSessionsWeatherResponsePRACTICENODE25WNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponsePRACTICENODE25WNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponsePRACTICENODE25WNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponsePRACTICENODE25WNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponsePRACTICENODE25WNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponsePRACTICENODE25WNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponsePRACTICENODE25WNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponsePRACTICENODE25WNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE25WNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE25WNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE25WNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE25WNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE25WNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE25WNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE25WNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE25WNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE50 struct {
-	WNVDURATION      RestSessionsWeatherResponsePRACTICENODE50WNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponsePRACTICENODE50WNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponsePRACTICENODE50WNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponsePRACTICENODE50WNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponsePRACTICENODE50WNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponsePRACTICENODE50WNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponsePRACTICENODE50WNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponsePRACTICENODE50WNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE50WNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE50WNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE50WNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE50WNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE50WNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE50WNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE50WNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE50WNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE75 struct {
-	WNVDURATION      RestSessionsWeatherResponsePRACTICENODE75WNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponsePRACTICENODE75WNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponsePRACTICENODE75WNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponsePRACTICENODE75WNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponsePRACTICENODE75WNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponsePRACTICENODE75WNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponsePRACTICENODE75WNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponsePRACTICENODE75WNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE75WNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE75WNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE75WNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE75WNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE75WNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE75WNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE75WNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICENODE75WNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICESTART struct {
-	WNVDURATION      RestSessionsWeatherResponsePRACTICESTARTWNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponsePRACTICESTARTWNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponsePRACTICESTARTWNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponsePRACTICESTARTWNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponsePRACTICESTARTWNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponsePRACTICESTARTWNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponsePRACTICESTARTWNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponsePRACTICESTARTWNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponsePRACTICESTARTWNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICESTARTWNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICESTARTWNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICESTARTWNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICESTARTWNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICESTARTWNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICESTARTWNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponsePRACTICESTARTWNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFY struct {
-	FINISH RestSessionsWeatherResponseQUALIFYFINISH `json:"FINISH"`
-	NODE25 RestSessionsWeatherResponseQUALIFYNODE25 `json:"NODE_25"`
-	NODE50 RestSessionsWeatherResponseQUALIFYNODE50 `json:"NODE_50"`
-	NODE75 RestSessionsWeatherResponseQUALIFYNODE75 `json:"NODE_75"`
-	START  RestSessionsWeatherResponseQUALIFYSTART  `json:"START"`
-}
-
-type RestSessionsWeatherResponseQUALIFYFINISH struct {
-	WNVDURATION      RestSessionsWeatherResponseQUALIFYFINISHWNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponseQUALIFYFINISHWNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponseQUALIFYFINISHWNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponseQUALIFYFINISHWNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponseQUALIFYFINISHWNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponseQUALIFYFINISHWNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponseQUALIFYFINISHWNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponseQUALIFYFINISHWNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponseQUALIFYFINISHWNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYFINISHWNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYFINISHWNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYFINISHWNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYFINISHWNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYFINISHWNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYFINISHWNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYFINISHWNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE25 struct {
-	WNVDURATION      RestSessionsWeatherResponseQUALIFYNODE25WNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponseQUALIFYNODE25WNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponseQUALIFYNODE25WNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponseQUALIFYNODE25WNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponseQUALIFYNODE25WNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponseQUALIFYNODE25WNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponseQUALIFYNODE25WNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponseQUALIFYNODE25WNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE25WNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE25WNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE25WNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE25WNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE25WNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE25WNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE25WNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE25WNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE50 struct {
-	WNVDURATION      RestSessionsWeatherResponseQUALIFYNODE50WNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponseQUALIFYNODE50WNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponseQUALIFYNODE50WNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponseQUALIFYNODE50WNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponseQUALIFYNODE50WNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponseQUALIFYNODE50WNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponseQUALIFYNODE50WNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponseQUALIFYNODE50WNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE50WNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE50WNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE50WNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE50WNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE50WNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE50WNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE50WNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE50WNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE75 struct {
-	WNVDURATION      RestSessionsWeatherResponseQUALIFYNODE75WNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponseQUALIFYNODE75WNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponseQUALIFYNODE75WNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponseQUALIFYNODE75WNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponseQUALIFYNODE75WNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponseQUALIFYNODE75WNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponseQUALIFYNODE75WNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponseQUALIFYNODE75WNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE75WNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE75WNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE75WNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE75WNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE75WNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE75WNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE75WNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYNODE75WNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYSTART struct {
-	WNVDURATION      RestSessionsWeatherResponseQUALIFYSTARTWNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponseQUALIFYSTARTWNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponseQUALIFYSTARTWNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponseQUALIFYSTARTWNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponseQUALIFYSTARTWNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponseQUALIFYSTARTWNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponseQUALIFYSTARTWNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponseQUALIFYSTARTWNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponseQUALIFYSTARTWNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYSTARTWNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYSTARTWNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYSTARTWNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYSTARTWNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYSTARTWNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYSTARTWNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseQUALIFYSTARTWNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACE struct {
-	FINISH RestSessionsWeatherResponseRACEFINISH `json:"FINISH"`
-	NODE25 RestSessionsWeatherResponseRACENODE25 `json:"NODE_25"`
-	NODE50 RestSessionsWeatherResponseRACENODE50 `json:"NODE_50"`
-	NODE75 RestSessionsWeatherResponseRACENODE75 `json:"NODE_75"`
-	START  RestSessionsWeatherResponseRACESTART  `json:"START"`
-}
-
-type RestSessionsWeatherResponseRACEFINISH struct {
-	WNVDURATION      RestSessionsWeatherResponseRACEFINISHWNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponseRACEFINISHWNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponseRACEFINISHWNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponseRACEFINISHWNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponseRACEFINISHWNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponseRACEFINISHWNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponseRACEFINISHWNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponseRACEFINISHWNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponseRACEFINISHWNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACEFINISHWNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACEFINISHWNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACEFINISHWNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACEFINISHWNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACEFINISHWNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACEFINISHWNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACEFINISHWNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE25 struct {
-	WNVDURATION      RestSessionsWeatherResponseRACENODE25WNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponseRACENODE25WNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponseRACENODE25WNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponseRACENODE25WNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponseRACENODE25WNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponseRACENODE25WNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponseRACENODE25WNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponseRACENODE25WNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponseRACENODE25WNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE25WNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE25WNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE25WNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE25WNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE25WNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE25WNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE25WNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE50 struct {
-	WNVDURATION      RestSessionsWeatherResponseRACENODE50WNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponseRACENODE50WNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponseRACENODE50WNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponseRACENODE50WNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponseRACENODE50WNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponseRACENODE50WNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponseRACENODE50WNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponseRACENODE50WNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponseRACENODE50WNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE50WNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE50WNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE50WNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE50WNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE50WNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE50WNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE50WNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE75 struct {
-	WNVDURATION      RestSessionsWeatherResponseRACENODE75WNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponseRACENODE75WNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponseRACENODE75WNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponseRACENODE75WNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponseRACENODE75WNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponseRACENODE75WNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponseRACENODE75WNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponseRACENODE75WNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponseRACENODE75WNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE75WNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE75WNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE75WNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE75WNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE75WNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE75WNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACENODE75WNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACESTART struct {
-	WNVDURATION      RestSessionsWeatherResponseRACESTARTWNVDURATION      `json:"WNV_DURATION"`
-	WNVHUMIDITY      RestSessionsWeatherResponseRACESTARTWNVHUMIDITY      `json:"WNV_HUMIDITY"`
-	WNVRAINCHANCE    RestSessionsWeatherResponseRACESTARTWNVRAINCHANCE    `json:"WNV_RAIN_CHANCE"`
-	WNVSKY           RestSessionsWeatherResponseRACESTARTWNVSKY           `json:"WNV_SKY"`
-	WNVSTARTTIME     RestSessionsWeatherResponseRACESTARTWNVSTARTTIME     `json:"WNV_STARTTIME"`
-	WNVTEMPERATURE   RestSessionsWeatherResponseRACESTARTWNVTEMPERATURE   `json:"WNV_TEMPERATURE"`
-	WNVWINDDIRECTION RestSessionsWeatherResponseRACESTARTWNVWINDDIRECTION `json:"WNV_WINDDIRECTION"`
-	WNVWINDSPEED     RestSessionsWeatherResponseRACESTARTWNVWINDSPEED     `json:"WNV_WINDSPEED"`
-}
-
-type RestSessionsWeatherResponseRACESTARTWNVDURATION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACESTARTWNVHUMIDITY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACESTARTWNVRAINCHANCE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACESTARTWNVSKY struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACESTARTWNVSTARTTIME struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACESTARTWNVTEMPERATURE struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACESTARTWNVWINDDIRECTION struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestSessionsWeatherResponseRACESTARTWNVWINDSPEED struct {
-	CurrentValue float64 `json:"currentValue"`
-	StringValue  string  `json:"stringValue"`
-}
-
-type RestStrategyPitstopEstimateResponse struct {
-	Damage     float64 `json:"damage"`
-	DriverSwap float64 `json:"driverSwap"`
-	Fuel       float64 `json:"fuel"`
-	Penalties  float64 `json:"penalties"`
-	Tires      float64 `json:"tires"`
-	Total      float64 `json:"total"`
-	Ve         float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponse struct {
-	AhmadAlHarthy        []RestStrategyUsageResponseAhmadAlHarthyItem        `json:"Ahmad Al Harthy"`
-	AndrewGilbert        []RestStrategyUsageResponseAndrewGilbertItem        `json:"Andrew Gilbert"`
-	AntaresAu            []RestStrategyUsageResponseAntaresAuItem            `json:"Antares Au"`
-	BernardoSousa        []RestStrategyUsageResponseBernardoSousaItem        `json:"Bernardo Sousa"`
-	BrentonGrove         []RestStrategyUsageResponseBrentonGroveItem         `json:"Brenton Grove"`
-	EddieCheever         []RestStrategyUsageResponseEddieCheeverItem         `json:"Eddie Cheever"`
-	EduardoBarrichello   []RestStrategyUsageResponseEduardoBarrichelloItem   `json:"Eduardo Barrichello"`
-	FinnGehrsitz         []RestStrategyUsageResponseFinnGehrsitzItem         `json:"Finn Gehrsitz"`
-	FrancescoCastellacci []RestStrategyUsageResponseFrancescoCastellacciItem `json:"Francesco Castellacci"`
-	FranOisHeriau        []RestStrategyUsageResponseFranOisHeriauItem        `json:"François Heriau"`
-	IanJames             []RestStrategyUsageResponseIanJamesItem             `json:"Ian James"`
-	JamesCottingham      []RestStrategyUsageResponseJamesCottinghamItem      `json:"James Cottingham"`
-	JonnyEdgar           []RestStrategyUsageResponseJonnyEdgarItem           `json:"Jonny Edgar"`
-	JosMarALPez          []RestStrategyUsageResponseJosMarALPezItem          `json:"José María López"`
-	LinHodenius          []RestStrategyUsageResponseLinHodeniusItem          `json:"Lin Hodenius"`
-	MattBell             []RestStrategyUsageResponseMattBellItem             `json:"Matt Bell"`
-	MatthiasKuech        []RestStrategyUsageResponseMatthiasKuechItem        `json:"Matthias Kuech"`
-	RiccardoAgostini     []RestStrategyUsageResponseRiccardoAgostiniItem     `json:"Riccardo Agostini"`
-	RuiAndrade           []RestStrategyUsageResponseRuiAndradeItem           `json:"Rui Andrade"`
-	RyanHardwick         []RestStrategyUsageResponseRyanHardwickItem         `json:"Ryan Hardwick"`
-	SeanGelael           []RestStrategyUsageResponseSeanGelaelItem           `json:"Sean Gelael"`
-	StefanoGattuso       []RestStrategyUsageResponseStefanoGattusoItem       `json:"Stefano Gattuso"`
-	TakeshiKimura        []RestStrategyUsageResponseTakeshiKimuraItem        `json:"Takeshi Kimura"`
-	TimurBoguslavskiy    []RestStrategyUsageResponseTimurBoguslavskiyItem    `json:"Timur Boguslavskiy"`
-}
-
-type RestStrategyUsageResponseAhmadAlHarthyItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseAndrewGilbertItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseAntaresAuItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseBernardoSousaItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseBrentonGroveItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseEddieCheeverItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseEduardoBarrichelloItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseFinnGehrsitzItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseFranOisHeriauItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseFrancescoCastellacciItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseIanJamesItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseJamesCottinghamItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseJonnyEdgarItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseJosMarALPezItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseLinHodeniusItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseMattBellItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseMatthiasKuechItem struct {
-	Fuel  float64   `json:"fuel"`
-	Lap   float64   `json:"lap"`
-	Pit   bool      `json:"pit"`
-	Stint float64   `json:"stint"`
-	Tyres []float64 `json:"tyres"`
-	Ve    float64   `json:"ve"`
-}
-
-type RestStrategyUsageResponseRiccardoAgostiniItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseRuiAndradeItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseRyanHardwickItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseSeanGelaelItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseStefanoGattusoItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseTakeshiKimuraItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestStrategyUsageResponseTimurBoguslavskiyItem struct {
-	Lap   float64 `json:"lap"`
-	Pit   bool    `json:"pit"`
-	Stint float64 `json:"stint"`
-	Ve    float64 `json:"ve"`
-}
-
-type RestWatchReplayGetReplayFolderResponse struct {
-	Custom  string `json:"custom"`
-	Default string `json:"default"`
-}
-
-type RestWatchReplaysResponseItem struct {
-	Id              float64                              `json:"id"`
-	Metadata        RestWatchReplaysResponseItemMetadata `json:"metadata"`
-	ReplayDirectory string                               `json:"replayDirectory"`
-	ReplayName      string                               `json:"replayName"`
-	Size            float64                              `json:"size"`
-	Timestamp       float64                              `json:"timestamp"`
-}
-
-type RestWatchReplaysResponseItemMetadata struct {
-	SceneDesc string `json:"sceneDesc"`
-	Session   string `json:"session"`
-}
-
-type RestWatchSessionInfoResponse struct {
-	AmbientTemp              float64                                    `json:"ambientTemp"`
-	AveragePathWetness       float64                                    `json:"averagePathWetness"`
-	CurrentEventTime         float64                                    `json:"currentEventTime"`
-	DarkCloud                float64                                    `json:"darkCloud"`
-	EndEventTime             float64                                    `json:"endEventTime"`
-	GameMode                 string                                     `json:"gameMode"`
-	GamePhase                float64                                    `json:"gamePhase"`
-	InRealtime               bool                                       `json:"inRealtime"`
-	LapDistance              float64                                    `json:"lapDistance"`
-	MaxPathWetness           float64                                    `json:"maxPathWetness"`
-	MaxPlayers               float64                                    `json:"maxPlayers"`
-	MaxTime                  float64                                    `json:"maxTime"`
-	MaximumLaps              float64                                    `json:"maximumLaps"`
-	MinPathWetness           float64                                    `json:"minPathWetness"`
-	NumRedLights             float64                                    `json:"numRedLights"`
-	NumberOfPlayers          float64                                    `json:"numberOfPlayers"`
-	NumberOfVehicles         float64                                    `json:"numberOfVehicles"`
-	PasswordProtected        bool                                       `json:"passwordProtected"`
-	PlayerFileName           string                                     `json:"playerFileName"`
-	PlayerName               string                                     `json:"playerName"`
-	RaceCompletion           RestWatchSessionInfoResponseRaceCompletion `json:"raceCompletion"`
-	Raining                  float64                                    `json:"raining"`
-	SectorFlag               []string                                   `json:"sectorFlag"`
-	ServerName               string                                     `json:"serverName"`
-	ServerPort               float64                                    `json:"serverPort"`
-	Session                  string                                     `json:"session"`
-	StartEventTime           float64                                    `json:"startEventTime"`
-	StartLightFrame          float64                                    `json:"startLightFrame"`
-	TimeRemainingInGamePhase float64                                    `json:"timeRemainingInGamePhase"`
-	TrackName                string                                     `json:"trackName"`
-	TrackTemp                float64                                    `json:"trackTemp"`
-	WindSpeed                RestWatchSessionInfoResponseWindSpeed      `json:"windSpeed"`
-	YellowFlagState          string                                     `json:"yellowFlagState"`
-}
-
-type RestWatchSessionInfoResponseRaceCompletion struct {
-	TimeCompletion float64 `json:"timeCompletion"`
-}
-
-type RestWatchSessionInfoResponseWindSpeed struct {
-	Velocity float64 `json:"velocity"`
-	X        float64 `json:"x"`
-	Y        float64 `json:"y"`
-	Z        float64 `json:"z"`
-}
-
-type RestWatchStandingsHistoryResponseItemItem struct {
-	CarClass     string  `json:"carClass"`
-	DriverName   string  `json:"driverName"`
-	FinishStatus string  `json:"finishStatus"`
-	LapTime      float64 `json:"lapTime"`
-	Pitting      bool    `json:"pitting"`
-	Position     float64 `json:"position"`
-	SectorTime1  float64 `json:"sectorTime1"`
-	SectorTime2  float64 `json:"sectorTime2"`
-	SlotID       float64 `json:"slotID"`
-	TotalLaps    float64 `json:"totalLaps"`
-	VehicleName  string  `json:"vehicleName"`
-}
-
-type RestWatchStandingsResponseItem struct {
-	AttackMode         RestWatchStandingsResponseItemAttackMode      `json:"attackMode"`
-	BestLapSectorTime1 float64                                       `json:"bestLapSectorTime1"`
-	BestLapSectorTime2 float64                                       `json:"bestLapSectorTime2"`
-	BestLapTime        float64                                       `json:"bestLapTime"`
-	BestSectorTime1    float64                                       `json:"bestSectorTime1"`
-	BestSectorTime2    float64                                       `json:"bestSectorTime2"`
-	CarAcceleration    RestWatchStandingsResponseItemCarAcceleration `json:"carAcceleration"`
-	CarClass           string                                        `json:"carClass"`
-	CarId              string                                        `json:"carId"`
-	CarNumber          string                                        `json:"carNumber"`
-	CarPosition        RestWatchStandingsResponseItemCarPosition     `json:"carPosition"`
-	CarVelocity        RestWatchStandingsResponseItemCarVelocity     `json:"carVelocity"`
-	CountLapFlag       string                                        `json:"countLapFlag"`
-	CurrentSectorTime1 float64                                       `json:"currentSectorTime1"`
-	CurrentSectorTime2 float64                                       `json:"currentSectorTime2"`
-	DriverName         string                                        `json:"driverName"`
-	DrsActive          bool                                          `json:"drsActive"`
-	EstimatedLapTime   float64                                       `json:"estimatedLapTime"`
-	FinishStatus       string                                        `json:"finishStatus"`
-	Flag               string                                        `json:"flag"`
-	Focus              bool                                          `json:"focus"`
-	FuelFraction       float64                                       `json:"fuelFraction"`
-	FullTeamName       string                                        `json:"fullTeamName"`
-	GamePhase          string                                        `json:"gamePhase"`
-	HasFocus           bool                                          `json:"hasFocus"`
-	Headlights         bool                                          `json:"headlights"`
-	InControl          float64                                       `json:"inControl"`
-	InGarageStall      bool                                          `json:"inGarageStall"`
-	LapDistance        float64                                       `json:"lapDistance"`
-	LapStartET         float64                                       `json:"lapStartET"`
-	LapsBehindLeader   float64                                       `json:"lapsBehindLeader"`
-	LapsBehindNext     float64                                       `json:"lapsBehindNext"`
-	LapsCompleted      float64                                       `json:"lapsCompleted"`
-	LastLapTime        float64                                       `json:"lastLapTime"`
-	LastSectorTime1    float64                                       `json:"lastSectorTime1"`
-	LastSectorTime2    float64                                       `json:"lastSectorTime2"`
-	PathLateral        float64                                       `json:"pathLateral"`
-	Penalties          float64                                       `json:"penalties"`
-	PitGroup           string                                        `json:"pitGroup"`
-	PitLapDistance     float64                                       `json:"pitLapDistance"`
-	PitState           string                                        `json:"pitState"`
-	Pitstops           float64                                       `json:"pitstops"`
-	Pitting            bool                                          `json:"pitting"`
-	Player             bool                                          `json:"player"`
-	Position           float64                                       `json:"position"`
-	Qualification      float64                                       `json:"qualification"`
-	Sector             string                                        `json:"sector"`
-	ServerScored       bool                                          `json:"serverScored"`
-	SlotID             float64                                       `json:"slotID"`
-	SteamID            float64                                       `json:"steamID"`
-	TimeBehindLeader   float64                                       `json:"timeBehindLeader"`
-	TimeBehindNext     float64                                       `json:"timeBehindNext"`
-	TimeIntoLap        float64                                       `json:"timeIntoLap"`
-	TrackEdge          float64                                       `json:"trackEdge"`
-	UnderYellow        bool                                          `json:"underYellow"`
-	UpgradePack        string                                        `json:"upgradePack"`
-	VehicleFilename    string                                        `json:"vehicleFilename"`
-	VehicleName        string                                        `json:"vehicleName"`
-}
-
-type RestWatchStandingsResponseItemAttackMode struct {
-	RemainingCount float64 `json:"remainingCount"`
-	TimeRemaining  float64 `json:"timeRemaining"`
-	TotalCount     float64 `json:"totalCount"`
-}
-
-type RestWatchStandingsResponseItemCarAcceleration struct {
-	Velocity float64 `json:"velocity"`
-	X        float64 `json:"x"`
-	Y        float64 `json:"y"`
-	Z        float64 `json:"z"`
-}
-
-type RestWatchStandingsResponseItemCarPosition struct {
-	Type float64 `json:"type"`
-	X    float64 `json:"x"`
-	Y    float64 `json:"y"`
-	Z    float64 `json:"z"`
-}
-
-type RestWatchStandingsResponseItemCarVelocity struct {
-	Velocity float64 `json:"velocity"`
-	X        float64 `json:"x"`
-	Y        float64 `json:"y"`
-	Z        float64 `json:"z"`
-}
-
-type RestWatchTrackmapResponseItem struct {
-	Type float64 `json:"type"`
-	X    float64 `json:"x"`
-	Y    float64 `json:"y"`
-	Z    float64 `json:"z"`
-}
@@ -0,0 +1,170 @@
+// Package identity turns the display names and slots the API reports into
+// stable driver identities, so reports spanning several sessions aggregate
+// per real driver rather than per name spelling or per SlotID.
+package identity
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// aiSuffixes are the decorations the game and common mods append to AI names.
+var aiSuffixes = []string{"(AI)", "[AI]", "- AI", "(A.I.)", "(Bot)"}
+
+// NormalizeName cleans a driver display name: it repairs UTF-8 text that was
+// decoded as Latin-1 ("JÃ¶rg" -> "Jörg"), strips control characters and AI
+// suffixes, and collapses runs of whitespace.
+func NormalizeName(name string) string {
+	name = fixMojibake(name)
+	name = strings.Map(func(r rune) rune {
+		if r == utf8.RuneError || unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.Join(strings.Fields(name), " ")
+	for trimmed := true; trimmed; {
+		trimmed = false
+		for _, suf := range aiSuffixes {
+			if len(name) >= len(suf) && strings.EqualFold(name[len(name)-len(suf):], suf) {
+				name = strings.TrimSpace(name[:len(name)-len(suf)])
+				trimmed = true
+			}
+		}
+	}
+	return name
+}
+
+// IsAI reports whether the raw display name carries an AI suffix.
+func IsAI(name string) bool {
+	name = strings.TrimSpace(name)
+	for _, suf := range aiSuffixes {
+		if len(name) >= len(suf) && strings.EqualFold(name[len(name)-len(suf):], suf) {
+			return true
+		}
+	}
+	return false
+}
+
+// fixMojibake re-decodes a string whose UTF-8 bytes were read as Latin-1 and
+// re-encoded. It only applies the repair when every rune fits in a byte and
+// the result is valid UTF-8 containing multi-byte sequences.
+func fixMojibake(s string) string {
+	b := make([]byte, 0, len(s))
+	multi := false
+	for _, r := range s {
+		if r > 0xFF {
+			return s
+		}
+		if r >= 0x80 {
+			multi = true
+		}
+		b = append(b, byte(r))
+	}
+	if !multi || !utf8.Valid(b) {
+		return s
+	}
+	return string(b)
+}
+
+// Key folds a normalized name for case-insensitive comparisons.
+func Key(name string) string {
+	return strings.ToLower(NormalizeName(name))
+}
+
+// ID is a persistent driver identity. Drivers with a Steam ID are keyed by
+// it; everyone else (AI, offline sessions) falls back to the normalized name.
+type ID string
+
+// Of derives the identity of a standings entry. The Steam ID is kept as
+// the digits the game sent: 17-digit IDs do not survive a float64.
+func Of(s lib.RestWatchStandingsResponseItem) ID {
+	if id := strings.TrimLeft(s.SteamID.String(), "0"); id != "" && strings.Trim(id, "0123456789") == "" {
+		return ID("steam:" + id)
+	}
+	return ID("name:" + Key(s.DriverName))
+}
+
+// Driver is what the Registry knows about one identity.
+type Driver struct {
+	ID    ID
+	Name  string   // latest normalized display name
+	Names []string // every distinct normalized name seen
+	AI    bool
+}
+
+// Registry maps slots to identities for the current session and remembers
+// every driver seen across sessions. It is safe for concurrent use.
+type Registry struct {
+	mu      sync.Mutex
+	slots   map[int]ID
+	drivers map[ID]*Driver
+}
+
+func NewRegistry() *Registry {
+	return &Registry{slots: map[int]ID{}, drivers: map[ID]*Driver{}}
+}
+
+// Update records a standings frame and returns the slot to identity mapping
+// for it. Slots that disappeared from the frame are forgotten.
+func (r *Registry) Update(standings []lib.RestWatchStandingsResponseItem) map[int]ID {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	slots := make(map[int]ID, len(standings))
+	for _, s := range standings {
+		id := Of(s)
+		slots[int(s.SlotID)] = id
+		name := NormalizeName(s.DriverName)
+		d, ok := r.drivers[id]
+		if !ok {
+			d = &Driver{ID: id}
+			r.drivers[id] = d
+		}
+		d.AI = d.AI || IsAI(s.DriverName)
+		if name != "" && name != d.Name {
+			d.Name = name
+			seen := false
+			for _, n := range d.Names {
+				if n == name {
+					seen = true
+					break
+				}
+			}
+			if !seen {
+				d.Names = append(d.Names, name)
+			}
+		}
+	}
+	r.slots = slots
+	out := make(map[int]ID, len(slots))
+	for k, v := range slots {
+		out[k] = v
+	}
+	return out
+}
+
+// Slot returns the identity currently in a slot.
+func (r *Registry) Slot(slot int) (ID, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id, ok := r.slots[slot]
+	return id, ok
+}
+
+// Driver returns a copy of what is known about an identity.
+func (r *Registry) Driver(id ID) (Driver, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d, ok := r.drivers[id]
+	if !ok {
+		return Driver{}, false
+	}
+	cp := *d
+	cp.Names = append([]string(nil), d.Names...)
+	return cp, true
+}
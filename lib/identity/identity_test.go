@@ -0,0 +1,27 @@
+package identity
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+func TestOfSteamID(t *testing.T) {
+	// As float64 the first two are the same number
+	payload := `[{"driverName": "A", "steamID": 76561198000000001},
+		{"driverName": "B", "steamID": 76561198000000002},
+		{"driverName": "C", "steamID": "76561198000000003"},
+		{"driverName": "D (AI)", "steamID": 0},
+		{"driverName": "E"}]`
+	var standings []lib.RestWatchStandingsResponseItem
+	if err := json.Unmarshal([]byte(payload), &standings); err != nil {
+		t.Fatal(err)
+	}
+	want := []ID{"steam:76561198000000001", "steam:76561198000000002", "steam:76561198000000003", "name:d", "name:e"}
+	for i, s := range standings {
+		if got := Of(s); got != want[i] {
+			t.Errorf("Of(%s) = %q, want %q", s.DriverName, got, want[i])
+		}
+	}
+}
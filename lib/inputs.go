@@ -0,0 +1,41 @@
+package lib
+
+import "time"
+
+// Inputs are the player's driver inputs after the game's processing
+// (deadzones, filtering, assists), from /rest/options/liveInputs. The REST
+// API does not expose the engine: gear and RPM are only in the shared
+// memory telemetry.
+type Inputs struct {
+	At        time.Time `json:"at"`
+	Throttle  float64   `json:"throttle"`  // 0..1
+	Brake     float64   `json:"brake"`     // 0..1
+	Clutch    float64   `json:"clutch"`    // 0..1
+	Handbrake float64   `json:"handbrake"` // 0..1
+	Steer     float64   `json:"steer"`     // -1 (full left) .. 1 (full right)
+	ShiftUp   bool      `json:"shiftUp,omitempty"`
+	ShiftDown bool      `json:"shiftDown,omitempty"`
+	// TCOverride is true while the traction control override button is held.
+	TCOverride bool `json:"tcOverride,omitempty"`
+}
+
+// Inputs returns the player's current driver inputs, stamped with the time
+// of the response.
+func (c *Client) Inputs() (*Inputs, error) {
+	r, err := c.RestOptionsLiveInputs()
+	if err != nil {
+		return nil, err
+	}
+	p := r.LiveInputs.ProcessedInputs
+	return &Inputs{
+		At:         time.Now(),
+		Throttle:   p.Throttle,
+		Brake:      p.Brakes,
+		Clutch:     p.Clutch,
+		Handbrake:  p.Handbrake,
+		Steer:      p.SteerRight - p.SteerLeft,
+		ShiftUp:    p.ShiftUp,
+		ShiftDown:  p.ShiftDown,
+		TCOverride: p.TcOverride,
+	}, nil
+}
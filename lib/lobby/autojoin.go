@@ -0,0 +1,132 @@
+package lobby
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// AutoJoin drives the game from the menus into a multiplayer session:
+// join the server, wait for the track to load, select the car and livery
+// and optionally leave the garage onto the track.
+type AutoJoin struct {
+	Client   *lib.Client
+	Server   Server
+	Password string // overrides the servers file
+	Car      string // matched against vehicle name, id and team
+	Livery   string // matched against livery name and car number
+	Drive    bool   // press Drive once the car is selected
+
+	// Timeout bounds the wait for the track to load (default 3 minutes).
+	Timeout time.Duration
+	// Progress, if set, receives a line per step.
+	Progress func(string)
+}
+
+// Run performs the join. Each step fails fast with the step's name so a
+// league script can tell where it got stuck.
+func (a *AutoJoin) Run() error {
+	if a.Timeout == 0 {
+		a.Timeout = 3 * time.Minute
+	}
+	opts := a.Server.JoinOptions(a.Password)
+	if a.Server.Locked && opts.Password == "" {
+		return fmt.Errorf("join %s: password protected and no password given", a.Server.Name)
+	}
+
+	a.progress("joining %s (%s)", a.Server.Name, a.Server.Addr())
+	if err := a.Client.Join(opts); err != nil {
+		return fmt.Errorf("join: %w", err)
+	}
+	if err := a.waitLoaded(); err != nil {
+		return fmt.Errorf("join: %w", err)
+	}
+
+	if a.Car != "" || a.Livery != "" {
+		v, err := a.pickVehicle()
+		if err != nil {
+			return fmt.Errorf("select car: %w", err)
+		}
+		a.progress("selecting %s %s #%s", v.Vehicle, v.LiveryName, v.Number)
+		if err := a.Client.SetCurrentVehicle(v.Id); err != nil {
+			return fmt.Errorf("select car: %w", err)
+		}
+	}
+
+	if a.Drive {
+		a.progress("leaving the garage")
+		if _, err := a.Client.PostRestGarageDrive(); err != nil {
+			return fmt.Errorf("drive: %w", err)
+		}
+	}
+	return nil
+}
+
+// waitLoaded waits for the loading screen that follows a successful join
+// to come and go.
+func (a *AutoJoin) waitLoaded() error {
+	start := time.Now()
+	deadline := start.Add(a.Timeout)
+	seen := false
+	state := ""
+	for time.Now().Before(deadline) {
+		if s, err := a.Client.RestMultiplayerJoinState(); err == nil && s != state {
+			a.progress("join state %s", s)
+			state = s
+		}
+		nav, err := a.Client.NavigationState()
+		if err == nil {
+			if nav.LoadingStatus.Loading {
+				if !seen {
+					a.progress("loading %s", nav.LoadingStatus.Track.Track)
+				}
+				seen = true
+			} else if seen {
+				return nil
+			}
+		}
+		if !seen && time.Since(start) > time.Minute {
+			return fmt.Errorf("track did not start loading within a minute (join state %q)", state)
+		}
+		time.Sleep(time.Second)
+	}
+	return fmt.Errorf("track still loading after %s", a.Timeout)
+}
+
+func (a *AutoJoin) pickVehicle() (lib.RestSessionsGetAllVehiclesResponseItem, error) {
+	vehicles, err := a.Client.RestSessionsGetAllVehicles()
+	if err != nil {
+		return lib.RestSessionsGetAllVehiclesResponseItem{}, err
+	}
+	var matches []lib.RestSessionsGetAllVehiclesResponseItem
+	for _, v := range vehicles {
+		if a.Car != "" && !containsFold(v.Vehicle, a.Car) && !strings.EqualFold(v.Id, a.Car) && !containsFold(v.FullTeam, a.Car) {
+			continue
+		}
+		if a.Livery != "" && !containsFold(v.LiveryName, a.Livery) && strings.TrimPrefix(a.Livery, "#") != v.Number {
+			continue
+		}
+		matches = append(matches, v)
+	}
+	switch len(matches) {
+	case 0:
+		return lib.RestSessionsGetAllVehiclesResponseItem{}, fmt.Errorf("no vehicle matches car %q livery %q", a.Car, a.Livery)
+	case 1:
+		return matches[0], nil
+	}
+	// prefer owned cars, then the first in the game's order
+	for _, v := range matches {
+		if v.IsOwned {
+			return v, nil
+		}
+	}
+	return matches[0], nil
+}
+
+func (a *AutoJoin) progress(format string, args ...any) {
+	if a.Progress != nil {
+		a.Progress(fmt.Sprintf(format, args...))
+	}
+}
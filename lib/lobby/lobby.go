@@ -0,0 +1,154 @@
+// Package lobby models multiplayer servers and filters them. The game's
+// REST API can join a server but does not expose the server browser, so
+// the list comes from a servers file that a league or team maintains:
+//
+//	[
+//	  {"name": "League Race 1", "host": "203.0.113.10", "port": 64297,
+//	   "track": "Circuit de la Sarthe", "classes": ["Hypercar", "LMGT3"],
+//	   "region": "EU", "password": "s3cret"}
+//	]
+package lobby
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/carclass"
+	"github.com/snipem/go-lmu-api/lib/config"
+)
+
+// Server is one multiplayer server.
+type Server struct {
+	Name     string   `json:"name"`
+	Host     string   `json:"host"`
+	Port     int      `json:"port"`
+	Track    string   `json:"track,omitempty"`
+	Classes  []string `json:"classes,omitempty"`
+	Region   string   `json:"region,omitempty"`
+	Password string   `json:"password,omitempty"`
+	Locked   bool     `json:"locked,omitempty"` // password protected, password not in the file
+	Notes    string   `json:"notes,omitempty"`
+}
+
+// Protected reports whether joining needs a password.
+func (s Server) Protected() bool {
+	return s.Locked || s.Password != ""
+}
+
+// Addr is host:port.
+func (s Server) Addr() string {
+	return fmt.Sprintf("%s:%d", s.Host, s.Port)
+}
+
+// JoinOptions returns the join request for s. password overrides the
+// password from the servers file when set.
+func (s Server) JoinOptions(password string) lib.JoinOptions {
+	if password == "" {
+		password = s.Password
+	}
+	return lib.JoinOptions{Host: s.Host, Port: s.Port, Password: password}
+}
+
+// DefaultPath is servers.json next to the config file.
+func DefaultPath() string {
+	return filepath.Join(filepath.Dir(config.DefaultPath()), "servers.json")
+}
+
+// Load reads a servers file. A missing file yields no servers.
+func Load(path string) ([]Server, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var servers []Server
+	if err := json.Unmarshal(data, &servers); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for i, s := range servers {
+		if s.Host == "" || s.Port == 0 {
+			return nil, fmt.Errorf("%s: server %d (%q) needs host and port", path, i+1, s.Name)
+		}
+	}
+	return servers, nil
+}
+
+// Filter selects servers. Empty fields match everything; Track and Region
+// match case-insensitive substrings, Class matches any of a server's
+// classes by name or alias.
+type Filter struct {
+	Track    string
+	Class    string
+	Region   string
+	Password string // "" any, "open" without password, "locked" with password
+}
+
+// Match reports whether s passes the filter.
+func (f Filter) Match(s Server) bool {
+	if f.Track != "" && !containsFold(s.Track, f.Track) {
+		return false
+	}
+	if f.Region != "" && !strings.EqualFold(s.Region, f.Region) {
+		return false
+	}
+	switch f.Password {
+	case "open":
+		if s.Protected() {
+			return false
+		}
+	case "locked":
+		if !s.Protected() {
+			return false
+		}
+	}
+	if f.Class != "" {
+		want := carclass.Lookup(f.Class)
+		found := false
+		for _, c := range s.Classes {
+			if strings.EqualFold(carclass.Lookup(c).Name, want.Name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply returns the servers matching f, sorted by name.
+func (f Filter) Apply(servers []Server) []Server {
+	var out []Server
+	for _, s := range servers {
+		if f.Match(s) {
+			out = append(out, s)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Find returns the server with the given name (case-insensitive) or
+// host:port.
+func Find(servers []Server, name string) (Server, bool) {
+	for _, s := range servers {
+		if strings.EqualFold(s.Name, name) || s.Addr() == name {
+			return s, true
+		}
+	}
+	return Server{}, false
+}
+
+func containsFold(s, sub string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(sub))
+}
@@ -0,0 +1,111 @@
+// Package locale formats numbers, lap times and dates and translates the
+// tools' fixed messages for the languages they support: English (the
+// default), German and French.
+//
+// Messages are looked up by their English text, gettext style, so a
+// missing translation falls back to English rather than to a key.
+package locale
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Locale is a language's formats and messages. The zero Locale is English.
+type Locale struct {
+	Lang string // "en", "de" or "fr"
+
+	decimal, group string
+	date           string // time layout of a date with time of day
+	messages       map[string]string
+}
+
+var locales = map[string]Locale{
+	"en": {Lang: "en", decimal: ".", group: ",", date: "2006-01-02 15:04"},
+	"de": {Lang: "de", decimal: ",", group: ".", date: "02.01.2006 15:04", messages: german},
+	"fr": {Lang: "fr", decimal: ",", group: " ", date: "02/01/2006 15:04", messages: french},
+}
+
+// English is the default locale.
+var English = locales["en"]
+
+// Get returns the locale for a language tag such as "de", "de-AT" or
+// "fr_FR.UTF-8"; only the language is used. An empty tag is English.
+func Get(tag string) (Locale, error) {
+	if tag == "" {
+		return English, nil
+	}
+	lang := strings.ToLower(tag)
+	if i := strings.IndexAny(lang, "-_."); i >= 0 {
+		lang = lang[:i]
+	}
+	l, ok := locales[lang]
+	if !ok {
+		return English, fmt.Errorf("unsupported language %q (en, de, fr)", tag)
+	}
+	return l, nil
+}
+
+func (l Locale) or() Locale {
+	if l.decimal == "" {
+		return English
+	}
+	return l
+}
+
+// T translates an English message, a fmt format when args are given. Text
+// without a translation is used as it is.
+func (l Locale) T(msg string, args ...any) string {
+	if t, ok := l.messages[msg]; ok {
+		msg = t
+	}
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// Number formats v with prec decimals and grouped thousands: 1,234.5 in
+// English, 1.234,5 in German.
+func (l Locale) Number(v float64, prec int) string {
+	l = l.or()
+	s := strconv.FormatFloat(math.Abs(v), 'f', prec, 64)
+	whole, frac, _ := strings.Cut(s, ".")
+	var b strings.Builder
+	if v < 0 && strings.Trim(s, "0.") != "" {
+		b.WriteByte('-')
+	}
+	for i, r := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(l.group)
+		}
+		b.WriteRune(r)
+	}
+	if frac != "" {
+		b.WriteString(l.decimal + frac)
+	}
+	return b.String()
+}
+
+// LapTime formats seconds as m:ss.mmm with the locale's decimal separator.
+func (l Locale) LapTime(sec float64) string {
+	l = l.or()
+	ms := int(math.Round(sec * 1000))
+	return fmt.Sprintf("%d:%02d%s%03d", ms/60000, ms/1000%60, l.decimal, ms%1000)
+}
+
+// Date formats a date and time of day in the locale's order.
+func (l Locale) Date(t time.Time) string {
+	return t.Format(l.or().date)
+}
+
+// Laps reads "one lap" or "3 laps" in the locale's language.
+func (l Locale) Laps(v float64) string {
+	if v < 1.5 && v >= 0.5 {
+		return l.T("one lap")
+	}
+	return l.T("%s laps", l.Number(v, 0))
+}
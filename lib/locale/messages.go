@@ -0,0 +1,80 @@
+package locale
+
+// The catalogs map English messages to their translations. Format verbs
+// must match the English message's.
+
+var german = map[string]string{
+	"one lap":  "eine Runde",
+	"%s laps":  "%s Runden",
+	"LAP %d: ": "RUNDE %d: ",
+
+	// Wheel positions
+	"Front left":  "vorne links",
+	"Front right": "vorne rechts",
+	"Rear left":   "hinten links",
+	"Rear right":  "hinten rechts",
+
+	// Stewarding report
+	"# Stewarding report: %s, %s\n\n": "# Rennleitungsbericht: %s, %s\n\n",
+	"Session %d, started %s":          "Session %d, gestartet %s",
+	" on %s":                          " auf %s",
+	". Times are session time.\n\n":   ". Zeiten sind Sessionzeit.\n\n",
+	"Count":                           "Anzahl",
+	"Incidents":                       "Zwischenfälle",
+	"Penalties issued":                "Verhängte Strafen",
+	"Track limits":                    "Track Limits",
+	"Pit infractions":                 "Boxenverstöße",
+	"Penalties":                       "Strafen",
+	"None recorded.":                  "Keine aufgezeichnet.",
+	"Time":                            "Zeit",
+	"Car":                             "Auto",
+	"What":                            "Was",
+	"Involved":                        "Beteiligt",
+	"Outstanding":                     "Offen",
+	"Laps":                            "Runden",
+	"Lap":                             "Runde",
+	"Invalidated":                     "Gestrichen",
+	"issued":                          "verhängt",
+	"served":                          "abgeleistet",
+	"lap %d at %s (%s)":               "Runde %d um %s (%s)",
+	"Incidents are inferred from sudden stops and position losses; review them before acting.":                                      "Zwischenfälle werden aus plötzlichem Stillstand und Positionsverlusten abgeleitet; vor einer Entscheidung prüfen.",
+	"The API does not report cuts; these laps were much faster than the car's other laps (see lib/analysis). Times are wall clock.": "Die API meldet keine Abkürzungen; diese Runden waren deutlich schneller als die übrigen Runden des Autos (siehe lib/analysis). Zeiten sind Uhrzeit.",
+}
+
+var french = map[string]string{
+	"one lap":  "un tour",
+	"%s laps":  "%s tours",
+	"LAP %d: ": "TOUR %d : ",
+
+	// Wheel positions
+	"Front left":  "avant gauche",
+	"Front right": "avant droit",
+	"Rear left":   "arrière gauche",
+	"Rear right":  "arrière droit",
+
+	// Stewarding report
+	"# Stewarding report: %s, %s\n\n": "# Rapport des commissaires : %s, %s\n\n",
+	"Session %d, started %s":          "Session %d, débutée le %s",
+	" on %s":                          " sur %s",
+	". Times are session time.\n\n":   ". Les temps sont en temps de session.\n\n",
+	"Count":                           "Nombre",
+	"Incidents":                       "Incidents",
+	"Penalties issued":                "Pénalités infligées",
+	"Track limits":                    "Limites de piste",
+	"Pit infractions":                 "Infractions aux stands",
+	"Penalties":                       "Pénalités",
+	"None recorded.":                  "Aucun enregistrement.",
+	"Time":                            "Temps",
+	"Car":                             "Voiture",
+	"What":                            "Quoi",
+	"Involved":                        "Impliqués",
+	"Outstanding":                     "En attente",
+	"Laps":                            "Tours",
+	"Lap":                             "Tour",
+	"Invalidated":                     "Invalidés",
+	"issued":                          "infligée",
+	"served":                          "purgée",
+	"lap %d at %s (%s)":               "tour %d à %s (%s)",
+	"Incidents are inferred from sudden stops and position losses; review them before acting.":                                      "Les incidents sont déduits des arrêts soudains et des pertes de positions ; à vérifier avant toute décision.",
+	"The API does not report cuts; these laps were much faster than the car's other laps (see lib/analysis). Times are wall clock.": "L'API ne signale pas les coupes ; ces tours étaient bien plus rapides que les autres tours de la voiture (voir lib/analysis). Les heures sont l'heure réelle.",
+}
@@ -0,0 +1,31 @@
+package lib
+
+// Hand-written wrapper for joining a multiplayer server, so callers do not
+// have to pass RestMultiplayerJoin's seven positional parameters.
+
+// JoinOptions are the parameters of a multiplayer join request. Host and
+// Port address the dedicated server; the rest are optional.
+type JoinOptions struct {
+	Host           string
+	Port           int
+	Password       string
+	Authentication string
+	TeamName       string
+	VehicleNumber  string
+	PaintBlobID    string
+}
+
+// Join asks the game to connect to a multiplayer server. The join runs in
+// the background; poll RestMultiplayerJoinState for progress.
+func (c *Client) Join(o JoinOptions) error {
+	_, err := c.RestMultiplayerJoin(o.Password, o.Authentication, o.TeamName, o.VehicleNumber, o.PaintBlobID, o.Host, o.Port)
+	return err
+}
+
+// SetCurrentVehicle selects the player's vehicle (and with it the livery)
+// for the current session. The schema does not document the request body;
+// this sends the vehicle id from RestSessionsGetAllVehicles as a JSON string.
+func (c *Client) SetCurrentVehicle(id string) error {
+	_, err := c.doRequest("POST", "/rest/garage/SetCurrentVehicle", id)
+	return err
+}
@@ -0,0 +1,58 @@
+package lib
+
+// GamePhase is the session info's gamePhase, the phase of the session as
+// rFactor 2 numbers them.
+type GamePhase int
+
+const (
+	PhaseBeforeSession  GamePhase = 0
+	PhaseReconnaissance GamePhase = 1
+	PhaseGridWalk       GamePhase = 2
+	PhaseFormation      GamePhase = 3 // formation lap
+	PhaseCountdown      GamePhase = 4 // the start lights are coming on
+	PhaseGreen          GamePhase = 5
+	PhaseFullCourse     GamePhase = 6 // full course yellow or safety car
+	PhaseStopped        GamePhase = 7 // red flag
+	PhaseOver           GamePhase = 8
+	PhasePaused         GamePhase = 9
+)
+
+func (p GamePhase) String() string {
+	switch p {
+	case PhaseBeforeSession:
+		return "before session"
+	case PhaseReconnaissance:
+		return "reconnaissance"
+	case PhaseGridWalk:
+		return "grid walk"
+	case PhaseFormation:
+		return "formation lap"
+	case PhaseCountdown:
+		return "start lights"
+	case PhaseGreen:
+		return "green"
+	case PhaseFullCourse:
+		return "full course yellow"
+	case PhaseStopped:
+		return "stopped"
+	case PhaseOver:
+		return "over"
+	case PhasePaused:
+		return "paused"
+	}
+	return "unknown"
+}
+
+// PhaseOf returns the session's game phase.
+func PhaseOf(si *RestWatchSessionInfoResponse) GamePhase {
+	return GamePhase(si.GamePhase)
+}
+
+// StartLightsOf returns how many start lights are lit and how many the
+// track has while they are coming on; both are 0 in other phases.
+func StartLightsOf(si *RestWatchSessionInfoResponse) (lit, total int) {
+	if PhaseOf(si) != PhaseCountdown {
+		return 0, 0
+	}
+	return int(si.StartLightFrame), int(si.NumRedLights)
+}
@@ -0,0 +1,120 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Hand-written typed wrappers for the pit menu, the settings for the next
+// stop (fuel, virtual energy, tyres, repairs, driver) the pit stop screen
+// edits. The game takes the whole menu back with the changed settings.
+
+// PitMenuItem is one line of the pit menu. CurrentSetting indexes Settings.
+type PitMenuItem struct {
+	Name           string          `json:"name"`
+	CurrentSetting int             `json:"currentSetting"`
+	Default        int             `json:"default"`
+	PMCValue       int             `json:"PMC Value"`
+	Settings       []PitMenuChoice `json:"settings"`
+}
+
+// PitMenuChoice is one setting of a pit menu line, e.g. "No Change",
+// "Medium" or "45.0L/11.9gal".
+type PitMenuChoice struct {
+	Text string `json:"text"`
+}
+
+// PitMenu is the pit menu in the order the game shows it.
+type PitMenu []PitMenuItem
+
+// PitMenu returns the player's pit menu.
+func (c *Client) PitMenu() (PitMenu, error) {
+	data, err := c.doRequest("GET", "/rest/garage/PitMenu/receivePitMenu", nil)
+	if err != nil {
+		return nil, err
+	}
+	var m PitMenu
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("decode pit menu: %w", err)
+	}
+	return m, nil
+}
+
+// SetPitMenu sends the pit menu back to the game, the same request the pit
+// stop screen sends when a setting is changed.
+func (c *Client) SetPitMenu(m PitMenu) error {
+	_, err := c.doRequest("POST", "/rest/garage/PitMenu/loadPitMenu", m)
+	return err
+}
+
+// Item returns the line with the given name, compared without case and the
+// trailing colon ("fuel" finds "FUEL:"), or nil.
+func (m PitMenu) Item(name string) *PitMenuItem {
+	for i := range m {
+		if strings.EqualFold(strings.TrimSuffix(strings.TrimSpace(m[i].Name), ":"), name) {
+			return &m[i]
+		}
+	}
+	return nil
+}
+
+// Text is the text of the current setting.
+func (it PitMenuItem) Text() string {
+	if it.CurrentSetting < 0 || it.CurrentSetting >= len(it.Settings) {
+		return ""
+	}
+	return it.Settings[it.CurrentSetting].Text
+}
+
+// NoChange returns the index of the "No Change" setting, or -1.
+func (it PitMenuItem) NoChange() int {
+	for i, s := range it.Settings {
+		if strings.EqualFold(strings.TrimSpace(s.Text), "no change") {
+			return i
+		}
+	}
+	return -1
+}
+
+// Amount is the number a setting starts with: 45 for "45.0L/11.9gal", 80
+// for "80%". ok is false for settings without one, such as "No Change".
+func (it PitMenuItem) Amount(i int) (v float64, ok bool) {
+	if i < 0 || i >= len(it.Settings) {
+		return 0, false
+	}
+	text := strings.TrimLeft(strings.TrimSpace(it.Settings[i].Text), "+")
+	end := 0
+	for end < len(text) && (text[end] == '.' || text[end] == '-' || text[end] >= '0' && text[end] <= '9') {
+		end++
+	}
+	v, err := strconv.ParseFloat(text[:end], 64)
+	return v, err == nil
+}
+
+// Add moves the setting to the one whose amount is closest to the current
+// amount plus delta, e.g. 10 more litres of fuel. From a setting without an
+// amount it counts from 0. It reports whether the setting changed.
+func (it *PitMenuItem) Add(delta float64) bool {
+	cur, _ := it.Amount(it.CurrentSetting)
+	want, best, bestDiff := cur+delta, -1, 0.0
+	for i := range it.Settings {
+		v, ok := it.Amount(i)
+		if !ok {
+			continue
+		}
+		diff := v - want
+		if diff < 0 {
+			diff = -diff
+		}
+		if best < 0 || diff < bestDiff {
+			best, bestDiff = i, diff
+		}
+	}
+	if best < 0 || best == it.CurrentSetting {
+		return false
+	}
+	it.CurrentSetting = best
+	return true
+}
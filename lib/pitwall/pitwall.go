@@ -0,0 +1,169 @@
+// Package pitwall merges the local telemetry of several game instances into
+// one view per car. The spectator data (standings) has no fuel, tyre wear,
+// damage or driver inputs for other cars; each team member's game only
+// reports them for its own car, so a pit wall polls every member's machine.
+package pitwall
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Source is one game instance, e.g. a team member's rig.
+type Source struct {
+	Name   string
+	Client *lib.Client
+}
+
+// Car is the telemetry one source reports for its player's car.
+type Car struct {
+	Source   string
+	Number   string
+	Driver   string
+	Class    string
+	Position int
+	Laps     int
+	Pitting  bool
+	// Driving is true when the source's player is in control of the car;
+	// teammates waiting for their stint report the same car but are not.
+	Driving bool
+
+	Fuel, FuelCapacity float64
+	Energy             float64   // virtual energy in percent, 0 when the car has none
+	Tyres              []float64 // condition per wheel (FL, FR, RL, RR), 1 = new
+	Brakes             []float64 // condition per wheel
+	Damage             float64   // overall vehicle damage
+	Suspension         []float64 // damage per wheel
+
+	Throttle, Brake, Steer float64 // driver inputs, 0..1 and -1..1 for steering
+
+	Updated time.Time
+}
+
+// Poll reads the player car of one source. Standings are required; the
+// telemetry endpoints are best effort, since some are only served while the
+// car is on track.
+func Poll(s Source) (Car, error) {
+	standings, err := s.Client.RestWatchStandings()
+	if err != nil {
+		return Car{}, err
+	}
+	car := Car{Source: s.Name, Updated: time.Now()}
+	found := false
+	for _, st := range standings {
+		if st.Player {
+			car.Number, car.Driver, car.Class = st.CarNumber, st.DriverName, st.CarClass
+			car.Position, car.Laps, car.Pitting = int(st.Position), int(st.LapsCompleted), st.Pitting
+			car.Driving = st.InControl == 0 // 0 is the local player, 1 AI, 2 remote
+			found = true
+			break
+		}
+	}
+	if !found {
+		return Car{}, fmt.Errorf("%s: no player car", s.Name)
+	}
+	if vc, err := s.Client.RestGarageGetVehicleCondition(); err == nil {
+		car.Fuel, car.FuelCapacity = vc.Fuel, vc.FuelCapacity
+		car.Tyres, car.Brakes = vc.TireCondition, vc.BrakeCondition
+		car.Damage, car.Suspension = vc.VehicleDamage, vc.SuspensionDamage
+	}
+	if e, _, err := s.Client.Energy(); err == nil {
+		car.Energy = e.EnergyPercent()
+	}
+	if in, err := s.Client.Inputs(); err == nil {
+		car.Throttle, car.Brake, car.Steer = in.Throttle, in.Brake, in.Steer
+	}
+	return car, nil
+}
+
+// Aggregator polls several sources and keeps the latest view of each car.
+type Aggregator struct {
+	Sources []Source
+	// MaxAge drops cars not reported for this long. 0 keeps them.
+	MaxAge time.Duration
+
+	mu   sync.Mutex
+	cars map[string]Car
+	errs map[string]error
+}
+
+// NewAggregator returns an aggregator over sources.
+func NewAggregator(sources []Source) *Aggregator {
+	return &Aggregator{Sources: sources, cars: make(map[string]Car), errs: make(map[string]error)}
+}
+
+// Poll polls every source concurrently and merges the results. When several
+// sources report the same car, the one whose player is driving wins, then
+// the newest.
+func (a *Aggregator) Poll() {
+	type result struct {
+		name string
+		car  Car
+		err  error
+	}
+	results := make([]result, len(a.Sources))
+	var wg sync.WaitGroup
+	for i, s := range a.Sources {
+		wg.Add(1)
+		go func(i int, s Source) {
+			defer wg.Done()
+			car, err := Poll(s)
+			results[i] = result{s.Name, car, err}
+		}(i, s)
+	}
+	wg.Wait()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	fresh := make(map[string]bool)
+	for _, r := range results {
+		a.errs[r.name] = r.err
+		if r.err != nil {
+			continue
+		}
+		cur, seen := a.cars[r.car.Number]
+		if !fresh[r.car.Number] || !seen || (r.car.Driving && !cur.Driving) {
+			a.cars[r.car.Number] = r.car
+		}
+		fresh[r.car.Number] = true
+	}
+	if a.MaxAge > 0 {
+		for n, c := range a.cars {
+			if time.Since(c.Updated) > a.MaxAge {
+				delete(a.cars, n)
+			}
+		}
+	}
+}
+
+// Cars returns the merged cars by position, then number.
+func (a *Aggregator) Cars() []Car {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	out := make([]Car, 0, len(a.cars))
+	for _, c := range a.cars {
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Position != out[j].Position {
+			return out[i].Position < out[j].Position
+		}
+		return out[i].Number < out[j].Number
+	})
+	return out
+}
+
+// Errors returns the last error per source, nil for sources that answered.
+func (a *Aggregator) Errors() map[string]error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	out := make(map[string]error, len(a.errs))
+	for k, v := range a.errs {
+		out[k] = v
+	}
+	return out
+}
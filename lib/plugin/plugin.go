@@ -0,0 +1,359 @@
+// Package plugin runs external tools as subprocess plugins speaking
+// newline-delimited JSON-RPC 2.0 over stdin/stdout. Subprocesses work on
+// every platform the game runs on (Go's plugin package does not support
+// Windows) and can be written in any language.
+//
+// Host to plugin:
+//
+//	{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"host":"standings"}}
+//	  -> result {"name":"my-plugin","capabilities":["panel","sink"]}
+//	{"jsonrpc":"2.0","method":"snapshot","params":{"standings":[...],"session":{...},"meta":{"seq":42,...}}}
+//	{"jsonrpc":"2.0","method":"event","params":{"kind":"rival_pitted",...}}
+//	{"jsonrpc":"2.0","id":2,"method":"panel"}
+//	  -> result {"title":"Tyres","lines":["FL 82°C", "..."]}
+//	{"jsonrpc":"2.0","method":"shutdown"}
+//
+// Plugin to host (notification, any time):
+//
+//	{"jsonrpc":"2.0","method":"notify","params":{"text":"Box now","priority":"high"}}
+//
+// Anything a plugin writes to stderr is passed through to the host's stderr.
+//
+// Messages to a plugin are queued and written by a goroutine of their own,
+// so a plugin that stops reading never holds up the host. When its queue is
+// full, further messages are dropped and the drops logged to stderr.
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/events"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// Capabilities a plugin can declare in its initialize result.
+const (
+	CapPanel = "panel" // answers "panel" requests with lines for a TUI panel
+	CapSink  = "sink"  // wants "event" notifications
+)
+
+// Info is the plugin's answer to initialize.
+type Info struct {
+	Name         string   `json:"name"`
+	Capabilities []string `json:"capabilities"`
+}
+
+func (i Info) Has(c string) bool {
+	for _, x := range i.Capabilities {
+		if x == c {
+			return true
+		}
+	}
+	return false
+}
+
+// Panel is a block of text a plugin contributes to a display.
+type Panel struct {
+	Title string   `json:"title"`
+	Lines []string `json:"lines"`
+}
+
+// Notification is a message a plugin pushes to the host's notifier.
+type Notification struct {
+	Text     string `json:"text"`
+	Priority string `json:"priority,omitempty"` // low, normal, high, critical
+}
+
+// Snapshot is the payload of "snapshot" notifications.
+// Meta numbers the frames (see watch.FrameMeta); a plugin that sees a gap
+// in meta.seq missed snapshots because it did not keep up.
+type Snapshot struct {
+	Standings []lib.RestWatchStandingsResponseItem `json:"standings"`
+	Session   *lib.RestWatchSessionInfoResponse    `json:"session,omitempty"`
+	Meta      *watch.FrameMeta                     `json:"meta,omitempty"`
+}
+
+type message struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      *int64          `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  interface{}     `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// queueSize is how many messages wait for a plugin that does not keep up
+// before further ones are dropped, 8 seconds of snapshots at 4 Hz.
+const queueSize = 32
+
+// ErrDropped is returned for a message dropped because the plugin's queue
+// was full.
+var ErrDropped = errors.New("plugin: not keeping up, message dropped")
+
+// Plugin is a running plugin process.
+type Plugin struct {
+	Info Info
+	// Notify receives the plugin's notifications. It is buffered; when the
+	// host doesn't keep up, further notifications are dropped.
+	Notify chan Notification
+
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	queue   chan []byte
+	written chan struct{} // closed when the writer has stopped
+	writeMu sync.Mutex    // guards queue against sends after Close
+	closed  bool
+	dropped int // messages dropped since the last one queued
+	mu      sync.Mutex
+	werr    error
+	nextID  int64
+	pending map[int64]chan message
+	done    chan struct{}
+	err     error
+}
+
+// Start launches cmdline (split on whitespace) and performs the initialize
+// handshake. host names the calling tool.
+func Start(ctx context.Context, cmdline, host string) (*Plugin, error) {
+	argv := strings.Fields(cmdline)
+	if len(argv) == 0 {
+		return nil, errors.New("plugin: empty command")
+	}
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("plugin %s: %w", argv[0], err)
+	}
+	p := &Plugin{
+		Notify:  make(chan Notification, 16),
+		cmd:     cmd,
+		stdin:   stdin,
+		queue:   make(chan []byte, queueSize),
+		written: make(chan struct{}),
+		pending: map[int64]chan message{},
+		done:    make(chan struct{}),
+	}
+	go p.read(stdout)
+	go p.writeQueue()
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := p.Call(ctx, "initialize", map[string]string{"host": host}, &p.Info); err != nil {
+		p.Close()
+		return nil, fmt.Errorf("plugin %s: initialize: %w", argv[0], err)
+	}
+	if p.Info.Name == "" {
+		p.Info.Name = argv[0]
+	}
+	return p, nil
+}
+
+func (p *Plugin) read(r io.Reader) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for sc.Scan() {
+		var m message
+		if err := json.Unmarshal(sc.Bytes(), &m); err != nil {
+			continue
+		}
+		switch {
+		case m.ID != nil && m.Method == "":
+			p.mu.Lock()
+			ch := p.pending[*m.ID]
+			delete(p.pending, *m.ID)
+			p.mu.Unlock()
+			if ch != nil {
+				ch <- m
+			}
+		case m.Method == "notify":
+			var n Notification
+			raw, _ := json.Marshal(m.Params)
+			if json.Unmarshal(raw, &n) == nil && n.Text != "" {
+				select {
+				case p.Notify <- n:
+				default:
+				}
+			}
+		}
+	}
+	p.mu.Lock()
+	p.err = sc.Err()
+	if p.err == nil {
+		p.err = io.EOF
+	}
+	p.mu.Unlock()
+	close(p.done)
+}
+
+// writeQueue writes the queued messages to the plugin until the queue is
+// closed, then closes its stdin. After a failed write the rest are
+// discarded.
+func (p *Plugin) writeQueue() {
+	defer close(p.written)
+	defer p.stdin.Close()
+	for b := range p.queue {
+		p.mu.Lock()
+		failed := p.werr != nil
+		p.mu.Unlock()
+		if failed {
+			continue
+		}
+		if _, err := p.stdin.Write(b); err != nil {
+			p.mu.Lock()
+			p.werr = err
+			p.mu.Unlock()
+		}
+	}
+}
+
+// write queues m for the writer. It fails with ErrDropped when the queue is
+// full, or with the error of an earlier write.
+func (p *Plugin) write(m message) error {
+	m.JSONRPC = "2.0"
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	err = p.werr
+	p.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	p.writeMu.Lock()
+	defer p.writeMu.Unlock()
+	if p.closed {
+		return errors.New("plugin: closed")
+	}
+	select {
+	case p.queue <- append(b, '\n'):
+		if p.dropped > 0 {
+			fmt.Fprintf(os.Stderr, "\rplugin %s: caught up, %d messages dropped\n", p.name(), p.dropped)
+			p.dropped = 0
+		}
+		return nil
+	default:
+		if p.dropped == 0 {
+			fmt.Fprintf(os.Stderr, "\rplugin %s: not keeping up, dropping %s and later messages\n", p.name(), m.Method)
+		}
+		p.dropped++
+		return ErrDropped
+	}
+}
+
+// name is the plugin's name for logs, its command before initialize.
+func (p *Plugin) name() string {
+	if p.Info.Name != "" {
+		return p.Info.Name
+	}
+	return p.cmd.Path
+}
+
+// Send delivers a notification without waiting for an answer.
+func (p *Plugin) Send(method string, params interface{}) error {
+	return p.write(message{Method: method, Params: params})
+}
+
+// Call sends a request and decodes the result into out.
+func (p *Plugin) Call(ctx context.Context, method string, params, out interface{}) error {
+	p.mu.Lock()
+	p.nextID++
+	id := p.nextID
+	ch := make(chan message, 1)
+	p.pending[id] = ch
+	p.mu.Unlock()
+
+	if err := p.write(message{ID: &id, Method: method, Params: params}); err != nil {
+		p.mu.Lock()
+		delete(p.pending, id)
+		p.mu.Unlock()
+		return err
+	}
+	select {
+	case m := <-ch:
+		if m.Error != nil {
+			return fmt.Errorf("%s: %s (%d)", method, m.Error.Message, m.Error.Code)
+		}
+		if out != nil {
+			return json.Unmarshal(m.Result, out)
+		}
+		return nil
+	case <-p.done:
+		return fmt.Errorf("%s: plugin exited: %v", method, p.err)
+	case <-ctx.Done():
+		p.mu.Lock()
+		delete(p.pending, id)
+		p.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// Snapshot streams a frame to the plugin.
+func (p *Plugin) Snapshot(s Snapshot) error {
+	return p.Send("snapshot", s)
+}
+
+// Event forwards an event when the plugin declared itself a sink.
+func (p *Plugin) Event(e events.Event) error {
+	if !p.Info.Has(CapSink) {
+		return nil
+	}
+	return p.Send("event", e)
+}
+
+// Panel asks for the plugin's panel. Plugins without the panel capability
+// return an empty panel.
+func (p *Plugin) Panel(ctx context.Context) (Panel, error) {
+	var panel Panel
+	if !p.Info.Has(CapPanel) {
+		return panel, nil
+	}
+	err := p.Call(ctx, "panel", nil, &panel)
+	return panel, err
+}
+
+// Close asks the plugin to shut down and waits briefly before killing it.
+// Messages still queued are written first.
+func (p *Plugin) Close() error {
+	p.Send("shutdown", nil)
+	p.writeMu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.writeMu.Unlock()
+	select {
+	case <-p.done:
+	case <-time.After(2 * time.Second):
+		p.cmd.Process.Kill()
+		// A child of the plugin may still hold its stdin; closing it
+		// unblocks the writer
+		p.stdin.Close()
+	}
+	<-p.written
+	return p.cmd.Wait()
+}
@@ -0,0 +1,116 @@
+// Package rating computes Elo driver ratings from race results. A race
+// counts as a head-to-head between every pair of drivers in it: finishing
+// ahead of a driver is a win against them, and the rating moves by how
+// much better or worse that was than the ratings expected. Leagues use the
+// ratings for skill-based splits.
+package rating
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	// Initial is the rating of a driver without races.
+	Initial = 1500
+	// DefaultK is how far one race can move a rating against a field of
+	// equals, win or lose everything.
+	DefaultK = 32
+)
+
+// Finisher is a driver's result in one race of one class. Place is the
+// position in the class; drivers sharing one tie.
+type Finisher struct {
+	ID    string
+	Name  string
+	Place int
+}
+
+// Rating is a driver's rating after the races added.
+type Rating struct {
+	ID     string
+	Name   string // as in the latest race
+	Rating float64
+	Races  int
+	Wins   int
+	Peak   float64
+	Change float64 // in the latest race
+}
+
+// Table keeps the ratings of one field, e.g. one class. Add races in the
+// order they were run.
+type Table struct {
+	K float64
+
+	drivers map[string]*Rating
+}
+
+func New() *Table {
+	return &Table{K: DefaultK, drivers: map[string]*Rating{}}
+}
+
+// Add rates a race. Every driver's change is the sum of their pairwise
+// results against the others, scaled by K/(n-1) so a race weighs the same
+// whatever the field size. Races with fewer than two drivers change
+// nothing.
+func (t *Table) Add(race []Finisher) {
+	if len(race) < 2 {
+		return
+	}
+	rs := make([]*Rating, len(race))
+	before := make([]float64, len(race))
+	for i, f := range race {
+		r := t.drivers[f.ID]
+		if r == nil {
+			r = &Rating{ID: f.ID, Rating: Initial, Peak: Initial}
+			t.drivers[f.ID] = r
+		}
+		r.Name = f.Name
+		rs[i], before[i] = r, r.Rating
+	}
+	k := t.K / float64(len(race)-1)
+	for i, a := range race {
+		var delta float64
+		for j, b := range race {
+			if i == j {
+				continue
+			}
+			score := 0.5
+			if a.Place < b.Place {
+				score = 1
+			} else if a.Place > b.Place {
+				score = 0
+			}
+			delta += score - Expected(before[i], before[j])
+		}
+		r := rs[i]
+		r.Change = k * delta
+		r.Rating += r.Change
+		r.Peak = math.Max(r.Peak, r.Rating)
+		r.Races++
+		if a.Place == 1 {
+			r.Wins++
+		}
+	}
+}
+
+// Expected is the score a driver rated a is expected to take against one
+// rated b, between 0 and 1.
+func Expected(a, b float64) float64 {
+	return 1 / (1 + math.Pow(10, (b-a)/400))
+}
+
+// Ratings returns every rated driver, highest rating first.
+func (t *Table) Ratings() []Rating {
+	out := make([]Rating, 0, len(t.drivers))
+	for _, r := range t.drivers {
+		out = append(out, *r)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Rating != out[j].Rating {
+			return out[i].Rating > out[j].Rating
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
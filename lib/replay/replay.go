@@ -0,0 +1,139 @@
+// Package replay lists the game's saved replays and renames them after the
+// session they recorded. The API has no call to save a replay (the game
+// writes one when a session ends); it lists them and reports the replay
+// folder, which is enough to give new files meaningful names when the tool
+// runs on the game machine.
+package replay
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Replay is one saved replay.
+type Replay struct {
+	ID      int
+	Name    string
+	Dir     string
+	Size    int64
+	Time    time.Time
+	Track   string // scene description
+	Session string
+}
+
+// List returns the saved replays, newest first.
+func List(c *lib.Client) ([]Replay, error) {
+	items, err := c.RestWatchReplays()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Replay, 0, len(items))
+	for _, it := range items {
+		out = append(out, Replay{
+			ID:      int(it.Id),
+			Name:    it.ReplayName,
+			Dir:     it.ReplayDirectory,
+			Size:    int64(it.Size),
+			Time:    time.Unix(int64(it.Timestamp), 0),
+			Track:   it.Metadata.SceneDesc,
+			Session: it.Metadata.Session,
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Time.After(out[j].Time) })
+	return out, nil
+}
+
+// Folder returns the directory replays are written to: the custom folder
+// when one is configured, the default one otherwise.
+func Folder(c *lib.Client) (string, error) {
+	f, err := c.RestWatchReplayGetReplayFolder()
+	if err != nil {
+		return "", err
+	}
+	if f.Custom != "" {
+		return f.Custom, nil
+	}
+	return f.Default, nil
+}
+
+// Path locates the replay's file on disk. The listed name may or may not
+// carry the .Vcr extension, so both are tried.
+func (r Replay) Path(folder string) (string, error) {
+	dir := r.Dir
+	if dir == "" {
+		dir = folder
+	}
+	for _, p := range []string{filepath.Join(dir, r.Name), filepath.Join(dir, r.Name+".Vcr")} {
+		if fi, err := os.Stat(p); err == nil && !fi.IsDir() {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("replay %q not found in %s", r.Name, dir)
+}
+
+// Meta describes the session a replay recorded, for naming it.
+type Meta struct {
+	Track    string
+	Session  string
+	Date     time.Time
+	Position int // player's finishing position, 0 when unknown
+	Class    string
+}
+
+// FileName builds a replay name like
+// "2024-06-15 1830 Circuit de la Sarthe RACE1 P3 Hypercar".
+func (m Meta) FileName() string {
+	parts := []string{m.Date.Local().Format("2006-01-02 1504"), m.Track, m.Session}
+	if m.Position > 0 {
+		parts = append(parts, fmt.Sprintf("P%d", m.Position))
+	}
+	if m.Class != "" {
+		parts = append(parts, m.Class)
+	}
+	var b strings.Builder
+	for _, p := range parts {
+		p = strings.TrimSpace(sanitize(p))
+		if p == "" {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+// sanitize drops characters Windows does not allow in file names.
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`<>:"/\|?*`, r) || r < 32 {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Rename gives the replay file at path a new base name, keeping its
+// extension. An existing file of that name gets a numeric suffix rather
+// than being overwritten. It returns the new path.
+func Rename(path, name string) (string, error) {
+	ext := filepath.Ext(path)
+	dir := filepath.Dir(path)
+	target := filepath.Join(dir, name+ext)
+	for i := 2; ; i++ {
+		if _, err := os.Stat(target); errors.Is(err, fs.ErrNotExist) {
+			break
+		}
+		target = filepath.Join(dir, fmt.Sprintf("%s (%d)%s", name, i, ext))
+	}
+	return target, os.Rename(path, target)
+}
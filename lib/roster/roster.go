@@ -0,0 +1,167 @@
+// Package roster scripts the AI field of an offline session: how many
+// opponents, how strong and aggressive they are, and which opponent groups
+// (classes and cars) make up the grid. A roster can be stored as JSON next
+// to a league's race settings and applied before practice:
+//
+//	{"opponents": 29, "strength": 95, "aggression": 40, "groups": ["Hypercar", "LMGT3"]}
+//
+// The game has a single AI strength, not a range. Count, strength and
+// aggression are set through the API; the opponent group selection can only
+// be read, so Apply reports groups that still need to be changed in the menu.
+package roster
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Roster is the desired AI field. Nil fields are left as they are.
+type Roster struct {
+	Opponents  *int     `json:"opponents,omitempty"`
+	Strength   *float64 `json:"strength,omitempty"`   // AI strength setting value
+	Aggression *float64 `json:"aggression,omitempty"` // AI aggression setting value
+	Groups     []string `json:"groups,omitempty"`     // opponent groups by name
+}
+
+// Load reads a roster file.
+func Load(path string) (*Roster, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var r Roster
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &r, nil
+}
+
+// State is the game's current AI configuration.
+type State struct {
+	Settings  map[string]lib.SessionSetting
+	Selected  []string // opponent groups in the field
+	Available []string // every opponent group the game offers
+}
+
+// Current reads the AI configuration from the game.
+func Current(c *lib.Client) (*State, error) {
+	settings, err := c.SessionSettings()
+	if err != nil {
+		return nil, err
+	}
+	st := &State{Settings: settings}
+	sel, err := c.RestSessionsOpponents()
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range sel {
+		st.Selected = append(st.Selected, o.Name)
+	}
+	all, err := c.RestSessionsOpponentsAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range all {
+		st.Available = append(st.Available, o.Name)
+	}
+	return st, nil
+}
+
+// Validate checks r against the ranges and groups the game offers.
+func (r *Roster) Validate(st *State) error {
+	var errs []string
+	check := func(key, name string, v float64) {
+		s, ok := st.Settings[key]
+		if !ok {
+			errs = append(errs, name+": not available in this session")
+			return
+		}
+		if s.NumStepsTotal > 0 && (v < 0 || v >= s.NumStepsTotal) {
+			errs = append(errs, fmt.Sprintf("%s: %g outside 0..%g", name, v, s.NumStepsTotal-1))
+		}
+	}
+	if r.Opponents != nil {
+		check(lib.SettingNumOpponents, "opponents", float64(*r.Opponents))
+	}
+	if r.Strength != nil {
+		check(lib.SettingAIStrength, "strength", *r.Strength)
+	}
+	if r.Aggression != nil {
+		check(lib.SettingAIAggression, "aggression", *r.Aggression)
+	}
+	for _, g := range r.Groups {
+		if !containsFold(st.Available, g) {
+			errs = append(errs, fmt.Sprintf("group %q: not offered (available: %s)", g, strings.Join(st.Available, ", ")))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid roster:\n  %s", strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+// Apply validates r and sets count, strength and aggression. It returns the
+// groups that differ from the game's selection: missing ones to add and
+// extra ones to remove in the menu.
+func (r *Roster) Apply(c *lib.Client) (missing, extra []string, err error) {
+	st, err := Current(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := r.Validate(st); err != nil {
+		return nil, nil, err
+	}
+	set := func(key string, v float64) error {
+		if st.Settings[key].CurrentValue == v {
+			return nil
+		}
+		if err := c.SetSessionSetting(key, v); err != nil {
+			return fmt.Errorf("set %s: %w", key, err)
+		}
+		return nil
+	}
+	if r.Opponents != nil {
+		if err := set(lib.SettingNumOpponents, float64(*r.Opponents)); err != nil {
+			return nil, nil, err
+		}
+	}
+	if r.Strength != nil {
+		if err := set(lib.SettingAIStrength, *r.Strength); err != nil {
+			return nil, nil, err
+		}
+	}
+	if r.Aggression != nil {
+		if err := set(lib.SettingAIAggression, *r.Aggression); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(r.Groups) > 0 {
+		for _, g := range r.Groups {
+			if !containsFold(st.Selected, g) {
+				missing = append(missing, g)
+			}
+		}
+		for _, g := range st.Selected {
+			if !containsFold(r.Groups, g) {
+				extra = append(extra, g)
+			}
+		}
+		sort.Strings(missing)
+		sort.Strings(extra)
+	}
+	return missing, extra, nil
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,224 @@
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Check type-checks the expression against the types of vars without
+// evaluating it, so every identifier, field and operator is checked, also
+// those on the side of && and || that evaluating would skip. Values of an
+// interface type, such as map values, are only known at run time and pass.
+func (p *Program) Check(vars map[string]interface{}) error {
+	_, err := p.check(vars)
+	return err
+}
+
+// CheckBool is Check for a condition: the expression must also be boolean.
+func (p *Program) CheckBool(vars map[string]interface{}) error {
+	t, err := p.check(vars)
+	if err != nil {
+		return err
+	}
+	if t != nil && t != boolType {
+		return fmt.Errorf("rules: %q is %s, not a condition", p.src, t)
+	}
+	return nil
+}
+
+func (p *Program) check(vars map[string]interface{}) (reflect.Type, error) {
+	types := make(map[string]reflect.Type, len(vars))
+	for name, v := range vars {
+		types[name] = normalizeType(reflect.TypeOf(v))
+	}
+	return typeOf(p.root, types)
+}
+
+var (
+	numberType = reflect.TypeOf(float64(0))
+	stringType = reflect.TypeOf("")
+	boolType   = reflect.TypeOf(false)
+)
+
+// normalizeType is normalize for types: numbers become float64 and
+// pointers are dereferenced. It returns nil, unknown, for interfaces.
+func normalizeType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Interface:
+		return nil
+	case reflect.Bool:
+		return boolType
+	case reflect.String:
+		return stringType
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return numberType
+	}
+	return t
+}
+
+// typeOf returns the type n evaluates to, nil when that is only known at
+// run time.
+func typeOf(n node, vars map[string]reflect.Type) (reflect.Type, error) {
+	switch n := n.(type) {
+	case literal:
+		if n.v == nil {
+			return nil, nil
+		}
+		return normalizeType(reflect.TypeOf(n.v)), nil
+	case ident:
+		t, ok := vars[n.name]
+		if !ok {
+			return nil, fmt.Errorf("rules: unknown variable %q", n.name)
+		}
+		return t, nil
+	case member:
+		x, err := typeOf(n.x, vars)
+		if err != nil || x == nil {
+			return nil, err
+		}
+		return fieldType(x, n.field)
+	case call:
+		args := make([]reflect.Type, len(n.args))
+		for i, a := range n.args {
+			t, err := typeOf(a, vars)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = t
+		}
+		return callType(n.fn, args)
+	case unary:
+		x, err := typeOf(n.x, vars)
+		if err != nil {
+			return nil, err
+		}
+		want := boolType
+		if n.op == "-" {
+			want = numberType
+		}
+		if x != nil && x != want {
+			return nil, fmt.Errorf("rules: %s applied to %s", n.op, x)
+		}
+		return want, nil
+	case binary:
+		l, err := typeOf(n.l, vars)
+		if err != nil {
+			return nil, err
+		}
+		r, err := typeOf(n.r, vars)
+		if err != nil {
+			return nil, err
+		}
+		return binaryType(n.op, l, r)
+	}
+	return nil, fmt.Errorf("rules: bad expression node %T", n)
+}
+
+// fieldType mirrors field.
+func fieldType(t reflect.Type, name string) (reflect.Type, error) {
+	switch t.Kind() {
+	case reflect.Map:
+		if t.Key().Kind() == reflect.String {
+			return normalizeType(t.Elem()), nil
+		}
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if f.Name == name || tag == name {
+				return normalizeType(f.Type), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("rules: %w %q on %s", errNoField, name, t)
+}
+
+// callType mirrors callFunc.
+func callType(name string, args []reflect.Type) (reflect.Type, error) {
+	want, ok := arity[name]
+	if !ok {
+		return nil, fmt.Errorf("rules: unknown function %s", name)
+	}
+	if len(args) != want {
+		return nil, fmt.Errorf("rules: %s takes %d arguments, got %d", name, want, len(args))
+	}
+	argsOf := func(t reflect.Type, kind string) error {
+		for i, a := range args {
+			if a != nil && a != t {
+				return fmt.Errorf("rules: %s argument %d is %s, not a %s", name, i+1, a, kind)
+			}
+		}
+		return nil
+	}
+	switch name {
+	case "abs", "min", "max":
+		return numberType, argsOf(numberType, "number")
+	case "size":
+		if a := args[0]; a != nil && a != stringType {
+			switch a.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+			default:
+				return nil, fmt.Errorf("rules: size of %s", a)
+			}
+		}
+		return numberType, nil
+	case "lower", "upper":
+		return stringType, argsOf(stringType, "string")
+	}
+	return boolType, argsOf(stringType, "string")
+}
+
+// binaryType mirrors evalBinary.
+func binaryType(op string, l, r reflect.Type) (reflect.Type, error) {
+	switch op {
+	case "&&", "||":
+		for _, t := range []reflect.Type{l, r} {
+			if t != nil && t != boolType {
+				return nil, fmt.Errorf("rules: %s applied to %s", op, t)
+			}
+		}
+		return boolType, nil
+	case "==", "!=":
+		for _, t := range []reflect.Type{l, r} {
+			if t != nil && !t.Comparable() {
+				return nil, fmt.Errorf("rules: %s on %s, which cannot be compared", op, t)
+			}
+		}
+		return boolType, nil
+	}
+	compare := op == "<" || op == "<=" || op == ">" || op == ">="
+	if l == nil || r == nil {
+		if compare {
+			return boolType, nil
+		}
+		return nil, nil
+	}
+	switch {
+	case l == stringType && r == stringType:
+		if compare {
+			return boolType, nil
+		}
+		if op == "+" {
+			return stringType, nil
+		}
+		return nil, fmt.Errorf("rules: %s not defined on strings", op)
+	case l == numberType && r == numberType:
+		if compare {
+			return boolType, nil
+		}
+		return numberType, nil
+	}
+	return nil, fmt.Errorf("rules: %s between %s and %s", op, l, r)
+}
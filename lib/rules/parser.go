@@ -0,0 +1,194 @@
+package rules
+
+import "fmt"
+
+// node is an expression tree node.
+type node interface{}
+
+type (
+	literal struct{ v interface{} }
+	ident   struct{ name string }
+	member  struct {
+		x     node
+		field string
+	}
+	call struct {
+		fn   string
+		args []node // a method call's receiver is args[0]
+	}
+	unary struct {
+		op string
+		x  node
+	}
+	binary struct {
+		op   string
+		l, r node
+	}
+)
+
+type parser struct {
+	toks []token
+	i    int
+}
+
+func (p *parser) peek() token { return p.toks[p.i] }
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) isOp(ops ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != tOp {
+		return "", false
+	}
+	for _, o := range ops {
+		if t.text == o {
+			return o, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) expect(op string) error {
+	t := p.next()
+	if t.kind != tOp || t.text != op {
+		return fmt.Errorf("expected %q at %d", op, t.pos)
+	}
+	return nil
+}
+
+// levels lists binary operators from lowest to highest precedence.
+var levels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *parser) binary(level int) (node, error) {
+	if level == len(levels) {
+		return p.unary()
+	}
+	l, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.isOp(levels[level]...)
+		if !ok {
+			return l, nil
+		}
+		p.next()
+		r, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		l = binary{op, l, r}
+	}
+}
+
+func (p *parser) unary() (node, error) {
+	if op, ok := p.isOp("!", "-"); ok {
+		p.next()
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return unary{op, x}, nil
+	}
+	return p.postfix()
+}
+
+func (p *parser) postfix() (node, error) {
+	x, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.isOp("."); !ok {
+			return x, nil
+		}
+		p.next()
+		t := p.next()
+		if t.kind != tIdent {
+			return nil, fmt.Errorf("expected field name at %d", t.pos)
+		}
+		if _, ok := p.isOp("("); ok {
+			args, err := p.args()
+			if err != nil {
+				return nil, err
+			}
+			x = call{t.text, append([]node{x}, args...)}
+			continue
+		}
+		x = member{x, t.text}
+	}
+}
+
+func (p *parser) args() ([]node, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var args []node
+	if _, ok := p.isOp(")"); ok {
+		p.next()
+		return args, nil
+	}
+	for {
+		a, err := p.binary(0)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, a)
+		if _, ok := p.isOp(","); ok {
+			p.next()
+			continue
+		}
+		return args, p.expect(")")
+	}
+}
+
+func (p *parser) primary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tNumber:
+		return literal{t.num}, nil
+	case tString:
+		return literal{t.text}, nil
+	case tIdent:
+		switch t.text {
+		case "true":
+			return literal{true}, nil
+		case "false":
+			return literal{false}, nil
+		case "null":
+			return literal{nil}, nil
+		}
+		if _, ok := p.isOp("("); ok {
+			args, err := p.args()
+			if err != nil {
+				return nil, err
+			}
+			return call{t.text, args}, nil
+		}
+		return ident{t.text}, nil
+	case tOp:
+		if t.text == "(" {
+			x, err := p.binary(0)
+			if err != nil {
+				return nil, err
+			}
+			return x, p.expect(")")
+		}
+	case tEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %q at %d", t.text, t.pos)
+}
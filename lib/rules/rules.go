@@ -0,0 +1,360 @@
+// Package rules is a small expression language for user-defined alert
+// conditions. The syntax follows the common subset of CEL:
+//
+//	standing.Player && standing.TimeBehindNext < 1.0
+//	session.Raining > 0.2 || session.Session.contains("RACE")
+//	abs(standing.TimeBehindNext - 0.5) < 0.1
+//
+// Values are numbers (float64), strings, booleans and null. Fields of Go
+// structs can be addressed by their Go name or their JSON name; maps by key.
+// Functions: abs, min, max, size, contains, startsWith, endsWith, lower,
+// upper; string functions can also be called as methods.
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Program is a compiled expression.
+type Program struct {
+	src  string
+	root node
+}
+
+// Compile parses an expression.
+func Compile(src string) (*Program, error) {
+	toks, err := lex(src)
+	if err != nil {
+		return nil, fmt.Errorf("rules: %w", err)
+	}
+	p := &parser{toks: toks}
+	root, err := p.binary(0)
+	if err != nil {
+		return nil, fmt.Errorf("rules: %w", err)
+	}
+	if t := p.peek(); t.kind != tEOF {
+		return nil, fmt.Errorf("rules: unexpected %q at %d", t.text, t.pos)
+	}
+	return &Program{src: src, root: root}, nil
+}
+
+func (p *Program) String() string { return p.src }
+
+// Uses reports whether the expression refers to the named variable.
+func (p *Program) Uses(name string) bool {
+	var walk func(n node) bool
+	walk = func(n node) bool {
+		switch n := n.(type) {
+		case ident:
+			return n.name == name
+		case member:
+			return walk(n.x)
+		case call:
+			for _, a := range n.args {
+				if walk(a) {
+					return true
+				}
+			}
+		case unary:
+			return walk(n.x)
+		case binary:
+			return walk(n.l) || walk(n.r)
+		}
+		return false
+	}
+	return walk(p.root)
+}
+
+// Eval evaluates the expression against the named variables.
+func (p *Program) Eval(env map[string]interface{}) (interface{}, error) {
+	return eval(p.root, env)
+}
+
+// EvalBool evaluates a condition. Non-boolean results are an error.
+func (p *Program) EvalBool(env map[string]interface{}) (bool, error) {
+	v, err := p.Eval(env)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("rules: %q is %T, not a condition", p.src, v)
+	}
+	return b, nil
+}
+
+func eval(n node, env map[string]interface{}) (interface{}, error) {
+	switch n := n.(type) {
+	case literal:
+		return n.v, nil
+	case ident:
+		v, ok := env[n.name]
+		if !ok {
+			return nil, fmt.Errorf("rules: unknown variable %q", n.name)
+		}
+		return normalize(reflect.ValueOf(v)), nil
+	case member:
+		x, err := eval(n.x, env)
+		if err != nil {
+			return nil, err
+		}
+		return field(x, n.field)
+	case call:
+		args := make([]interface{}, len(n.args))
+		for i, a := range n.args {
+			v, err := eval(a, env)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = v
+		}
+		return callFunc(n.fn, args)
+	case unary:
+		x, err := eval(n.x, env)
+		if err != nil {
+			return nil, err
+		}
+		switch n.op {
+		case "!":
+			b, ok := x.(bool)
+			if !ok {
+				return nil, fmt.Errorf("rules: ! applied to %T", x)
+			}
+			return !b, nil
+		case "-":
+			f, ok := x.(float64)
+			if !ok {
+				return nil, fmt.Errorf("rules: - applied to %T", x)
+			}
+			return -f, nil
+		}
+	case binary:
+		return evalBinary(n, env)
+	}
+	return nil, fmt.Errorf("rules: bad expression node %T", n)
+}
+
+func evalBinary(n binary, env map[string]interface{}) (interface{}, error) {
+	l, err := eval(n.l, env)
+	if err != nil {
+		return nil, err
+	}
+	// short-circuit logic
+	if n.op == "&&" || n.op == "||" {
+		lb, ok := l.(bool)
+		if !ok {
+			return nil, fmt.Errorf("rules: %s applied to %T", n.op, l)
+		}
+		if (n.op == "&&" && !lb) || (n.op == "||" && lb) {
+			return lb, nil
+		}
+		r, err := eval(n.r, env)
+		if err != nil {
+			return nil, err
+		}
+		rb, ok := r.(bool)
+		if !ok {
+			return nil, fmt.Errorf("rules: %s applied to %T", n.op, r)
+		}
+		return rb, nil
+	}
+	r, err := eval(n.r, env)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==", "!=":
+		for _, x := range []interface{}{l, r} {
+			if x != nil && !reflect.ValueOf(x).Comparable() {
+				return nil, fmt.Errorf("rules: %s on %T, which cannot be compared", n.op, x)
+			}
+		}
+		return (l == r) == (n.op == "=="), nil
+	}
+	if ls, ok := l.(string); ok {
+		rs, ok := r.(string)
+		if !ok {
+			return nil, fmt.Errorf("rules: %s between string and %T", n.op, r)
+		}
+		switch n.op {
+		case "+":
+			return ls + rs, nil
+		case "<":
+			return ls < rs, nil
+		case "<=":
+			return ls <= rs, nil
+		case ">":
+			return ls > rs, nil
+		case ">=":
+			return ls >= rs, nil
+		}
+		return nil, fmt.Errorf("rules: %s not defined on strings", n.op)
+	}
+	lf, lok := l.(float64)
+	rf, rok := r.(float64)
+	if !lok || !rok {
+		return nil, fmt.Errorf("rules: %s between %T and %T", n.op, l, r)
+	}
+	switch n.op {
+	case "+":
+		return lf + rf, nil
+	case "-":
+		return lf - rf, nil
+	case "*":
+		return lf * rf, nil
+	case "/":
+		return lf / rf, nil
+	case "%":
+		return math.Mod(lf, rf), nil
+	case "<":
+		return lf < rf, nil
+	case "<=":
+		return lf <= rf, nil
+	case ">":
+		return lf > rf, nil
+	case ">=":
+		return lf >= rf, nil
+	}
+	return nil, fmt.Errorf("rules: unknown operator %s", n.op)
+}
+
+// normalize converts Go values to the expression value space: all numbers
+// become float64, pointers are dereferenced, structs/maps/slices stay as-is.
+func normalize(v reflect.Value) interface{} {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.String:
+		return v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	}
+	return v.Interface()
+}
+
+var errNoField = errors.New("no such field")
+
+func field(x interface{}, name string) (interface{}, error) {
+	v := reflect.ValueOf(x)
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		e := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		if !e.IsValid() {
+			return nil, nil
+		}
+		return normalize(e), nil
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if f.Name == name || tag == name {
+				return normalize(v.Field(i)), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("rules: %w %q on %T", errNoField, name, x)
+}
+
+// arity is the number of arguments of each function.
+var arity = map[string]int{
+	"abs": 1, "min": 2, "max": 2, "size": 1, "lower": 1, "upper": 1,
+	"contains": 2, "startsWith": 2, "endsWith": 2,
+}
+
+func callFunc(name string, args []interface{}) (interface{}, error) {
+	num := func(i int) (float64, error) {
+		f, ok := args[i].(float64)
+		if !ok {
+			return 0, fmt.Errorf("rules: %s argument %d is %T, not a number", name, i+1, args[i])
+		}
+		return f, nil
+	}
+	str := func(i int) (string, error) {
+		s, ok := args[i].(string)
+		if !ok {
+			return "", fmt.Errorf("rules: %s argument %d is %T, not a string", name, i+1, args[i])
+		}
+		return s, nil
+	}
+	want, ok := arity[name]
+	if !ok {
+		return nil, fmt.Errorf("rules: unknown function %s", name)
+	}
+	if len(args) != want {
+		return nil, fmt.Errorf("rules: %s takes %d arguments, got %d", name, want, len(args))
+	}
+	switch name {
+	case "abs":
+		a, err := num(0)
+		return math.Abs(a), err
+	case "min", "max":
+		a, err := num(0)
+		if err != nil {
+			return nil, err
+		}
+		b, err := num(1)
+		if err != nil {
+			return nil, err
+		}
+		if name == "min" {
+			return math.Min(a, b), nil
+		}
+		return math.Max(a, b), nil
+	case "size":
+		if s, ok := args[0].(string); ok {
+			return float64(len([]rune(s))), nil
+		}
+		v := reflect.ValueOf(args[0])
+		switch v.Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+			return float64(v.Len()), nil
+		}
+		return nil, fmt.Errorf("rules: size of %T", args[0])
+	case "lower", "upper":
+		s, err := str(0)
+		if name == "lower" {
+			return strings.ToLower(s), err
+		}
+		return strings.ToUpper(s), err
+	}
+	a, err := str(0)
+	if err != nil {
+		return nil, err
+	}
+	b, err := str(1)
+	if err != nil {
+		return nil, err
+	}
+	switch name {
+	case "contains":
+		return strings.Contains(a, b), nil
+	case "startsWith":
+		return strings.HasPrefix(a, b), nil
+	default:
+		return strings.HasSuffix(a, b), nil
+	}
+}
@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+var vars = map[string]interface{}{
+	"standing": lib.RestWatchStandingsResponseItem{},
+	"session":  lib.RestWatchSessionInfoResponse{},
+	"extra":    map[string]interface{}{"x": 1.0},
+}
+
+func TestCheckBool(t *testing.T) {
+	for _, tt := range []struct {
+		src string
+		err string // "" for a valid condition
+	}{
+		{src: "standing.Player && standing.TimeBehindNext < 1.0"},
+		{src: `session.raining > 0.2 || session.Session.contains("RACE")`},
+		{src: "abs(standing.TimeBehindNext - 0.5) < 0.1"},
+		{src: "size(session.sectorFlag) > 0 && extra.x == 1"},
+		{src: "standing.carClass == null"},
+		// The right of && and || is checked although evaluating against
+		// zero values stops after the left
+		{src: "standing.Player && standing.TimeBehindNxt < 1.0", err: `no such field "TimeBehindNxt"`},
+		{src: "!standing.Player || stnding.Player", err: `unknown variable "stnding"`},
+		{src: "standing.Player && abs(standing.driverName) > 1", err: "abs argument 1 is string"},
+		{src: "session.sectorFlag == session.sectorFlag", err: "cannot be compared"},
+		{src: "standing.TimeBehindNext + 1", err: "not a condition"},
+		{src: `standing.Player && standing.driverName < 1`, err: "< between string and float64"},
+	} {
+		prog, err := Compile(tt.src)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.src, err)
+		}
+		err = prog.CheckBool(vars)
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("%s: %v", tt.src, err)
+		case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+			t.Errorf("%s: error %v, want one with %q", tt.src, err, tt.err)
+		}
+	}
+}
+
+func TestEvalIncomparable(t *testing.T) {
+	prog, err := Compile("session.sectorFlag != session.sectorFlag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := map[string]interface{}{"session": lib.RestWatchSessionInfoResponse{SectorFlag: []string{"GREEN"}}}
+	if _, err := prog.Eval(env); err == nil || !strings.Contains(err.Error(), "cannot be compared") {
+		t.Errorf("comparing slices: error %v, want one saying they cannot be compared", err)
+	}
+}
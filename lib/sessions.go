@@ -0,0 +1,82 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Hand-written typed wrappers for the session settings. The generated
+// RestSessionsResponse has a struct per setting key seen during
+// generation; these keep every key.
+
+// SessionSetting is one race weekend setting (SESSSET_Num_Opponents,
+// SESSSET_AI_Strength, ...). CurrentValue is a step in [0, NumStepsTotal)
+// unless the setting is free-form; StringValue is the label the menu shows.
+type SessionSetting struct {
+	SettingID     float64 `json:"settingID"`
+	CurrentValue  float64 `json:"currentValue"`
+	NumStepsTotal float64 `json:"numStepsTotal"`
+	StringValue   string  `json:"stringValue"`
+	ValueType     string  `json:"valueType"`
+}
+
+// Session setting keys used by the AI roster.
+const (
+	SettingNumOpponents = "SESSSET_Num_Opponents"
+	SettingAIStrength   = "SESSSET_AI_Strength"
+	SettingAIAggression = "SESSSET_AI_Aggression"
+	SettingCutsAllowed  = "SESSSET_cuts_allowed"
+)
+
+// SessionSettings returns all race weekend settings keyed by setting key.
+func (c *Client) SessionSettings() (map[string]SessionSetting, error) {
+	data, err := c.doRequest("GET", "/rest/sessions/", nil)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]SessionSetting
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("decode session settings: %w", err)
+	}
+	return result, nil
+}
+
+// CutsAllowed returns the track limits warnings a driver may collect before
+// a penalty, from the cuts allowed setting, or 0 when the setting is missing
+// or its label is not a number.
+func (c *Client) CutsAllowed() (int, error) {
+	settings, err := c.SessionSettings()
+	if err != nil {
+		return 0, err
+	}
+	n, _ := strconv.Atoi(strings.TrimSpace(settings[SettingCutsAllowed].StringValue))
+	return n, nil
+}
+
+// SetSessionSetting changes one race weekend setting. The schema does not
+// document the request body; this sends the setting key and new value.
+func (c *Client) SetSessionSetting(key string, value float64) error {
+	body := map[string]interface{}{"sessionSetting": key, "value": value}
+	_, err := c.doRequest("POST", "/rest/sessions/settings", body)
+	return err
+}
+
+// PitSpeedLimit returns the server's pit speed override in km/h, or 0 when
+// the track's own limit applies. The API does not report the track's limit.
+func (c *Client) PitSpeedLimit() (float64, error) {
+	settings, err := c.RestOptionsSettings()
+	if err != nil {
+		return 0, err
+	}
+	o := settings.SERVEROPTPitSpeedOverride
+	// The label reads like "80 km/h", or "Default" without an override
+	if f := strings.Fields(o.StringValue); len(f) > 0 {
+		if v, err := strconv.ParseFloat(f[0], 64); err == nil {
+			return v, nil
+		}
+		return 0, nil
+	}
+	return o.CurrentValue, nil
+}
@@ -0,0 +1,49 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Hand-written typed wrappers for the garage setup. The generated model for
+// the car setup overview has one struct per setting key seen during
+// generation, so settings of other cars are silently dropped; these types
+// keep every key.
+
+// GarageValue is one adjustable setup setting as shown in the garage.
+// Value is the setting's index within [MinValue, MaxValue]; StringValue is
+// the human readable value ("2.10 bar", "-0.8 deg").
+type GarageValue struct {
+	Key         string  `json:"key"`
+	Available   bool    `json:"available"`
+	Value       float64 `json:"value"`
+	StringValue string  `json:"stringValue"`
+	MinValue    float64 `json:"minValue"`
+	MaxValue    float64 `json:"maxValue"`
+	IsFree      bool    `json:"isFreeSetting"`
+}
+
+// CarSetup returns the current setup's garage values keyed by setting key
+// (VM_BRAKE_BALANCE, VM_FRONT_WING, ...).
+func (c *Client) CarSetup() (map[string]GarageValue, error) {
+	data, err := c.doRequest("GET", "/rest/garage/UIScreen/CarSetupOverview", nil)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		CarSetup struct {
+			GarageValues map[string]GarageValue `json:"garageValues"`
+		} `json:"carSetup"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("decode car setup: %w", err)
+	}
+	return result.CarSetup.GarageValues, nil
+}
+
+// SetGarageValue changes one setup setting to the given index, the same
+// request the garage screen sends when a setting is adjusted.
+func (c *Client) SetGarageValue(key string, value float64) error {
+	_, err := c.doRequest("POST", "/rest/garage/"+key, value)
+	return err
+}
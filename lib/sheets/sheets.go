@@ -0,0 +1,199 @@
+// Package sheets appends rows to a Google Sheet with service-account
+// credentials, so leagues scoring in Sheets get laps and results without
+// copying them by hand. It uses the Sheets v4 REST API directly: a signed
+// JWT is exchanged for an access token, which is cached until shortly
+// before it expires.
+package sheets
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const scope = "https://www.googleapis.com/auth/spreadsheets"
+
+// Credentials is the JSON key file of a service account, as downloaded from
+// the Google Cloud console. Share the sheet with its ClientEmail.
+type Credentials struct {
+	Type        string `json:"type"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// LoadCredentials reads a service account key file.
+func LoadCredentials(path string) (*Credentials, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Credentials
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if c.Type != "service_account" || c.ClientEmail == "" || c.PrivateKey == "" {
+		return nil, fmt.Errorf("%s is not a service account key", path)
+	}
+	if c.TokenURI == "" {
+		c.TokenURI = "https://oauth2.googleapis.com/token"
+	}
+	return &c, nil
+}
+
+// Client appends to one spreadsheet.
+type Client struct {
+	Spreadsheet string // the id in the sheet's URL
+	HTTPClient  *http.Client
+	BaseURL     string // default https://sheets.googleapis.com
+
+	creds *Credentials
+	key   *rsa.PrivateKey
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+func NewClient(creds *Credentials, spreadsheet string) (*Client, error) {
+	block, _ := pem.Decode([]byte(creds.PrivateKey))
+	if block == nil {
+		return nil, fmt.Errorf("service account key: no PEM block")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("service account key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("service account key is not RSA")
+	}
+	return &Client{
+		Spreadsheet: spreadsheet,
+		HTTPClient:  &http.Client{Timeout: 30 * time.Second},
+		BaseURL:     "https://sheets.googleapis.com",
+		creds:       creds,
+		key:         key,
+	}, nil
+}
+
+// Append adds rows after the last row of a sheet (tab). Values are entered
+// as if typed, so numbers and dates are parsed by Sheets.
+func (c *Client) Append(sheet string, rows [][]interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	token, err := c.accessToken()
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]interface{}{"values": rows})
+	if err != nil {
+		return err
+	}
+	u := fmt.Sprintf("%s/v4/spreadsheets/%s/values/%s:append?valueInputOption=USER_ENTERED&insertDataOption=INSERT_ROWS",
+		c.BaseURL, url.PathEscape(c.Spreadsheet), url.PathEscape(quoteSheet(sheet)+"!A1"))
+	req, err := http.NewRequest("POST", u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if resp.StatusCode == http.StatusUnauthorized {
+			c.mu.Lock()
+			c.token = ""
+			c.mu.Unlock()
+		}
+		return fmt.Errorf("sheets append %s: %s: %s", sheet, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// quoteSheet quotes a sheet name for A1 notation.
+func quoteSheet(name string) string {
+	return "'" + strings.ReplaceAll(name, "'", "''") + "'"
+}
+
+// accessToken returns a cached token or fetches a new one with a JWT
+// bearer grant.
+func (c *Client) accessToken() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if c.token != "" && now.Before(c.expires) {
+		return c.token, nil
+	}
+	assertion, err := c.jwt(now)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.HTTPClient.PostForm(c.creds.TokenURI, url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("sheets token: %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal(data, &tok); err != nil {
+		return "", fmt.Errorf("sheets token: %w", err)
+	}
+	c.token = tok.AccessToken
+	c.expires = now.Add(time.Duration(tok.ExpiresIn)*time.Second - time.Minute)
+	return c.token, nil
+}
+
+// jwt builds the signed RS256 assertion for the token request.
+func (c *Client) jwt(now time.Time) (string, error) {
+	enc := base64.RawURLEncoding
+	header := enc.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss":   c.creds.ClientEmail,
+		"scope": scope,
+		"aud":   c.creds.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	signing := header + "." + enc.EncodeToString(claims)
+	sum := sha256.Sum256([]byte(signing))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, c.key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", err
+	}
+	return signing + "." + enc.EncodeToString(sig), nil
+}
@@ -0,0 +1,249 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib/delta"
+)
+
+// BestLap is the fastest recorded lap of a driver for one track and car.
+type BestLap struct {
+	Track    string
+	Car      string
+	CarClass string
+	Driver   string
+	DriverID string
+	LapTime  float64
+	S1       float64
+	S2       float64
+	S3       float64
+	Session  string
+	Date     time.Time
+	// MiniSectors is the lap's mini-sector profile; only records have one.
+	MiniSectors delta.Profile
+}
+
+// Filter narrows queries. Empty fields match everything.
+type Filter struct {
+	Track    string
+	Car      string
+	CarClass string
+	DriverID string
+}
+
+func (f Filter) where(alias string) (string, []interface{}) {
+	clause := "1=1"
+	var args []interface{}
+	add := func(col, v string) {
+		if v != "" {
+			clause += " AND " + col + " = ?"
+			args = append(args, v)
+		}
+	}
+	add("s.track", f.Track)
+	add(alias+".car", f.Car)
+	add(alias+".car_class", f.CarClass)
+	add(alias+".driver_id", f.DriverID)
+	return clause, args
+}
+
+// BestLaps returns the best valid lap per track, car and driver, fastest first
+// within each track.
+func (s *Store) BestLaps(f Filter) ([]BestLap, error) {
+	where, args := f.where("l")
+	rows, err := s.db.Query(`
+SELECT s.track, l.car, l.car_class, l.driver, l.driver_id, l.lap_time, l.s1, l.s2, l.s3, s.session, s.started_at
+FROM laps l JOIN sessions s ON s.id = l.session_id
+WHERE `+where+` AND l.lap_time > 0 AND l.pit = 0 AND l.invalid = '' AND l.id = (
+	SELECT l2.id FROM laps l2 JOIN sessions s2 ON s2.id = l2.session_id
+	WHERE s2.track = s.track AND l2.car = l.car AND l2.driver_id = l.driver_id AND l2.lap_time > 0 AND l2.pit = 0 AND l2.invalid = ''
+	ORDER BY l2.lap_time LIMIT 1)
+ORDER BY s.track, l.lap_time`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []BestLap
+	for rows.Next() {
+		var b BestLap
+		var date string
+		if err := rows.Scan(&b.Track, &b.Car, &b.CarClass, &b.Driver, &b.DriverID, &b.LapTime, &b.S1, &b.S2, &b.S3, &b.Session, &date); err != nil {
+			return nil, err
+		}
+		b.Date, _ = time.Parse(time.RFC3339, date)
+		out = append(out, b)
+	}
+	return out, rows.Err()
+}
+
+// SessionPace is a driver's pace in one session.
+type SessionPace struct {
+	SessionID int64
+	Date      time.Time
+	Track     string
+	Session   string
+	Car       string
+	Laps      int
+	Best      float64
+	Average   float64 // mean of valid green laps (see lib/analysis.Kind) within 107% of the session best
+}
+
+// PaceEvolution lists a driver's pace per session in date order, e.g. across
+// the rounds of a championship.
+func (s *Store) PaceEvolution(f Filter) ([]SessionPace, error) {
+	where, args := f.where("l")
+	rows, err := s.db.Query(`
+WITH green AS (
+	SELECT l.session_id, l.car, l.lap_time FROM laps l JOIN sessions s ON s.id = l.session_id
+	WHERE `+where+` AND l.lap_time > 0 AND l.invalid = '' AND (l.kind = 'green' OR (l.kind = '' AND l.pit = 0))
+), best AS (
+	SELECT session_id, car, MIN(lap_time) AS best, COUNT(*) AS n FROM green GROUP BY session_id, car
+)
+SELECT s.id, s.started_at, s.track, s.session, b.car, b.n, b.best,
+	(SELECT AVG(g.lap_time) FROM green g WHERE g.session_id = b.session_id AND g.car = b.car AND g.lap_time <= b.best * 1.07)
+FROM best b JOIN sessions s ON s.id = b.session_id
+ORDER BY s.started_at`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []SessionPace
+	for rows.Next() {
+		var p SessionPace
+		var date string
+		var avg sql.NullFloat64
+		if err := rows.Scan(&p.SessionID, &date, &p.Track, &p.Session, &p.Car, &p.Laps, &p.Best, &avg); err != nil {
+			return nil, err
+		}
+		p.Date, _ = time.Parse(time.RFC3339, date)
+		p.Average = avg.Float64
+		out = append(out, p)
+	}
+	return out, rows.Err()
+}
+
+// Laps returns the laps of a session matching f in car and lap order. With
+// sessionID 0 it uses the latest session that has matching laps.
+func (s *Store) Laps(f Filter, sessionID int64) ([]Lap, error) {
+	where, args := f.where("l")
+	if sessionID == 0 {
+		var latest sql.NullInt64
+		err := s.db.QueryRow(`SELECT MAX(l.session_id) FROM laps l JOIN sessions s ON s.id = l.session_id WHERE `+where, args...).Scan(&latest)
+		if err != nil || !latest.Valid {
+			return nil, err
+		}
+		sessionID = latest.Int64
+	}
+	rows, err := s.db.Query(`
+SELECT l.session_id, l.slot_id, l.driver, l.driver_id, l.car_number, l.car_class, l.car,
+	l.lap, l.lap_time, l.s1, l.s2, l.s3, l.position, l.pit, l.invalid, l.fcy, l.min_gap, l.kind, l.vmax, l.recorded_at
+FROM laps l JOIN sessions s ON s.id = l.session_id
+WHERE `+where+` AND l.session_id = ?
+ORDER BY l.slot_id, l.lap`, append(args, sessionID)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []Lap
+	for rows.Next() {
+		var l Lap
+		var at string
+		if err := rows.Scan(&l.SessionID, &l.SlotID, &l.Driver, &l.DriverID, &l.CarNumber, &l.CarClass, &l.Car,
+			&l.Lap, &l.LapTime, &l.S1, &l.S2, &l.S3, &l.Position, &l.Pit, &l.Invalid, &l.FCY, &l.Gap, &l.Kind, &l.Vmax, &at); err != nil {
+			return nil, err
+		}
+		l.RecordedAt, _ = time.Parse(time.RFC3339, at)
+		out = append(out, l)
+	}
+	return out, rows.Err()
+}
+
+// Session returns a session row. With id 0 it returns the latest session
+// that has results.
+func (s *Store) Session(id int64) (Session, error) {
+	if id == 0 {
+		if err := s.db.QueryRow(`SELECT COALESCE(MAX(session_id), 0) FROM results`).Scan(&id); err != nil {
+			return Session{}, err
+		}
+		if id == 0 {
+			return Session{}, fmt.Errorf("no session with results recorded yet")
+		}
+	}
+	var sess Session
+	var started string
+	err := s.db.QueryRow(`SELECT id, started_at, track, session, game_mode, server FROM sessions WHERE id = ?`, id).
+		Scan(&sess.ID, &started, &sess.Track, &sess.Session, &sess.GameMode, &sess.Server)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Session{}, fmt.Errorf("no session %d", id)
+	}
+	sess.StartedAt, _ = time.Parse(time.RFC3339, started)
+	return sess, err
+}
+
+// PreviousSession returns the latest session before sess at the same track
+// and of the same kind (e.g. RACE1) that has laps, for comparing rounds.
+func (s *Store) PreviousSession(sess Session) (Session, error) {
+	var id int64
+	err := s.db.QueryRow(`
+SELECT COALESCE(MAX(s.id), 0) FROM sessions s
+WHERE s.id < ? AND s.track = ? AND s.session = ? AND EXISTS (SELECT 1 FROM laps l WHERE l.session_id = s.id)`,
+		sess.ID, sess.Track, sess.Session).Scan(&id)
+	if err != nil {
+		return Session{}, err
+	}
+	if id == 0 {
+		return Session{}, fmt.Errorf("no %s at %s recorded before session %d", sess.Session, sess.Track, sess.ID)
+	}
+	return s.Session(id)
+}
+
+// Results returns the classification of a session in position order.
+func (s *Store) Results(sessionID int64) ([]Result, error) {
+	rows, err := s.db.Query(`
+SELECT session_id, slot_id, driver, driver_id, car_number, car_class, car,
+	position, class_position, laps, best_lap, finish_status, pitstops, player
+FROM results WHERE session_id = ? ORDER BY position`, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []Result
+	for rows.Next() {
+		var r Result
+		if err := rows.Scan(&r.SessionID, &r.SlotID, &r.Driver, &r.DriverID, &r.CarNumber, &r.CarClass, &r.Car,
+			&r.Position, &r.ClassPosition, &r.Laps, &r.BestLap, &r.FinishStatus, &r.Pitstops, &r.Player); err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, rows.Err()
+}
+
+// Events returns the events of a session in time order.
+func (s *Store) Events(sessionID int64) ([]EventRow, error) {
+	rows, err := s.db.Query(`
+SELECT session_id, at, session_time, kind, slot_id, driver, car_number, value, detail, involved
+FROM events WHERE session_id = ? ORDER BY session_time, id`, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []EventRow
+	for rows.Next() {
+		var e EventRow
+		var at, involved string
+		if err := rows.Scan(&e.SessionID, &at, &e.SessionTime, &e.Kind, &e.SlotID, &e.Driver, &e.CarNumber, &e.Value, &e.Detail, &involved); err != nil {
+			return nil, err
+		}
+		e.At, _ = time.Parse(time.RFC3339Nano, at)
+		if involved != "" {
+			e.Involved = strings.Split(involved, ",")
+		}
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,374 @@
+package store
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/analysis"
+	"github.com/snipem/go-lmu-api/lib/delta"
+	"github.com/snipem/go-lmu-api/lib/events"
+	"github.com/snipem/go-lmu-api/lib/identity"
+	"github.com/snipem/go-lmu-api/lib/timing"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// Recorder turns live frames into rows: it opens a session row when a new
+// session starts, stores every newly completed lap from the history payload,
+// closes stints at pit laps, and writes results when the session changes or
+// Finish is called.
+type Recorder struct {
+	store *Store
+
+	session   *Session
+	lastET    float64
+	stored    map[int]int            // laps already stored per slot
+	laps      map[int][]analysis.Lap // laps stored per slot, for validity checks
+	fcy       map[int]bool           // a full course yellow was seen on the slot's current lap
+	gap       map[int]float64        // closest gap to the car ahead on the slot's current lap
+	vmax      *timing.Vmax
+	mini      map[int]*delta.Learner // mini-sector profiles of the laps in progress
+	stints    map[int]*openStint
+	standings []lib.RestWatchStandingsResponseItem
+	slots     watch.SlotTracker
+	seq       watch.SeqCheck
+	trackLen  float64
+	player    []trackPos // the player's position in the last two frames
+}
+
+// trackPos is where a car was at a frame, for placing input samples taken
+// between frames.
+type trackPos struct {
+	at   time.Time
+	lap  int
+	dist float64
+}
+
+type openStint struct {
+	car   Entry
+	start int
+	times []float64
+}
+
+func NewRecorder(s *Store) *Recorder {
+	return &Recorder{store: s}
+}
+
+// SessionID returns the current session row, or 0 before the first frame.
+func (r *Recorder) SessionID() int64 {
+	if r.session == nil {
+		return 0
+	}
+	return r.session.ID
+}
+
+func entryOf(s lib.RestWatchStandingsResponseItem) Entry {
+	car := s.CarId
+	if car == "" {
+		car = s.VehicleName
+	}
+	return Entry{
+		SlotID:    int(s.SlotID),
+		Driver:    identity.NormalizeName(s.DriverName),
+		DriverID:  string(identity.Of(s)),
+		CarNumber: s.CarNumber,
+		CarClass:  s.CarClass,
+		Car:       car,
+	}
+}
+
+// Frame records one poll. history is keyed by SlotID as the API returns it.
+// A frame whose sequence number was recorded already is skipped; frames
+// without one (Seq 0) are always recorded.
+func (r *Recorder) Frame(meta watch.FrameMeta, si *lib.RestWatchSessionInfoResponse, standings []lib.RestWatchStandingsResponseItem, history map[string][]lib.RestWatchStandingsHistoryResponseItemItem) error {
+	if si == nil {
+		return nil
+	}
+	var dropped uint64
+	if meta.Seq > 0 {
+		var dup bool
+		if dropped, dup = r.seq.Check(meta.Seq); dup {
+			return nil
+		}
+	}
+	at := meta.At
+	if r.session == nil || si.TrackName != r.session.Track || si.Session != r.session.Session || si.CurrentEventTime < r.lastET {
+		if err := r.Finish(); err != nil {
+			return err
+		}
+		sess := &Session{StartedAt: at, Track: si.TrackName, Session: si.Session, GameMode: si.GameMode, Server: si.ServerName}
+		if err := r.store.AddSession(sess); err != nil {
+			return err
+		}
+		r.session = sess
+		r.stored = map[int]int{}
+		r.laps = map[int][]analysis.Lap{}
+		r.fcy = map[int]bool{}
+		r.gap = map[int]float64{}
+		r.stints = map[int]*openStint{}
+		r.vmax = timing.NewVmax()
+		r.mini = map[int]*delta.Learner{}
+		r.player = nil
+	}
+	r.lastET = si.CurrentEventTime
+	r.trackLen = si.LapDistance
+	for _, s := range standings {
+		if s.Player {
+			r.player = append(r.player, trackPos{at: at, lap: int(s.LapsCompleted) + 1, dist: s.LapDistance})
+			if len(r.player) > 2 {
+				r.player = r.player[1:]
+			}
+			break
+		}
+	}
+	if meta.Seq > 0 {
+		if err := r.store.AddFrame(FrameRow{SessionID: r.session.ID, Seq: meta.Seq, At: at, Latency: meta.Latency, Timings: meta.Timings, Dropped: dropped}); err != nil {
+			return err
+		}
+	}
+	// A reconnect can reshuffle the SlotIDs within a session; follow the
+	// cars so their laps are not stored twice
+	if c := r.slots.Update(standings, si); c.Reshuffled && !c.NewSession {
+		r.stored = watch.Remap(r.stored, c)
+		r.laps = watch.Remap(r.laps, c)
+		r.fcy = watch.Remap(r.fcy, c)
+		r.gap = watch.Remap(r.gap, c)
+		r.stints = watch.Remap(r.stints, c)
+		r.mini = watch.Remap(r.mini, c)
+		r.vmax.Remap(c)
+		for slot, st := range r.stints {
+			st.car.SlotID = slot
+		}
+	}
+	if len(standings) > 0 {
+		r.standings = standings
+	}
+	// Before storing laps, so the top speed and profile of a just completed
+	// lap are final
+	r.vmax.Update(standings)
+	for _, s := range standings {
+		l := r.mini[int(s.SlotID)]
+		if l == nil || l.TrackLength != si.LapDistance {
+			l = delta.NewLearner(si.LapDistance)
+			r.mini[int(s.SlotID)] = l
+		}
+		l.Update(s)
+	}
+
+	cars := make(map[int]lib.RestWatchStandingsResponseItem, len(standings))
+	for _, s := range standings {
+		cars[int(s.SlotID)] = s
+	}
+	for key, laps := range history {
+		slot, err := strconv.Atoi(key)
+		if err != nil {
+			continue
+		}
+		s, ok := cars[slot]
+		if !ok {
+			continue
+		}
+		car := entryOf(s)
+		for i := r.stored[slot]; i < len(laps); i++ {
+			if err := r.lap(at, car, i+1, laps[i]); err != nil {
+				return err
+			}
+			r.stored[slot] = i + 1
+		}
+	}
+	// Laps completed before this frame were stored above; the flag state
+	// and gaps now apply to the laps in progress
+	if analysis.FullCourseYellow(si.YellowFlagState) {
+		for slot := range cars {
+			r.fcy[slot] = true
+		}
+	}
+	for slot, g := range analysis.GapsAhead(standings, si.LapDistance) {
+		if cur := r.gap[slot]; cur == 0 || g < cur {
+			r.gap[slot] = g
+		}
+	}
+	return nil
+}
+
+// Inputs stores input samples taken since the previous frame. Each is
+// placed on track by interpolating the player's lap distance between the
+// last two frames, which is as precise as the frame interval allows.
+func (r *Recorder) Inputs(samples []lib.Inputs) error {
+	if r.session == nil || len(samples) == 0 || len(r.player) == 0 {
+		return nil
+	}
+	rows := make([]InputRow, len(samples))
+	for i, in := range samples {
+		lap, dist := r.playerAt(in.At)
+		rows[i] = InputRow{SessionID: r.session.ID, At: in.At, Lap: lap, LapDistance: dist,
+			Throttle: in.Throttle, Brake: in.Brake, Clutch: in.Clutch, Steer: in.Steer}
+	}
+	return r.store.AddInputs(rows)
+}
+
+func (r *Recorder) playerAt(t time.Time) (int, float64) {
+	b := r.player[len(r.player)-1]
+	if len(r.player) < 2 || !t.Before(b.at) {
+		return b.lap, b.dist
+	}
+	a := r.player[0]
+	if !t.After(a.at) || b.lap < a.lap || b.lap > a.lap+1 {
+		return a.lap, a.dist
+	}
+	// Distance covered between the frames, across the line if need be
+	span := b.dist - a.dist
+	if b.lap > a.lap {
+		span += r.trackLen
+	}
+	f := float64(t.Sub(a.at)) / float64(b.at.Sub(a.at))
+	dist := a.dist + f*span
+	if b.lap > a.lap && dist >= r.trackLen {
+		return b.lap, dist - r.trackLen
+	}
+	return a.lap, dist
+}
+
+func (r *Recorder) lap(at time.Time, car Entry, n int, h lib.RestWatchStandingsHistoryResponseItemItem) error {
+	al := analysis.FromHistory([]lib.RestWatchStandingsHistoryResponseItemItem{h})[0]
+	al.Number = n
+	l := Lap{
+		Entry:      car,
+		SessionID:  r.session.ID,
+		Lap:        n,
+		LapTime:    h.LapTime,
+		S1:         al.S1,
+		S2:         al.S2,
+		S3:         al.S3,
+		Position:   int(h.Position),
+		Pit:        h.Pitting,
+		Invalid:    analysis.DefaultValidity.Check(al, r.laps[car.SlotID]),
+		FCY:        r.fcy[car.SlotID],
+		Gap:        r.gap[car.SlotID],
+		Vmax:       r.vmax.Lap(car.SlotID, n),
+		RecordedAt: at,
+	}
+	delete(r.fcy, car.SlotID)
+	delete(r.gap, car.SlotID)
+	al.FCY, al.Gap = l.FCY, l.Gap
+	r.laps[car.SlotID] = append(r.laps[car.SlotID], al)
+	l.Kind = analysis.DefaultClassifier.Classify(r.laps[car.SlotID], len(r.laps[car.SlotID])-1)
+	if err := r.store.AddLap(l); err != nil {
+		return err
+	}
+	var mini delta.Profile
+	if ml := r.mini[car.SlotID]; ml != nil {
+		if n, p := ml.Last(); n == l.Lap {
+			mini = p
+		}
+	}
+	if _, err := r.store.UpdateRecord(r.session.Track, l, mini); err != nil {
+		return err
+	}
+
+	st, ok := r.stints[car.SlotID]
+	if !ok {
+		st = &openStint{car: car, start: n}
+		r.stints[car.SlotID] = st
+	}
+	if l.Invalid == "" && l.Kind == analysis.Green {
+		st.times = append(st.times, h.LapTime)
+	}
+	if h.Pitting {
+		delete(r.stints, car.SlotID)
+		return r.closeStint(st, n)
+	}
+	return nil
+}
+
+func (r *Recorder) closeStint(st *openStint, end int) error {
+	row := Stint{Entry: st.car, SessionID: r.session.ID, StartLap: st.start, EndLap: end, Laps: end - st.start + 1}
+	var sum float64
+	for _, t := range st.times {
+		sum += t
+		if row.BestLap == 0 || t < row.BestLap {
+			row.BestLap = t
+		}
+	}
+	if len(st.times) > 0 {
+		row.AvgLap = sum / float64(len(st.times))
+	}
+	return r.store.AddStint(row)
+}
+
+// Event stores an event against the current session.
+func (r *Recorder) Event(e events.Event) error {
+	if r.session == nil {
+		return nil
+	}
+	detail := e.Detail
+	if e.Rule != "" {
+		detail = e.Rule + ": " + detail
+	}
+	return r.store.AddEvent(EventRow{
+		SessionID:   r.session.ID,
+		At:          e.At,
+		SessionTime: e.SessionTime,
+		Kind:        string(e.Kind),
+		SlotID:      e.SlotID,
+		Driver:      e.Driver,
+		CarNumber:   e.CarNumber,
+		Value:       e.Value,
+		Detail:      detail,
+		Involved:    e.Involved,
+	})
+}
+
+// Finish closes open stints and writes results from the last standings of
+// the current session. Frame calls it on a session change; call it on exit.
+func (r *Recorder) Finish() error {
+	if r.session == nil {
+		return nil
+	}
+	slots := make([]int, 0, len(r.stints))
+	for slot := range r.stints {
+		slots = append(slots, slot)
+	}
+	sort.Ints(slots)
+	for _, slot := range slots {
+		st := r.stints[slot]
+		if err := r.closeStint(st, r.stored[slot]); err != nil {
+			return err
+		}
+	}
+	r.stints = map[int]*openStint{}
+	return r.SaveResults()
+}
+
+// SaveResults writes results from the last standings of the current
+// session, replacing ones written before. Finish does it too; call it to
+// have the results of a session that is over before the next one starts.
+func (r *Recorder) SaveResults() error {
+	if r.session == nil || len(r.standings) == 0 {
+		return nil
+	}
+	return r.store.SetResults(r.session.ID, Results(r.standings))
+}
+
+// Results converts a standings frame into classification rows.
+func Results(standings []lib.RestWatchStandingsResponseItem) []Result {
+	sorted := append([]lib.RestWatchStandingsResponseItem(nil), standings...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+	classPos := map[string]int{}
+	out := make([]Result, 0, len(sorted))
+	for _, s := range sorted {
+		classPos[s.CarClass]++
+		out = append(out, Result{
+			Entry:         entryOf(s),
+			Position:      int(s.Position),
+			ClassPosition: classPos[s.CarClass],
+			Laps:          int(s.LapsCompleted),
+			BestLap:       s.BestLapTime,
+			FinishStatus:  s.FinishStatus,
+			Pitstops:      int(s.Pitstops),
+			Player:        s.Player,
+		})
+	}
+	return out
+}
@@ -0,0 +1,113 @@
+package store
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib/delta"
+)
+
+// UpdateRecord keeps the records table up to date with a recorded lap: it
+// becomes the driver's record for the track and car when it is valid and
+// faster than the one stored. mini is the lap's mini-sector profile, if it
+// was learned. It reports whether the lap became the record.
+func (s *Store) UpdateRecord(track string, l Lap, mini delta.Profile) (bool, error) {
+	if l.LapTime <= 0 || l.Pit || l.Invalid != "" {
+		return false, nil
+	}
+	var js []byte
+	if mini.Valid() {
+		var err error
+		if js, err = json.Marshal(mini); err != nil {
+			return false, err
+		}
+	}
+	res, err := s.db.Exec(`INSERT INTO records (track, car, car_class, driver, driver_id, lap_time, s1, s2, s3, session_id, set_at, minisectors)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (track, car, driver_id) DO UPDATE SET
+			car_class = excluded.car_class, driver = excluded.driver, lap_time = excluded.lap_time,
+			s1 = excluded.s1, s2 = excluded.s2, s3 = excluded.s3, session_id = excluded.session_id, set_at = excluded.set_at,
+			minisectors = excluded.minisectors
+		WHERE excluded.lap_time < records.lap_time`,
+		track, l.Car, l.CarClass, l.Driver, l.DriverID, l.LapTime, l.S1, l.S2, l.S3, l.SessionID, l.RecordedAt.UTC().Format(time.RFC3339), string(js))
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// Records are the all-time records at a track for one car and class: the
+// driver's own, the fastest anyone recorded in the car, and the fastest
+// in the class. A zero LapTime means no record.
+type Records struct {
+	Personal, Car, Class BestLap
+}
+
+// RecordsFor returns the records a lap in the car can be compared with.
+func (s *Store) RecordsFor(track, car, carClass, driverID string) (Records, error) {
+	var r Records
+	var err error
+	if r.Personal, err = s.fastest("r.track = ? AND r.car = ? AND r.driver_id = ?", track, car, driverID); err != nil {
+		return r, err
+	}
+	if r.Car, err = s.fastest("r.track = ? AND r.car = ?", track, car); err != nil {
+		return r, err
+	}
+	r.Class, err = s.fastest("r.track = ? AND r.car_class = ?", track, carClass)
+	return r, err
+}
+
+func (s *Store) fastest(where string, args ...interface{}) (BestLap, error) {
+	laps, err := s.records(where+" ORDER BY r.lap_time LIMIT 1", args...)
+	if err != nil || len(laps) == 0 {
+		return BestLap{}, err
+	}
+	return laps[0], nil
+}
+
+// ClassRecords returns the fastest recorded lap per track and class, by
+// track and then lap time.
+func (s *Store) ClassRecords(f Filter) ([]BestLap, error) {
+	return s.recordsBy("car_class", f)
+}
+
+// CarRecords returns the fastest recorded lap per track and car.
+func (s *Store) CarRecords(f Filter) ([]BestLap, error) {
+	return s.recordsBy("car", f)
+}
+
+func (s *Store) recordsBy(col string, f Filter) ([]BestLap, error) {
+	where, args := f.where("r")
+	return s.records(where+` AND r.id = (
+	SELECT r2.id FROM records r2 WHERE r2.track = r.track AND r2.`+col+` = r.`+col+`
+	ORDER BY r2.lap_time LIMIT 1)
+ORDER BY r.track, r.lap_time`, args...)
+}
+
+// records queries the records table as r, joined with the session each lap
+// was set in as s.
+func (s *Store) records(where string, args ...interface{}) ([]BestLap, error) {
+	rows, err := s.db.Query(`
+SELECT r.track, r.car, r.car_class, r.driver, r.driver_id, r.lap_time, r.s1, r.s2, r.s3, s.session, r.set_at, r.minisectors
+FROM records r JOIN sessions s ON s.id = r.session_id
+WHERE `+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []BestLap
+	for rows.Next() {
+		var b BestLap
+		var date, mini string
+		if err := rows.Scan(&b.Track, &b.Car, &b.CarClass, &b.Driver, &b.DriverID, &b.LapTime, &b.S1, &b.S2, &b.S3, &b.Session, &date, &mini); err != nil {
+			return nil, err
+		}
+		b.Date, _ = time.Parse(time.RFC3339, date)
+		if mini != "" {
+			json.Unmarshal([]byte(mini), &b.MiniSectors)
+		}
+		out = append(out, b)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,206 @@
+package store
+
+// schemaVersion is stored in PRAGMA user_version. Bump it and add a step to
+// migrations when the schema changes.
+const schemaVersion = 10
+
+// migrations[i] upgrades a database from version i to i+1.
+//
+// Schema (version 1):
+//
+//	sessions  one row per recorded session
+//	  id, started_at (RFC 3339), track, session ("RACE1", "QUALIFY1", ...),
+//	  game_mode, server
+//	laps      one row per completed lap of every car
+//	  session_id, slot_id, driver, driver_id (see lib/identity), car_number,
+//	  car_class, car, lap (1-based), lap_time, s1, s2, s3 (seconds, 0 = no
+//	  time), position, pit (1 when the lap included a pit stop), recorded_at
+//	stints    pit-to-pit runs of one car
+//	  session_id, slot_id, driver, driver_id, car_number, car_class, car,
+//	  start_lap, end_lap, laps, avg_lap, best_lap
+//	results   final classification of a session
+//	  session_id, slot_id, driver, driver_id, car_number, car_class, car,
+//	  position, class_position, laps, best_lap, finish_status, pitstops, player
+//	events    events from lib/events
+//	  session_id, at, session_time, kind, slot_id, driver, car_number, value, detail
+//
+// Version 2 adds laps.invalid: why the lap does not count (see
+// lib/analysis.Validity), empty for valid laps.
+//
+// Version 3 adds laps.fcy: 1 when a full course yellow was out during the
+// lap.
+//
+// Version 4 adds laps.min_gap, the closest on-track gap to the car ahead
+// during the lap in seconds (0 = unknown), and laps.kind, the lap's
+// lib/analysis.Kind ("green", "in-lap", "out-lap", "fcy", "traffic",
+// "untimed"; empty for laps recorded before).
+//
+// Version 5 adds laps.vmax, the top speed seen during the lap in km/h (see
+// lib/timing.Vmax; 0 = unknown).
+//
+// Version 6 adds events.involved, the comma-separated numbers of the other
+// cars involved in an incident.
+//
+// Version 7 adds frames, one row per recorded poll (see lib/watch.FrameMeta):
+// session_id, seq, at (RFC 3339 with nanoseconds), latency (seconds from the
+// start of the capture until it was complete), timings (JSON object of
+// seconds by endpoint) and dropped (frames missing before this one, by
+// their sequence numbers).
+//
+// Version 8 adds inputs, the player's driver inputs sampled between frames
+// (see lib.Inputs): session_id, at (RFC 3339 with nanoseconds), lap (the
+// player's lap in progress), lap_distance (metres), throttle, brake,
+// clutch (0..1) and steer (-1..1).
+//
+// Version 9 adds records, every driver's best valid lap per track and car,
+// kept up to date as laps are recorded and filled from the laps recorded
+// before: track, car, car_class, driver, driver_id, lap_time, s1, s2, s3,
+// session_id (the session the lap was set in) and set_at (RFC 3339).
+//
+// Version 10 adds records.minisectors, the record lap's mini-sector profile
+// as JSON (see lib/delta.Profile), empty when the lap was not seen all the
+// way round.
+var migrations = []string{
+	`
+CREATE TABLE sessions (
+	id         INTEGER PRIMARY KEY,
+	started_at TEXT NOT NULL,
+	track      TEXT NOT NULL,
+	session    TEXT NOT NULL,
+	game_mode  TEXT NOT NULL DEFAULT '',
+	server     TEXT NOT NULL DEFAULT ''
+);
+CREATE TABLE laps (
+	id          INTEGER PRIMARY KEY,
+	session_id  INTEGER NOT NULL REFERENCES sessions(id),
+	slot_id     INTEGER NOT NULL,
+	driver      TEXT NOT NULL,
+	driver_id   TEXT NOT NULL,
+	car_number  TEXT NOT NULL,
+	car_class   TEXT NOT NULL,
+	car         TEXT NOT NULL,
+	lap         INTEGER NOT NULL,
+	lap_time    REAL NOT NULL,
+	s1          REAL NOT NULL,
+	s2          REAL NOT NULL,
+	s3          REAL NOT NULL,
+	position    INTEGER NOT NULL,
+	pit         INTEGER NOT NULL,
+	recorded_at TEXT NOT NULL
+);
+CREATE INDEX laps_session ON laps(session_id, slot_id);
+CREATE INDEX laps_driver ON laps(driver_id);
+CREATE TABLE stints (
+	id         INTEGER PRIMARY KEY,
+	session_id INTEGER NOT NULL REFERENCES sessions(id),
+	slot_id    INTEGER NOT NULL,
+	driver     TEXT NOT NULL,
+	driver_id  TEXT NOT NULL,
+	car_number TEXT NOT NULL,
+	car_class  TEXT NOT NULL,
+	car        TEXT NOT NULL,
+	start_lap  INTEGER NOT NULL,
+	end_lap    INTEGER NOT NULL,
+	laps       INTEGER NOT NULL,
+	avg_lap    REAL NOT NULL,
+	best_lap   REAL NOT NULL
+);
+CREATE TABLE results (
+	id             INTEGER PRIMARY KEY,
+	session_id     INTEGER NOT NULL REFERENCES sessions(id),
+	slot_id        INTEGER NOT NULL,
+	driver         TEXT NOT NULL,
+	driver_id      TEXT NOT NULL,
+	car_number     TEXT NOT NULL,
+	car_class      TEXT NOT NULL,
+	car            TEXT NOT NULL,
+	position       INTEGER NOT NULL,
+	class_position INTEGER NOT NULL,
+	laps           INTEGER NOT NULL,
+	best_lap       REAL NOT NULL,
+	finish_status  TEXT NOT NULL,
+	pitstops       INTEGER NOT NULL,
+	player         INTEGER NOT NULL
+);
+CREATE INDEX results_driver ON results(driver_id);
+CREATE TABLE events (
+	id           INTEGER PRIMARY KEY,
+	session_id   INTEGER NOT NULL REFERENCES sessions(id),
+	at           TEXT NOT NULL,
+	session_time REAL NOT NULL,
+	kind         TEXT NOT NULL,
+	slot_id      INTEGER NOT NULL,
+	driver       TEXT NOT NULL,
+	car_number   TEXT NOT NULL,
+	value        REAL NOT NULL,
+	detail       TEXT NOT NULL
+);
+`,
+	`
+ALTER TABLE laps ADD COLUMN invalid TEXT NOT NULL DEFAULT '';
+`,
+	`
+ALTER TABLE laps ADD COLUMN fcy INTEGER NOT NULL DEFAULT 0;
+`,
+	`
+ALTER TABLE laps ADD COLUMN min_gap REAL NOT NULL DEFAULT 0;
+ALTER TABLE laps ADD COLUMN kind TEXT NOT NULL DEFAULT '';
+`,
+	`
+ALTER TABLE laps ADD COLUMN vmax REAL NOT NULL DEFAULT 0;
+`,
+	`
+ALTER TABLE events ADD COLUMN involved TEXT NOT NULL DEFAULT '';
+`,
+	`
+CREATE TABLE frames (
+	id         INTEGER PRIMARY KEY,
+	session_id INTEGER NOT NULL REFERENCES sessions(id),
+	seq        INTEGER NOT NULL,
+	at         TEXT NOT NULL,
+	latency    REAL NOT NULL,
+	timings    TEXT NOT NULL,
+	dropped    INTEGER NOT NULL
+);
+CREATE INDEX frames_session ON frames(session_id, seq);
+`,
+	`
+CREATE TABLE inputs (
+	id           INTEGER PRIMARY KEY,
+	session_id   INTEGER NOT NULL REFERENCES sessions(id),
+	at           TEXT NOT NULL,
+	lap          INTEGER NOT NULL,
+	lap_distance REAL NOT NULL,
+	throttle     REAL NOT NULL,
+	brake        REAL NOT NULL,
+	clutch       REAL NOT NULL,
+	steer        REAL NOT NULL
+);
+CREATE INDEX inputs_session ON inputs(session_id, lap);
+`,
+	`
+CREATE TABLE records (
+	id         INTEGER PRIMARY KEY,
+	track      TEXT NOT NULL,
+	car        TEXT NOT NULL,
+	car_class  TEXT NOT NULL,
+	driver     TEXT NOT NULL,
+	driver_id  TEXT NOT NULL,
+	lap_time   REAL NOT NULL,
+	s1         REAL NOT NULL,
+	s2         REAL NOT NULL,
+	s3         REAL NOT NULL,
+	session_id INTEGER NOT NULL REFERENCES sessions(id),
+	set_at     TEXT NOT NULL,
+	UNIQUE (track, car, driver_id)
+);
+INSERT INTO records (track, car, car_class, driver, driver_id, lap_time, s1, s2, s3, session_id, set_at)
+SELECT s.track, l.car, l.car_class, l.driver, l.driver_id, MIN(l.lap_time), l.s1, l.s2, l.s3, l.session_id, l.recorded_at
+FROM laps l JOIN sessions s ON s.id = l.session_id
+WHERE l.lap_time > 0 AND l.pit = 0 AND l.invalid = ''
+GROUP BY s.track, l.car, l.driver_id;
+`,
+	`
+ALTER TABLE records ADD COLUMN minisectors TEXT NOT NULL DEFAULT '';
+`,
+}
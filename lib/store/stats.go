@@ -0,0 +1,182 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNoPlayer is returned when the database has no results for the player.
+var ErrNoPlayer = errors.New("no player results recorded yet")
+
+// PlayerID returns the driver_id that most often drove the player car.
+func (s *Store) PlayerID() (string, error) {
+	var id string
+	err := s.db.QueryRow(`SELECT driver_id FROM results WHERE player = 1
+		GROUP BY driver_id ORDER BY COUNT(*) DESC LIMIT 1`).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrNoPlayer
+	}
+	return id, err
+}
+
+// ResolveDriver finds a driver_id from an exact id or a case-insensitive
+// substring of a driver name. Ambiguous names are an error listing the matches.
+func (s *Store) ResolveDriver(query string) (string, error) {
+	var n int
+	if err := s.db.QueryRow(`SELECT COUNT(*) FROM laps WHERE driver_id = ?`, query).Scan(&n); err != nil {
+		return "", err
+	}
+	if n > 0 {
+		return query, nil
+	}
+	rows, err := s.db.Query(`SELECT DISTINCT driver_id, driver FROM laps WHERE LOWER(driver) LIKE ? ORDER BY driver`,
+		"%"+strings.ToLower(query)+"%")
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	ids := map[string]string{}
+	for rows.Next() {
+		var id, name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return "", err
+		}
+		ids[id] = name
+	}
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no driver matches %q", query)
+	case 1:
+		for id := range ids {
+			return id, nil
+		}
+	}
+	var names []string
+	for id, name := range ids {
+		names = append(names, fmt.Sprintf("%s (%s)", name, id))
+	}
+	return "", fmt.Errorf("%q is ambiguous: %s", query, strings.Join(names, ", "))
+}
+
+// FinishStats summarizes a driver's race results in one class.
+type FinishStats struct {
+	CarClass    string
+	Races       int
+	AvgPosition float64 // overall
+	AvgClassPos float64
+	Wins        int // class wins
+	Podiums     int // class podiums
+	DNFs        int
+}
+
+// Finishes returns per-class race statistics for a driver. Only sessions
+// whose name contains RACE count.
+func (s *Store) Finishes(driverID string) ([]FinishStats, error) {
+	rows, err := s.db.Query(`
+SELECT r.car_class, COUNT(*), AVG(r.position), AVG(r.class_position),
+	SUM(r.class_position = 1), SUM(r.class_position <= 3),
+	SUM(r.finish_status NOT IN ('', 'FSTAT_FINISHED', 'FINISHED', 'NONE', 'FSTAT_NONE'))
+FROM results r JOIN sessions s ON s.id = r.session_id
+WHERE r.driver_id = ? AND UPPER(s.session) LIKE '%RACE%'
+GROUP BY r.car_class ORDER BY COUNT(*) DESC`, driverID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []FinishStats
+	for rows.Next() {
+		var f FinishStats
+		if err := rows.Scan(&f.CarClass, &f.Races, &f.AvgPosition, &f.AvgClassPos, &f.Wins, &f.Podiums, &f.DNFs); err != nil {
+			return nil, err
+		}
+		out = append(out, f)
+	}
+	return out, rows.Err()
+}
+
+// CarUsage is how much a driver has driven one car.
+type CarUsage struct {
+	Car      string
+	CarClass string
+	Sessions int
+	Laps     int
+	Best     float64 // best green lap on any track, for orientation
+}
+
+// LapsPerCar returns the laps a driver completed per car, most driven first.
+func (s *Store) LapsPerCar(driverID string) ([]CarUsage, error) {
+	rows, err := s.db.Query(`
+SELECT car, car_class, COUNT(DISTINCT session_id), COUNT(*),
+	COALESCE(MIN(CASE WHEN lap_time > 0 AND pit = 0 THEN lap_time END), 0)
+FROM laps WHERE driver_id = ?
+GROUP BY car, car_class ORDER BY COUNT(*) DESC`, driverID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []CarUsage
+	for rows.Next() {
+		var c CarUsage
+		if err := rows.Scan(&c.Car, &c.CarClass, &c.Sessions, &c.Laps, &c.Best); err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+// HeadToHead compares two drivers over the sessions both were classified in.
+type HeadToHead struct {
+	Sessions   int
+	AheadA     int // sessions where A finished ahead
+	AheadB     int
+	AvgPosA    float64
+	AvgPosB    float64
+	FasterLapA int // sessions where A had the faster best lap
+	FasterLapB int
+}
+
+func (s *Store) HeadToHead(a, b string) (HeadToHead, error) {
+	var h HeadToHead
+	var avgA, avgB sql.NullFloat64
+	err := s.db.QueryRow(`
+SELECT COUNT(*),
+	COALESCE(SUM(ra.position < rb.position), 0), COALESCE(SUM(rb.position < ra.position), 0),
+	AVG(ra.position), AVG(rb.position),
+	COALESCE(SUM(ra.best_lap > 0 AND (rb.best_lap <= 0 OR ra.best_lap < rb.best_lap)), 0),
+	COALESCE(SUM(rb.best_lap > 0 AND (ra.best_lap <= 0 OR rb.best_lap < ra.best_lap)), 0)
+FROM results ra
+JOIN results rb ON rb.session_id = ra.session_id AND rb.driver_id = ?
+WHERE ra.driver_id = ?`, b, a).Scan(&h.Sessions, &h.AheadA, &h.AheadB, &avgA, &avgB, &h.FasterLapA, &h.FasterLapB)
+	h.AvgPosA, h.AvgPosB = avgA.Float64, avgB.Float64
+	return h, err
+}
+
+// RaceResults returns the results of every race session, in the order the
+// sessions were recorded and by class and class position within each.
+// Only sessions whose name contains RACE count.
+func (s *Store) RaceResults(f Filter) ([]Result, error) {
+	where, args := f.where("r")
+	rows, err := s.db.Query(`
+SELECT r.session_id, r.slot_id, r.driver, r.driver_id, r.car_number, r.car_class, r.car,
+	r.position, r.class_position, r.laps, r.best_lap, r.finish_status, r.pitstops, r.player
+FROM results r JOIN sessions s ON s.id = r.session_id
+WHERE `+where+` AND UPPER(s.session) LIKE '%RACE%'
+ORDER BY s.id, r.car_class, r.class_position`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var out []Result
+	for rows.Next() {
+		var r Result
+		if err := rows.Scan(&r.SessionID, &r.SlotID, &r.Driver, &r.DriverID, &r.CarNumber, &r.CarClass, &r.Car,
+			&r.Position, &r.ClassPosition, &r.Laps, &r.BestLap, &r.FinishStatus, &r.Pitstops, &r.Player); err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, rows.Err()
+}
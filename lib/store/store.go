@@ -0,0 +1,271 @@
+// Package store keeps a history of sessions, laps, stints, results and
+// events in a SQLite database, and answers logbook questions about it
+// (personal bests per track and car, pace across a championship). The
+// schema is documented in schema.go.
+//
+// The package works on a *sql.DB and imports no driver, so the library
+// stays free of dependencies; open the database with the SQLite driver of
+// your choice and pass it to New. The tools use modernc.org/sqlite (see
+// cmd/internal/sqlite):
+//
+//	db, err := sql.Open("sqlite", "history.db?_pragma=foreign_keys(1)")
+//	if err != nil {
+//		return err
+//	}
+//	db.SetMaxOpenConns(1)
+//	s, err := store.New(db)
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib/analysis"
+	"github.com/snipem/go-lmu-api/lib/config"
+)
+
+// Store is an open history database.
+type Store struct {
+	db *sql.DB
+}
+
+// DefaultPath is the history database next to the user config file.
+func DefaultPath() string {
+	return filepath.Join(filepath.Dir(config.DefaultPath()), "history.db")
+}
+
+// New uses db, a SQLite database, as the store and brings its schema up to
+// date. Closing the store closes db.
+func New(db *sql.DB) (*Store, error) {
+	s := &Store{db: db}
+	if err := s.migrate(); err != nil {
+		return nil, fmt.Errorf("migrate: %w", err)
+	}
+	return s, nil
+}
+
+func (s *Store) migrate() error {
+	var version int
+	if err := s.db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return err
+	}
+	if version > schemaVersion {
+		return fmt.Errorf("database schema v%d is newer than this tool (v%d)", version, schemaVersion)
+	}
+	for v := version; v < schemaVersion; v++ {
+		tx, err := s.db.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(migrations[v]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("to v%d: %w", v+1, err)
+		}
+		if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", v+1)); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Store) Close() error { return s.db.Close() }
+
+// DB exposes the underlying handle for ad-hoc queries.
+func (s *Store) DB() *sql.DB { return s.db }
+
+// Session is a row of the sessions table.
+type Session struct {
+	ID        int64
+	StartedAt time.Time
+	Track     string
+	Session   string
+	GameMode  string
+	Server    string
+}
+
+// Entry identifies the car and driver a row belongs to. Car is the car
+// model (the API's carId, or the vehicle name when that is empty).
+type Entry struct {
+	SlotID    int
+	Driver    string
+	DriverID  string
+	CarNumber string
+	CarClass  string
+	Car       string
+}
+
+// Lap is a row of the laps table.
+type Lap struct {
+	Entry
+	SessionID  int64
+	Lap        int
+	LapTime    float64
+	S1, S2, S3 float64
+	Position   int
+	Pit        bool
+	Invalid    string  // reason the lap does not count, "" when valid
+	FCY        bool    // a full course yellow was out during the lap
+	Gap        float64 // closest on-track gap to the car ahead, 0 when unknown
+	Kind       analysis.Kind
+	Vmax       float64 // top speed during the lap in km/h, 0 when unknown
+	RecordedAt time.Time
+}
+
+// Analysis converts the row for lib/analysis.
+func (l Lap) Analysis() analysis.Lap {
+	return analysis.Lap{Number: l.Lap, Time: l.LapTime, S1: l.S1, S2: l.S2, S3: l.S3, Pit: l.Pit, FCY: l.FCY, Gap: l.Gap}
+}
+
+// Stint is a row of the stints table.
+type Stint struct {
+	Entry
+	SessionID int64
+	StartLap  int
+	EndLap    int
+	Laps      int
+	AvgLap    float64
+	BestLap   float64
+}
+
+// Result is a row of the results table.
+type Result struct {
+	Entry
+	SessionID     int64
+	Position      int
+	ClassPosition int
+	Laps          int
+	BestLap       float64
+	FinishStatus  string
+	Pitstops      int
+	Player        bool
+}
+
+// FrameRow is a row of the frames table.
+type FrameRow struct {
+	SessionID int64
+	Seq       uint64
+	At        time.Time
+	Latency   time.Duration
+	Timings   map[string]time.Duration
+	Dropped   uint64 // frames missing before this one
+}
+
+// InputRow is a row of the inputs table.
+type InputRow struct {
+	SessionID   int64
+	At          time.Time
+	Lap         int
+	LapDistance float64
+	Throttle    float64
+	Brake       float64
+	Clutch      float64
+	Steer       float64
+}
+
+// EventRow is a row of the events table.
+type EventRow struct {
+	SessionID   int64
+	At          time.Time
+	SessionTime float64
+	Kind        string
+	SlotID      int
+	Driver      string
+	CarNumber   string
+	Value       float64
+	Detail      string
+	Involved    []string // car numbers of the other cars in an incident
+}
+
+func (s *Store) AddSession(sess *Session) error {
+	res, err := s.db.Exec(`INSERT INTO sessions (started_at, track, session, game_mode, server) VALUES (?, ?, ?, ?, ?)`,
+		sess.StartedAt.UTC().Format(time.RFC3339), sess.Track, sess.Session, sess.GameMode, sess.Server)
+	if err != nil {
+		return err
+	}
+	sess.ID, err = res.LastInsertId()
+	return err
+}
+
+func (s *Store) AddLap(l Lap) error {
+	_, err := s.db.Exec(`INSERT INTO laps (session_id, slot_id, driver, driver_id, car_number, car_class, car,
+		lap, lap_time, s1, s2, s3, position, pit, invalid, fcy, min_gap, kind, vmax, recorded_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		l.SessionID, l.SlotID, l.Driver, l.DriverID, l.CarNumber, l.CarClass, l.Car,
+		l.Lap, l.LapTime, l.S1, l.S2, l.S3, l.Position, l.Pit, l.Invalid, l.FCY, l.Gap, string(l.Kind), l.Vmax, l.RecordedAt.UTC().Format(time.RFC3339))
+	return err
+}
+
+func (s *Store) AddStint(st Stint) error {
+	_, err := s.db.Exec(`INSERT INTO stints (session_id, slot_id, driver, driver_id, car_number, car_class, car,
+		start_lap, end_lap, laps, avg_lap, best_lap) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		st.SessionID, st.SlotID, st.Driver, st.DriverID, st.CarNumber, st.CarClass, st.Car,
+		st.StartLap, st.EndLap, st.Laps, st.AvgLap, st.BestLap)
+	return err
+}
+
+// SetResults replaces the classification of a session.
+func (s *Store) SetResults(sessionID int64, results []Result) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(`DELETE FROM results WHERE session_id = ?`, sessionID); err != nil {
+		return err
+	}
+	for _, r := range results {
+		if _, err := tx.Exec(`INSERT INTO results (session_id, slot_id, driver, driver_id, car_number, car_class, car,
+			position, class_position, laps, best_lap, finish_status, pitstops, player)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			sessionID, r.SlotID, r.Driver, r.DriverID, r.CarNumber, r.CarClass, r.Car,
+			r.Position, r.ClassPosition, r.Laps, r.BestLap, r.FinishStatus, r.Pitstops, r.Player); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *Store) AddFrame(f FrameRow) error {
+	timings := make(map[string]float64, len(f.Timings))
+	for name, d := range f.Timings {
+		timings[name] = d.Seconds()
+	}
+	js, err := json.Marshal(timings)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.Exec(`INSERT INTO frames (session_id, seq, at, latency, timings, dropped) VALUES (?, ?, ?, ?, ?, ?)`,
+		f.SessionID, int64(f.Seq), f.At.UTC().Format(time.RFC3339Nano), f.Latency.Seconds(), string(js), int64(f.Dropped))
+	return err
+}
+
+// AddInputs stores a batch of input samples in one transaction.
+func (s *Store) AddInputs(rows []InputRow) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, in := range rows {
+		if _, err := tx.Exec(`INSERT INTO inputs (session_id, at, lap, lap_distance, throttle, brake, clutch, steer) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			in.SessionID, in.At.UTC().Format(time.RFC3339Nano), in.Lap, in.LapDistance, in.Throttle, in.Brake, in.Clutch, in.Steer); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *Store) AddEvent(e EventRow) error {
+	_, err := s.db.Exec(`INSERT INTO events (session_id, at, session_time, kind, slot_id, driver, car_number, value, detail, involved)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		e.SessionID, e.At.UTC().Format(time.RFC3339Nano), e.SessionTime, e.Kind, e.SlotID, e.Driver, e.CarNumber, e.Value, e.Detail, strings.Join(e.Involved, ","))
+	return err
+}
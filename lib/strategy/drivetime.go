@@ -0,0 +1,136 @@
+package strategy
+
+import (
+	"sort"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// DriveLimits are the driver time rules of an endurance race. Zero values
+// disable the corresponding check.
+type DriveLimits struct {
+	MaxStint   time.Duration // longest continuous spell at the wheel
+	MaxTotal   time.Duration // total drive time per driver
+	WarnBefore time.Duration // start warning this long before a limit
+}
+
+// DriveWarning is raised when a driver approaches or exceeds a limit.
+type DriveWarning struct {
+	SlotID    int
+	Driver    string
+	Kind      string // "stint" or "total"
+	Used      time.Duration
+	Limit     time.Duration
+	Remaining time.Duration // negative once exceeded
+}
+
+func (w DriveWarning) Exceeded() bool { return w.Remaining < 0 }
+
+// DriveTime accumulates drive time per driver and car from standings frames,
+// detecting driver changes by a change of driver name in a slot. Time is
+// measured in session seconds so pauses don't count, and time spent in the
+// garage stall is not drive time.
+type DriveTime struct {
+	Limits DriveLimits
+
+	cars map[int]*carDrive
+}
+
+type carDrive struct {
+	driver     string
+	stintStart float64
+	lastET     float64
+	stint      float64
+	totals     map[string]float64
+}
+
+func NewDriveTime(limits DriveLimits) *DriveTime {
+	return &DriveTime{Limits: limits, cars: map[int]*carDrive{}}
+}
+
+// Update feeds one standings frame taken at session time et (seconds, e.g.
+// sessionInfo.currentEventTime). Session time going backwards resets all
+// tracking since a new session started.
+func (d *DriveTime) Update(standings []lib.RestWatchStandingsResponseItem, et float64) {
+	for _, s := range standings {
+		slot := int(s.SlotID)
+		c, ok := d.cars[slot]
+		if !ok || et < c.lastET {
+			c = &carDrive{driver: s.DriverName, stintStart: et, lastET: et, totals: map[string]float64{}}
+			d.cars[slot] = c
+			continue
+		}
+		dt := et - c.lastET
+		c.lastET = et
+		if s.DriverName != c.driver {
+			c.driver = s.DriverName
+			c.stint = 0
+			c.stintStart = et
+			continue
+		}
+		if s.InGarageStall {
+			continue
+		}
+		c.stint += dt
+		c.totals[s.DriverName] += dt
+	}
+}
+
+// Remap carries the tracking over reshuffled SlotIDs (see watch.SlotTracker)
+// and drops it after a new session.
+func (d *DriveTime) Remap(c watch.Change) {
+	d.cars = watch.Remap(d.cars, c)
+}
+
+// Stint returns the current driver of a slot and their continuous drive time.
+func (d *DriveTime) Stint(slot int) (string, time.Duration) {
+	c, ok := d.cars[slot]
+	if !ok {
+		return "", 0
+	}
+	return c.driver, secs(c.stint)
+}
+
+// Totals returns the accumulated drive time of every driver of a slot.
+func (d *DriveTime) Totals(slot int) map[string]time.Duration {
+	c, ok := d.cars[slot]
+	if !ok {
+		return nil
+	}
+	out := make(map[string]time.Duration, len(c.totals))
+	for k, v := range c.totals {
+		out[k] = secs(v)
+	}
+	return out
+}
+
+// Warnings lists current drivers within WarnBefore of a limit or past it,
+// most urgent first.
+func (d *DriveTime) Warnings() []DriveWarning {
+	var out []DriveWarning
+	check := func(slot int, driver, kind string, used, limit time.Duration) {
+		if limit <= 0 {
+			return
+		}
+		if rem := limit - used; rem <= d.Limits.WarnBefore {
+			out = append(out, DriveWarning{SlotID: slot, Driver: driver, Kind: kind, Used: used, Limit: limit, Remaining: rem})
+		}
+	}
+	for slot, c := range d.cars {
+		check(slot, c.driver, "stint", secs(c.stint), d.Limits.MaxStint)
+		check(slot, c.driver, "total", secs(c.totals[c.driver]), d.Limits.MaxTotal)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Remaining != out[j].Remaining {
+			return out[i].Remaining < out[j].Remaining
+		}
+		return out[i].SlotID < out[j].SlotID
+	})
+	return out
+}
+
+func secs(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,117 @@
+package strategy
+
+import (
+	"math"
+	"sort"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/analysis"
+)
+
+// DefaultRunLaps is how many green laps a run needs to count as a long run.
+const DefaultRunLaps = 5
+
+// Run is a car's laps between two visits to the pits.
+type Run struct {
+	First, Last int     // lap numbers, 1-based, out- and in-lap included
+	Green       int     // representative laps (see analysis.Kind)
+	Pace        float64 // mean of the green laps, slow outliers left out
+	Best        float64 // best green lap
+}
+
+// Runs splits a car's laps into runs, each ending with an in-lap or, for
+// the run still going, the last lap completed. The first lap of the
+// session is an out-lap from the garage and does not count towards the
+// pace either.
+func Runs(laps []analysis.Lap, c analysis.Classifier) []Run {
+	var out []Run
+	var times []float64
+	start := 0
+	for i, l := range laps {
+		if i > 0 && c.Classify(laps, i) == analysis.Green {
+			times = append(times, l.Time)
+		}
+		if l.Pit || i == len(laps)-1 {
+			r := Run{First: laps[start].Number, Last: l.Number}
+			r.Green, r.Pace, r.Best = runPace(times)
+			out = append(out, r)
+			times, start = nil, i+1
+		}
+	}
+	return out
+}
+
+// runPace averages lap times, leaving out laps more than 7% slower than the
+// best, as Pace does.
+func runPace(times []float64) (n int, pace, best float64) {
+	if len(times) == 0 {
+		return 0, 0, 0
+	}
+	best = times[0]
+	for _, t := range times {
+		best = math.Min(best, t)
+	}
+	var sum float64
+	for _, t := range times {
+		if t <= best*1.07 {
+			sum += t
+			n++
+		}
+	}
+	return n, sum / float64(n), best
+}
+
+// LongRun is a car's long-run pace in practice.
+type LongRun struct {
+	SlotID    int
+	CarNumber string
+	Driver    string
+	CarClass  string
+	Runs      []Run
+	// Pace is the pace of the car's fastest long run, 0 without a run of
+	// the minimum length; Laps is that run's green laps.
+	Pace float64
+	Laps int
+	// BestLap is the car's best lap, for comparison with single-lap pace.
+	BestLap float64
+}
+
+// LongRuns splits every car's history into runs and ranks the cars by the
+// pace of their fastest run with at least minLaps green laps (0 means
+// DefaultRunLaps). Cars without such a run follow, by best lap.
+func LongRuns(standings []lib.RestWatchStandingsResponseItem, history map[int][]lib.RestWatchStandingsHistoryResponseItemItem, minLaps int) []LongRun {
+	if minLaps <= 0 {
+		minLaps = DefaultRunLaps
+	}
+	out := make([]LongRun, 0, len(standings))
+	for _, s := range standings {
+		lr := LongRun{
+			SlotID:    int(s.SlotID),
+			CarNumber: s.CarNumber,
+			Driver:    s.DriverName,
+			CarClass:  s.CarClass,
+			BestLap:   s.BestLapTime,
+			Runs:      Runs(analysis.FromHistory(history[int(s.SlotID)]), analysis.DefaultClassifier),
+		}
+		for _, r := range lr.Runs {
+			if r.Green >= minLaps && (lr.Pace == 0 || r.Pace < lr.Pace) {
+				lr.Pace, lr.Laps = r.Pace, r.Green
+			}
+		}
+		out = append(out, lr)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if (a.Pace > 0) != (b.Pace > 0) {
+			return a.Pace > 0
+		}
+		if a.Pace > 0 {
+			return a.Pace < b.Pace
+		}
+		if (a.BestLap > 0) != (b.BestLap > 0) {
+			return a.BestLap > 0
+		}
+		return a.BestLap < b.BestLap
+	})
+	return out
+}
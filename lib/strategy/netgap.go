@@ -0,0 +1,165 @@
+package strategy
+
+import (
+	"math"
+	"sort"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// PitLoss measures the average time a pit stop costs, in seconds, from the
+// standings history: the laps flagged as pitting plus the lap after them,
+// against each car's pace. Stops with implausible losses (penalties served
+// in the garage, repairs) are ignored. It returns 0 until a stop was seen.
+func PitLoss(history map[int][]lib.RestWatchStandingsHistoryResponseItemItem, pace map[int]float64) float64 {
+	var losses []float64
+	for slot, laps := range history {
+		p := pace[slot]
+		if p <= 0 {
+			continue
+		}
+		for i := 0; i < len(laps); i++ {
+			if !laps[i].Pitting || (i > 0 && laps[i-1].Pitting) {
+				continue
+			}
+			// The stop's laps and the out-lap after them
+			var sum float64
+			n, complete := 0, true
+			for j := i; j < len(laps) && (j == i || laps[j-1].Pitting); j++ {
+				if laps[j].LapTime <= 0 {
+					complete = false
+					break
+				}
+				sum += laps[j].LapTime
+				n++
+			}
+			if !complete || n < 2 {
+				continue // the out-lap is not done yet
+			}
+			if loss := sum - float64(n)*p; loss > 5 && loss < 300 {
+				losses = append(losses, loss)
+			}
+		}
+	}
+	if len(losses) == 0 {
+		return 0
+	}
+	sort.Float64s(losses)
+	return losses[len(losses)/2]
+}
+
+// NetGap is a car's place once the current pit cycle has played out.
+type NetGap struct {
+	// Owed is 1 when the car has yet to make the stop others in its class
+	// already made in this cycle.
+	Owed int
+	// Laps and Gap are the net laps and seconds behind the net class leader.
+	Laps float64
+	Gap  float64
+	// Position is the net position in class.
+	Position int
+}
+
+// NetGaps adjusts every car's gap for the pit cycle, keyed by SlotID. A
+// class's cycle is the most stops any of its cars made; a car with fewer
+// is charged pitLoss for the stop it still has to make. Cars are ordered by
+// laps and then time behind the overall leader, like the game does.
+// Outside a cycle, when all cars of a class made the same number of stops,
+// net and real gaps agree.
+func NetGaps(standings []lib.RestWatchStandingsResponseItem, pitLoss float64) map[int]NetGap {
+	cycle := map[string]float64{}
+	for _, s := range standings {
+		cycle[s.CarClass] = max(cycle[s.CarClass], s.Pitstops)
+	}
+	type entry struct {
+		slot  int
+		class string
+		laps  float64
+		time  float64
+		owed  int
+	}
+	var list []entry
+	for _, s := range standings {
+		e := entry{slot: int(s.SlotID), class: s.CarClass, laps: s.LapsBehindLeader, time: s.TimeBehindLeader}
+		if s.Pitstops < cycle[s.CarClass] {
+			e.owed = 1
+			e.time += pitLoss
+		}
+		list = append(list, e)
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].laps != list[j].laps {
+			return list[i].laps < list[j].laps
+		}
+		return list[i].time < list[j].time
+	})
+	out := make(map[int]NetGap, len(list))
+	leader := map[string]entry{}
+	pos := map[string]int{}
+	for _, e := range list {
+		l, ok := leader[e.class]
+		if !ok {
+			l = e
+			leader[e.class] = e
+		}
+		pos[e.class]++
+		n := NetGap{Owed: e.owed, Laps: e.laps - l.laps, Position: pos[e.class]}
+		if n.Laps == 0 {
+			n.Gap = e.time - l.time
+		}
+		out[e.slot] = n
+	}
+	return out
+}
+
+// Rejoin projects where a car comes out if it pits now: its class
+// position and the cars of its class directly ahead of and behind it
+// (SlotIDs, -1 for none) with the gaps to them in seconds.
+type Rejoin struct {
+	Position            int
+	Ahead, Behind       int
+	GapAhead, GapBehind float64
+}
+
+// ProjectRejoin works out a Rejoin for slot from the cars' times behind
+// the leader and the pit loss. Cars a lap or more apart are ordered by
+// laps and not given a gap (0). ok is false when the car is not in the
+// standings.
+func ProjectRejoin(standings []lib.RestWatchStandingsResponseItem, slot int, pitLoss float64) (r Rejoin, ok bool) {
+	var me lib.RestWatchStandingsResponseItem
+	for _, s := range standings {
+		if int(s.SlotID) == slot {
+			me, ok = s, true
+		}
+	}
+	if !ok {
+		return r, false
+	}
+	at := me.TimeBehindLeader + pitLoss
+	r = Rejoin{Position: 1, Ahead: -1, Behind: -1}
+	for _, s := range standings {
+		if int(s.SlotID) == slot || s.CarClass != me.CarClass {
+			continue
+		}
+		d := s.TimeBehindLeader - at
+		if laps := s.LapsBehindLeader - me.LapsBehindLeader; laps != 0 {
+			d = math.Copysign(math.Inf(1), laps)
+		}
+		switch {
+		case d <= 0:
+			r.Position++
+			if r.Ahead < 0 || -d < r.GapAhead {
+				r.Ahead, r.GapAhead = int(s.SlotID), -d
+			}
+		case r.Behind < 0 || d < r.GapBehind:
+			r.Behind, r.GapBehind = int(s.SlotID), d
+		}
+	}
+	if math.IsInf(r.GapAhead, 0) {
+		r.GapAhead = 0
+	}
+	if math.IsInf(r.GapBehind, 0) {
+		r.GapBehind = 0
+	}
+	return r, true
+}
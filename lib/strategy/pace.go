@@ -0,0 +1,61 @@
+// Package strategy holds race-strategy calculations built on the watch
+// endpoints: car pace, traffic forecasts and stint planning.
+package strategy
+
+import (
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/analysis"
+)
+
+// DefaultPaceLaps is how many recent laps Pace averages.
+const DefaultPaceLaps = 5
+
+// Pace returns a car's representative lap time in seconds: the mean of its
+// last n green laps (see lib/analysis.Kind; from the history alone only
+// in- and out-laps can be told apart), ignoring laps more than 7% slower than its
+// best of those (traffic, off-tracks). It falls back to the given fallback
+// (typically the standings' last or best lap) when there is no history.
+func Pace(laps []lib.RestWatchStandingsHistoryResponseItemItem, n int, fallback float64) float64 {
+	if n <= 0 {
+		n = DefaultPaceLaps
+	}
+	recent := analysis.DefaultClassifier.GreenTimes(analysis.FromHistory(laps))
+	if len(recent) > n {
+		recent = recent[len(recent)-n:]
+	}
+	if len(recent) == 0 {
+		return fallback
+	}
+	best := recent[0]
+	for _, t := range recent {
+		if t < best {
+			best = t
+		}
+	}
+	var sum float64
+	var cnt int
+	for _, t := range recent {
+		if t <= best*1.07 {
+			sum += t
+			cnt++
+		}
+	}
+	return sum / float64(cnt)
+}
+
+// CarPace computes Pace for every car in a standings frame, keyed by SlotID.
+// history is keyed by SlotID as well.
+func CarPace(standings []lib.RestWatchStandingsResponseItem, history map[int][]lib.RestWatchStandingsHistoryResponseItemItem, n int) map[int]float64 {
+	out := make(map[int]float64, len(standings))
+	for _, s := range standings {
+		fallback := s.LastLapTime
+		if fallback <= 0 {
+			fallback = s.BestLapTime
+		}
+		if fallback <= 0 {
+			fallback = s.EstimatedLapTime
+		}
+		out[int(s.SlotID)] = Pace(history[int(s.SlotID)], n, fallback)
+	}
+	return out
+}
@@ -0,0 +1,90 @@
+package strategy
+
+import (
+	"math"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Progress is how far a race is run, from the leader's point of view.
+type Progress struct {
+	// LeaderLap is the lap the leader is on, counting from 1.
+	LeaderLap int
+	// TotalLaps is the race distance in the leader's laps: the lap limit,
+	// or in timed races the laps the leader completes at its current pace
+	// before the flag (Estimated). 0 when unknown.
+	TotalLaps int
+	Estimated bool
+	// TimeLeft is the session time left in seconds, when the race is
+	// timed (Timed).
+	TimeLeft float64
+	Timed    bool
+}
+
+// CarLaps returns a car's current lap and its race distance. A lapped car
+// takes the flag when the leader does, so its distance is short by the laps
+// it is down.
+func (p Progress) CarLaps(s lib.RestWatchStandingsResponseItem) (lap, total int) {
+	lap = int(s.LapsCompleted) + 1
+	if p.TotalLaps == 0 {
+		return lap, 0
+	}
+	total = p.TotalLaps - int(math.Floor(s.LapsBehindLeader))
+	return min(lap, total), total
+}
+
+// RaceProgress works out the race distance and the leader's lap. pace is
+// the leader's lap time used for timed races; 0 falls back to its last or
+// estimated lap. The lap in progress when time runs out is completed, as in
+// the game.
+func RaceProgress(si *lib.RestWatchSessionInfoResponse, standings []lib.RestWatchStandingsResponseItem, pace float64) Progress {
+	var p Progress
+	if si == nil {
+		return p
+	}
+	var leader *lib.RestWatchStandingsResponseItem
+	for i := range standings {
+		if standings[i].Position == 1 {
+			leader = &standings[i]
+			break
+		}
+	}
+	if si.EndEventTime > 0 {
+		p.Timed = true
+		p.TimeLeft = math.Max(0, si.EndEventTime-si.CurrentEventTime)
+	}
+	if leader == nil {
+		return p
+	}
+	p.LeaderLap = int(leader.LapsCompleted) + 1
+
+	if si.MaximumLaps > 0 && si.MaximumLaps < 10000 {
+		p.TotalLaps = int(si.MaximumLaps)
+	}
+	if p.Timed {
+		if pace <= 0 {
+			pace = leader.LastLapTime
+		}
+		if pace <= 0 {
+			pace = leader.EstimatedLapTime
+		}
+		if pace > 0 {
+			// The rest of the current lap, then every lap started in time
+			frac := 0.0
+			if si.LapDistance > 0 {
+				frac = math.Min(math.Max(leader.LapDistance/si.LapDistance, 0), 1)
+			}
+			total := p.LeaderLap
+			if rest := p.TimeLeft - (1-frac)*pace; rest > 0 {
+				total += int(math.Ceil(rest / pace))
+			}
+			if p.TotalLaps == 0 || total < p.TotalLaps {
+				p.TotalLaps, p.Estimated = total, true
+			}
+		}
+	}
+	if p.TotalLaps > 0 {
+		p.LeaderLap = min(p.LeaderLap, p.TotalLaps)
+	}
+	return p
+}
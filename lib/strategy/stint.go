@@ -0,0 +1,156 @@
+package strategy
+
+import (
+	"math"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Tank is a consumable the car races on: litres of fuel for cars without a
+// virtual energy budget, percent of virtual energy for Hypercars and other
+// BoP-governed classes. All amounts are in Unit.
+type Tank struct {
+	Unit     string  // "L" or "%"
+	Level    float64 // current amount
+	Capacity float64
+	PerLap   float64 // consumption per lap
+
+	FillRate float64 // Unit per second while refuelling
+	Insert   float64 // seconds to connect before refuelling starts
+	Remove   float64 // seconds to disconnect after refuelling
+}
+
+// NewTank builds the tank the car actually races on from the garage data.
+// Consumption comes from the game's estimate; pass a measured value (see
+// EnergyPerLap) through Tank.PerLap to override it once laps are available.
+func NewTank(e lib.EnergyState, u lib.ExpectedUsage, r lib.RefuelRates) Tank {
+	if e.UsesVirtualEnergy() {
+		t := Tank{
+			Unit:     "%",
+			Level:    e.EnergyPercent(),
+			Capacity: 100,
+			PerLap:   u.EnergyFractionPerLap * 100,
+			Insert:   r.EnergyInsert,
+			Remove:   r.EnergyRemove,
+		}
+		if e.MaxVirtualEnergy > 0 {
+			t.FillRate = r.EnergyFillRate / e.MaxVirtualEnergy * 100
+		}
+		return t
+	}
+	return Tank{
+		Unit:     "L",
+		Level:    e.Fuel,
+		Capacity: e.MaxFuel,
+		PerLap:   u.FuelPerLap,
+		FillRate: r.FuelFillRate,
+		Insert:   r.FuelInsert,
+		Remove:   r.FuelRemove,
+	}
+}
+
+// EnergyPerLap measures average virtual energy use in percent per lap from a
+// driver's usage history, skipping laps that include a pit stop. It returns
+// 0 when fewer than two consecutive green laps are available.
+func EnergyPerLap(laps []lib.UsageLap) float64 {
+	var sum float64
+	var n int
+	for i := 1; i < len(laps); i++ {
+		prev, cur := laps[i-1], laps[i]
+		if cur.Pit || prev.Pit || cur.Stint != prev.Stint {
+			continue
+		}
+		used := prev.VE - cur.VE
+		if used <= 0 {
+			continue
+		}
+		sum += used * 100
+		n++
+	}
+	if n == 0 {
+		return 0
+	}
+	return sum / float64(n)
+}
+
+// LapsLeft is how many laps the current level lasts.
+func (t Tank) LapsLeft() float64 {
+	if t.PerLap <= 0 {
+		return math.Inf(1)
+	}
+	return t.Level / t.PerLap
+}
+
+// LapsPerStint is how many laps a full tank lasts.
+func (t Tank) LapsPerStint() float64 {
+	if t.PerLap <= 0 {
+		return math.Inf(1)
+	}
+	return t.Capacity / t.PerLap
+}
+
+// RefuelTime is the stationary time to add amount, including connecting and
+// disconnecting the rig.
+func (t Tank) RefuelTime(amount float64) float64 {
+	if amount <= 0 {
+		return 0
+	}
+	if t.FillRate <= 0 {
+		return math.Inf(1)
+	}
+	return t.Insert + amount/t.FillRate + t.Remove
+}
+
+// StintPlan is the outcome of planning the rest of the race on one tank type.
+type StintPlan struct {
+	LapsLeft     float64 // on the current level
+	LapsPerStint float64 // on a full tank
+	Stops        int     // stops still required to finish
+	NextAdd      float64 // amount to add at the next stop, in Tank.Unit
+	NextTime     float64 // refuel seconds for NextAdd
+	Margin       float64 // spare laps at the finish with this plan
+}
+
+// Plan works out stops and refuel amounts to cover lapsRemaining plus a
+// safety margin in laps, assuming each stop is made on a nearly empty tank.
+// Stops fill the tank, except that the last one only adds what is needed.
+func Plan(t Tank, lapsRemaining, safety float64) StintPlan {
+	p := StintPlan{LapsLeft: t.LapsLeft(), LapsPerStint: t.LapsPerStint()}
+	if t.PerLap <= 0 {
+		return p
+	}
+	need := (lapsRemaining+safety)*t.PerLap - t.Level
+	if need <= 0 {
+		p.Margin = (t.Level - lapsRemaining*t.PerLap) / t.PerLap
+		return p
+	}
+	p.Stops = int(math.Ceil(need / t.Capacity))
+	p.NextAdd = t.Capacity
+	if p.Stops == 1 {
+		p.NextAdd = need
+	}
+	p.NextTime = t.RefuelTime(p.NextAdd)
+	total := t.Level + p.NextAdd + float64(p.Stops-1)*t.Capacity
+	p.Margin = total/t.PerLap - lapsRemaining
+	return p
+}
+
+// RemainingLaps estimates the laps left for a car: directly from the lap
+// limit in lap-based races, otherwise from the time remaining and pace,
+// counting the lap in progress at the flag.
+func RemainingLaps(si *lib.RestWatchSessionInfoResponse, lapsCompleted, pace float64) float64 {
+	if si == nil {
+		return 0
+	}
+	if si.MaximumLaps > 0 && si.MaximumLaps < 10000 {
+		return math.Max(0, si.MaximumLaps-lapsCompleted)
+	}
+	if pace <= 0 {
+		return 0
+	}
+	left := si.EndEventTime - si.CurrentEventTime
+	if left <= 0 {
+		return 0
+	}
+	return math.Ceil(left/pace) + 1
+}
@@ -0,0 +1,103 @@
+package strategy
+
+import (
+	"math"
+	"sort"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Encounter is a predicted on-track meeting between the player and another car.
+type Encounter struct {
+	SlotID    int
+	CarNumber string
+	Driver    string
+	CarClass  string
+	// Catching is true when the player closes on the other car, false when
+	// the other car closes on the player.
+	Catching bool
+	// Lapping is true when the overtake changes a lap count: the player
+	// laps a car, or the player is being lapped.
+	Lapping bool
+	InSec   float64 // seconds from now
+	InLaps  float64 // player laps from now
+}
+
+// Traffic predicts which cars the player will meet within horizon laps,
+// assuming every car keeps its current pace. pace is keyed by SlotID (see
+// CarPace) and trackLength is the lap distance in metres from sessionInfo.
+// Cars in the pits and cars without a pace are ignored. The result is
+// sorted by time to the meeting.
+func Traffic(standings []lib.RestWatchStandingsResponseItem, pace map[int]float64, trackLength, horizon float64) []Encounter {
+	if trackLength <= 0 || horizon <= 0 {
+		return nil
+	}
+	var player *lib.RestWatchStandingsResponseItem
+	for i := range standings {
+		if standings[i].Player {
+			player = &standings[i]
+			break
+		}
+	}
+	if player == nil {
+		return nil
+	}
+	pp := pace[int(player.SlotID)]
+	if pp <= 0 {
+		return nil
+	}
+	playerProg := progress(*player, trackLength)
+	limit := horizon * pp
+
+	var out []Encounter
+	for _, s := range standings {
+		if s.Player || s.Pitting || s.InGarageStall || (s.PitState != "NONE" && s.PitState != "") {
+			continue
+		}
+		pc := pace[int(s.SlotID)]
+		if pc <= 0 || pc == pp {
+			continue
+		}
+		prog := progress(s, trackLength)
+		// rate at which the player gains on this car, in laps per second
+		rate := 1/pp - 1/pc
+		var dist float64
+		if rate > 0 {
+			dist = frac(prog - playerProg) // how far the car is ahead on track
+		} else {
+			dist = frac(playerProg - prog) // how far the player is ahead on track
+		}
+		t := dist / math.Abs(rate)
+		if t > limit {
+			continue
+		}
+		e := Encounter{
+			SlotID:    int(s.SlotID),
+			CarNumber: s.CarNumber,
+			Driver:    s.DriverName,
+			CarClass:  s.CarClass,
+			Catching:  rate > 0,
+			InSec:     t,
+			InLaps:    t / pp,
+		}
+		// A car met on track that is behind in race distance is being lapped
+		// by the player; one met from behind that is ahead is lapping them.
+		if e.Catching {
+			e.Lapping = prog < playerProg
+		} else {
+			e.Lapping = prog > playerProg
+		}
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].InSec < out[j].InSec })
+	return out
+}
+
+// progress is race distance in laps.
+func progress(s lib.RestWatchStandingsResponseItem, trackLength float64) float64 {
+	return s.LapsCompleted + math.Max(0, s.LapDistance)/trackLength
+}
+
+func frac(x float64) float64 {
+	return x - math.Floor(x)
+}
@@ -0,0 +1,83 @@
+package strategy
+
+import (
+	"sort"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// MinStint is the shortest stint counted towards a class's typical stint
+// length; shorter ones are splashes, penalties or repairs.
+const MinStint = 3
+
+// RivalStint estimates when a car has to stop next, from the public
+// standings history alone: no fuel or energy levels are needed, so it works
+// for every car, not just the player's.
+type RivalStint struct {
+	SinceStop int     // laps completed since the last stop, or the start
+	Typical   float64 // typical stint length of the car's class in laps, 0 when unknown
+	LapsLeft  float64 // Typical - SinceStop; negative when overdue
+}
+
+// Known reports whether there is an estimate.
+func (r RivalStint) Known() bool { return r.Typical > 0 }
+
+// RivalStints estimates every car's next stop, keyed by SlotID. The typical
+// stint length of a class is the median of the completed stints of its
+// cars, so estimates appear once a car of the class has made its first
+// regular stop.
+func RivalStints(standings []lib.RestWatchStandingsResponseItem, history map[int][]lib.RestWatchStandingsHistoryResponseItemItem) map[int]RivalStint {
+	stints := map[string][]int{} // completed stint lengths by class
+	since := map[int]int{}
+	for _, s := range standings {
+		slot := int(s.SlotID)
+		done, last := Stints(history[slot])
+		for _, n := range done {
+			if n >= MinStint {
+				stints[s.CarClass] = append(stints[s.CarClass], n)
+			}
+		}
+		since[slot] = int(s.LapsCompleted) - last
+	}
+	out := make(map[int]RivalStint, len(standings))
+	for _, s := range standings {
+		slot := int(s.SlotID)
+		r := RivalStint{SinceStop: since[slot], Typical: median(stints[s.CarClass])}
+		if r.Typical > 0 {
+			r.LapsLeft = r.Typical - float64(r.SinceStop)
+		}
+		out[slot] = r
+	}
+	return out
+}
+
+// Stints splits a car's history at its pit stops. It returns the lengths
+// of the completed stints in laps and the lap of the last stop (0 for
+// none). Consecutive laps flagged as pitting (in- and out-lap) are one stop.
+func Stints(laps []lib.RestWatchStandingsHistoryResponseItemItem) (done []int, lastStop int) {
+	inPit := false
+	for i, l := range laps {
+		lap := int(l.TotalLaps)
+		if lap <= 0 {
+			lap = i + 1
+		}
+		if l.Pitting && !inPit {
+			done = append(done, lap-lastStop)
+			lastStop = lap
+		}
+		inPit = l.Pitting
+	}
+	return done, lastStop
+}
+
+func median(v []int) float64 {
+	if len(v) == 0 {
+		return 0
+	}
+	s := append([]int(nil), v...)
+	sort.Ints(s)
+	if len(s)%2 == 1 {
+		return float64(s[len(s)/2])
+	}
+	return float64(s[len(s)/2-1]+s[len(s)/2]) / 2
+}
@@ -0,0 +1,117 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Hand-written streaming decoders for the array endpoints. They hand out
+// each element as soon as it is parsed rather than after the whole body
+// arrived, so a display can start drawing a full field's standings or
+// history while the rest is still on its way over a slow link, e.g. when
+// spectating remotely over a VPN.
+
+// HistoryEntry is one car's laps from a streamed standings history.
+type HistoryEntry struct {
+	SlotID string // as the API keys it
+	Laps   []RestWatchStandingsHistoryResponseItemItem
+}
+
+// StreamStandings sends the cars of /rest/watch/standings on out in the
+// order the game lists them and closes out when it returns. The error is
+// nil when the whole array was read; cancelling ctx stops the download.
+func (c *Client) StreamStandings(ctx context.Context, out chan<- RestWatchStandingsResponseItem) error {
+	defer close(out)
+	return c.stream(ctx, "/rest/watch/standings", func(dec *json.Decoder) error {
+		if err := expectDelim(dec, '['); err != nil {
+			return err
+		}
+		for dec.More() {
+			var item RestWatchStandingsResponseItem
+			if err := dec.Decode(&item); err != nil {
+				return err
+			}
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return expectDelim(dec, ']')
+	})
+}
+
+// StreamStandingsHistory sends the cars of /rest/watch/standings/history
+// on out one at a time and closes out when it returns, like
+// StreamStandings.
+func (c *Client) StreamStandingsHistory(ctx context.Context, out chan<- HistoryEntry) error {
+	defer close(out)
+	return c.stream(ctx, "/rest/watch/standings/history", func(dec *json.Decoder) error {
+		if err := expectDelim(dec, '{'); err != nil {
+			return err
+		}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			e := HistoryEntry{SlotID: tok.(string)}
+			if err := dec.Decode(&e.Laps); err != nil {
+				return err
+			}
+			select {
+			case out <- e:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return expectDelim(dec, '}')
+	})
+}
+
+// stream makes a GET request and runs decode on the body as it arrives,
+// with the limits and metrics of the other requests.
+func (c *Client) stream(ctx context.Context, path string, decode func(*json.Decoder) error) (err error) {
+	if c.Metrics != nil {
+		start := time.Now()
+		defer func() { c.Metrics.observe("GET", path, time.Since(start), err) }()
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", c.BaseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	r := io.Reader(resp.Body)
+	if c.MaxResponseSize > 0 {
+		tooLarge := &ResponseTooLargeError{Method: "GET", Path: path, Limit: c.MaxResponseSize}
+		if resp.ContentLength > c.MaxResponseSize {
+			return tooLarge
+		}
+		r = &limitReader{r: resp.Body, left: c.MaxResponseSize, err: tooLarge}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(r)
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(data))
+	}
+	return decode(json.NewDecoder(r))
+}
+
+// expectDelim reads the next token and fails unless it is d.
+func expectDelim(dec *json.Decoder, d json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != d {
+		return fmt.Errorf("expected %q in response, got %v", d, tok)
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+// Package timesync maps between wall-clock time and in-game session time.
+//
+// The game reports elapsed session time (sessionInfo.currentEventTime) while
+// recorders stamp frames with the local clock. A Clock collects pairs of both
+// and fits session = offset + rate*wall over a sliding window, so replays and
+// analyses can line everything up on session time.
+package timesync
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultWindow is the number of samples kept for the fit.
+const DefaultWindow = 60
+
+// Sample pairs a wall-clock instant with the session time the game reported.
+type Sample struct {
+	Wall    time.Time
+	Session float64 // seconds
+}
+
+// Clock estimates the wall/session relationship. It is safe for concurrent use.
+type Clock struct {
+	Window int
+
+	mu      sync.Mutex
+	samples []Sample
+	epoch   time.Time
+	offset  float64
+	rate    float64
+	valid   bool
+}
+
+func New() *Clock {
+	return &Clock{Window: DefaultWindow}
+}
+
+// ObserveRequest records a sample for a request that started at start and
+// returned at end, stamping it at the midpoint to cancel out round-trip time.
+func (c *Clock) ObserveRequest(start, end time.Time, session float64) {
+	c.Observe(start.Add(end.Sub(start)/2), session)
+}
+
+// pauseSlack is how far session time may fall behind the wall clock
+// between two samples before the game counts as having been paused.
+const pauseSlack = time.Second
+
+// Observe records a sample. Session time going backwards means a new
+// session was loaded, and session time falling behind the wall clock means
+// the game was paused; both discard the old samples because the previous
+// fit no longer applies. A sample repeating the last session time is
+// skipped: the game updates currentEventTime less often than it can be
+// polled, and the first sample of a value is the closest to when it was set.
+func (c *Clock) Observe(wall time.Time, session float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if n := len(c.samples); n > 0 {
+		last := c.samples[n-1]
+		if !wall.After(last.Wall) || session == last.Session {
+			return
+		}
+		if session < last.Session || wall.Sub(last.Wall).Seconds()-(session-last.Session) > pauseSlack.Seconds() {
+			c.samples = c.samples[:0]
+			c.valid = false
+		}
+	}
+	if len(c.samples) == 0 {
+		c.epoch = wall
+	}
+	c.samples = append(c.samples, Sample{Wall: wall, Session: session})
+	window := c.Window
+	if window <= 1 {
+		window = DefaultWindow
+	}
+	if len(c.samples) > window {
+		c.samples = append(c.samples[:0], c.samples[len(c.samples)-window:]...)
+	}
+	c.fit()
+}
+
+// fit runs an ordinary least-squares line through the window. With a single
+// sample the rate is assumed to be 1.
+func (c *Clock) fit() {
+	n := float64(len(c.samples))
+	if n == 1 {
+		s := c.samples[0]
+		c.rate = 1
+		c.offset = s.Session - c.secs(s.Wall)
+		c.valid = true
+		return
+	}
+	var sx, sy, sxx, sxy float64
+	for _, s := range c.samples {
+		x := c.secs(s.Wall)
+		sx += x
+		sy += s.Session
+		sxx += x * x
+		sxy += x * s.Session
+	}
+	den := n*sxx - sx*sx
+	if den == 0 {
+		return
+	}
+	c.rate = (n*sxy - sx*sy) / den
+	c.offset = (sy - c.rate*sx) / n
+	c.valid = true
+}
+
+func (c *Clock) secs(t time.Time) float64 {
+	return t.Sub(c.epoch).Seconds()
+}
+
+// Synced reports whether at least one sample has been taken since the last reset.
+func (c *Clock) Synced() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.valid
+}
+
+// SessionTime converts a wall-clock instant to session seconds. A nil
+// Clock is never synced.
+func (c *Clock) SessionTime(wall time.Time) (float64, bool) {
+	if c == nil {
+		return 0, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.valid {
+		return 0, false
+	}
+	return c.offset + c.rate*c.secs(wall), true
+}
+
+// WallTime converts session seconds back to a wall-clock instant.
+func (c *Clock) WallTime(session float64) (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.valid || c.rate == 0 {
+		return time.Time{}, false
+	}
+	x := (session - c.offset) / c.rate
+	return c.epoch.Add(time.Duration(x * float64(time.Second))), true
+}
+
+// Drift returns how much faster (positive) or slower session time runs than
+// the wall clock, as a fraction: 0.001 means 1ms gained per second.
+func (c *Clock) Drift() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.valid {
+		return 0
+	}
+	return c.rate - 1
+}
+
+// Reset drops all samples.
+func (c *Clock) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.samples = c.samples[:0]
+	c.valid = false
+}
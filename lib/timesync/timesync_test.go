@@ -0,0 +1,70 @@
+package timesync
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// game is a session clock the game updates every step, polled more often.
+type game struct {
+	start  time.Time
+	offset float64 // session time at start
+	step   float64
+}
+
+func (g game) session(wall time.Time) float64 {
+	return g.offset + math.Floor(wall.Sub(g.start).Seconds()/g.step)*g.step
+}
+
+func TestObserveFastPolling(t *testing.T) {
+	start := time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC)
+	g := game{start: start, offset: 600, step: 0.2}
+	c := New()
+	wall := start
+	for i := 0; i < 100; i++ {
+		c.Observe(wall, g.session(wall))
+		wall = wall.Add(50 * time.Millisecond)
+	}
+	got, ok := c.SessionTime(wall)
+	if !ok {
+		t.Fatal("not synced after 5s of polling four times per update")
+	}
+	if want := 600 + wall.Sub(start).Seconds(); math.Abs(got-want) > 0.2 {
+		t.Errorf("SessionTime = %.3f, want %.3f", got, want)
+	}
+	if d := c.Drift(); math.Abs(d) > 0.01 {
+		t.Errorf("Drift = %f, want about 0", d)
+	}
+	if n := len(c.samples); n != 25 {
+		t.Errorf("%d samples kept, want one per update (25)", n)
+	}
+}
+
+func TestObserveResets(t *testing.T) {
+	start := time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC)
+	// Ten samples one second apart from session time 100, then one more
+	for _, tc := range []struct {
+		name    string
+		wall    time.Duration
+		session float64
+		reset   bool
+	}{
+		{"running", 11 * time.Second, 111, false},
+		{"jitter", 11 * time.Second, 110.6, false},
+		{"paused for 10s", 20 * time.Second, 110, true},
+		{"new session", 11 * time.Second, 5, true},
+	} {
+		c := New()
+		for i := 0; i < 10; i++ {
+			c.Observe(start.Add(time.Duration(i)*time.Second), 100+float64(i))
+		}
+		c.Observe(start.Add(tc.wall), tc.session)
+		if n := len(c.samples); tc.reset != (n == 1) {
+			t.Errorf("%s: %d samples kept, want a reset: %v", tc.name, n, tc.reset)
+		}
+		if got, _ := c.SessionTime(start.Add(tc.wall)); math.Abs(got-tc.session) > 0.5 {
+			t.Errorf("%s: SessionTime = %.3f, want about %.3f", tc.name, got, tc.session)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package timing
+
+import (
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// Gap is a car's gaps as it crossed the line at the end of a lap, in
+// seconds. Gaps to a car laps ahead are not comparable between laps and
+// are 0.
+type Gap struct {
+	Lap    int // completed lap, 1-based
+	Leader float64
+	Ahead  float64 // to the car directly ahead overall
+}
+
+// Gaps records every car's gaps lap by lap, keeping the last Depth laps of
+// each car.
+type Gaps struct {
+	// Depth is the number of laps kept per car, DefaultDepth when 0. It
+	// applies to cars seen after it is set.
+	Depth int
+
+	cars map[int]*carGaps
+}
+
+type carGaps struct {
+	laps int
+	hist *Ring[Gap]
+}
+
+func NewGaps() *Gaps {
+	return &Gaps{cars: make(map[int]*carGaps)}
+}
+
+// Update feeds one standings frame and records the gaps of the cars that
+// completed a lap since the previous one.
+func (g *Gaps) Update(standings []lib.RestWatchStandingsResponseItem) {
+	for _, s := range standings {
+		slot := int(s.SlotID)
+		c, ok := g.cars[slot]
+		if !ok {
+			g.cars[slot] = &carGaps{laps: int(s.LapsCompleted), hist: NewRing[Gap](g.Depth)}
+			continue
+		}
+		n := int(s.LapsCompleted)
+		if n <= c.laps {
+			continue
+		}
+		c.laps = n
+		gap := Gap{Lap: n}
+		if s.LapsBehindLeader == 0 {
+			gap.Leader = s.TimeBehindLeader
+		}
+		if s.LapsBehindNext == 0 {
+			gap.Ahead = s.TimeBehindNext
+		}
+		c.hist.Push(gap)
+	}
+}
+
+// History returns a slot's gaps, oldest first.
+func (g *Gaps) History(slot int) []Gap {
+	if c, ok := g.cars[slot]; ok {
+		return c.hist.All()
+	}
+	return nil
+}
+
+// Trend is how much a slot's gap to the car ahead changed over its last
+// laps laps, negative when closing in. ok is false without two comparable
+// gaps that far apart.
+func (g *Gaps) Trend(slot, laps int) (delta float64, ok bool) {
+	c, found := g.cars[slot]
+	if !found || laps <= 0 || c.hist.Len() <= laps {
+		return 0, false
+	}
+	last, first := c.hist.At(c.hist.Len()-1), c.hist.At(c.hist.Len()-1-laps)
+	if last.Ahead <= 0 || first.Ahead <= 0 || last.Lap-first.Lap != laps {
+		return 0, false
+	}
+	return last.Ahead - first.Ahead, true
+}
+
+// Remap carries the history over reshuffled SlotIDs (see watch.SlotTracker)
+// and drops it after a new session.
+func (g *Gaps) Remap(c watch.Change) {
+	g.cars = watch.Remap(g.cars, c)
+}
@@ -0,0 +1,104 @@
+package timing
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// IsQualifying reports whether a session name (sessionInfo's session, e.g.
+// "QUALIFY1") is a qualifying session.
+func IsQualifying(session string) bool {
+	return strings.HasPrefix(strings.ToUpper(session), "QUALIFY")
+}
+
+// QualiCar is a car's state in a qualifying session.
+type QualiCar struct {
+	// Grid is the provisional grid position by best lap, overall and in
+	// class; 0 without a lap time.
+	Grid, ClassGrid int
+	// Split is the last split of the lap in progress the car passed (1 or
+	// 2), 0 before the first or when its best lap has no splits. Delta is
+	// the lap so far against the same split of the car's best lap,
+	// negative when improving.
+	Split int
+	Delta float64
+	// ToLine is the estimated seconds until the car next crosses the line.
+	// A car in its garage or the pit lane is counted a lap away. CanStart
+	// reports whether it gets there before the flag, so it can start a lap
+	// that counts; it is always false in untimed sessions.
+	ToLine   float64
+	CanStart bool
+}
+
+// Improving reports whether the lap in progress is ahead of the car's best
+// at the last split.
+func (q QualiCar) Improving() bool {
+	return q.Split > 0 && q.Delta < 0
+}
+
+// Qualifying works out the provisional grid, the laps in progress against
+// each car's best and who can still start a lap, keyed by SlotID.
+func Qualifying(si *lib.RestWatchSessionInfoResponse, standings []lib.RestWatchStandingsResponseItem) map[int]QualiCar {
+	out := make(map[int]QualiCar, len(standings))
+	var timed []lib.RestWatchStandingsResponseItem
+	for _, s := range standings {
+		if s.BestLapTime > 0 {
+			timed = append(timed, s)
+		}
+	}
+	sort.SliceStable(timed, func(i, j int) bool { return timed[i].BestLapTime < timed[j].BestLapTime })
+	classGrid := map[string]int{}
+	for i, s := range timed {
+		classGrid[s.CarClass]++
+		out[int(s.SlotID)] = QualiCar{Grid: i + 1, ClassGrid: classGrid[s.CarClass]}
+	}
+
+	timeLeft := -1.0
+	var trackLen float64
+	if si != nil {
+		if si.EndEventTime > 0 {
+			timeLeft = math.Max(0, si.EndEventTime-si.CurrentEventTime)
+		}
+		trackLen = si.LapDistance
+	}
+	for _, s := range standings {
+		q := out[int(s.SlotID)]
+		inPit := s.PitState != "NONE" || s.InGarageStall
+		if !inPit {
+			q.Split, q.Delta = split(s)
+		}
+		pace := s.BestLapTime
+		if pace <= 0 {
+			pace = s.EstimatedLapTime
+		}
+		switch {
+		case inPit:
+			q.ToLine = pace
+		case trackLen > 0:
+			frac := math.Min(math.Max(s.LapDistance/trackLen, 0), 1)
+			q.ToLine = (1 - frac) * pace
+		}
+		q.CanStart = timeLeft > 0 && pace > 0 && q.ToLine < timeLeft
+		out[int(s.SlotID)] = q
+	}
+	return out
+}
+
+// split compares the lap in progress with the car's best lap at the last
+// split passed. The API's split times are cumulative from the line.
+func split(s lib.RestWatchStandingsResponseItem) (int, float64) {
+	switch s.Sector {
+	case "SECTOR2":
+		if s.CurrentSectorTime1 > 0 && s.BestLapSectorTime1 > 0 {
+			return 1, s.CurrentSectorTime1 - s.BestLapSectorTime1
+		}
+	case "SECTOR3", "SECTOR0":
+		if s.CurrentSectorTime2 > 0 && s.BestLapSectorTime2 > 0 {
+			return 2, s.CurrentSectorTime2 - s.BestLapSectorTime2
+		}
+	}
+	return 0, 0
+}
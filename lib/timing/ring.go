@@ -0,0 +1,54 @@
+package timing
+
+// DefaultDepth is how many laps of history the trackers keep per car: more
+// than a car completes in a 24-hour race at most tracks, while keeping the
+// memory of a long run bounded.
+const DefaultDepth = 500
+
+// Ring keeps the last Depth values pushed, oldest first. The zero value
+// holds nothing; use NewRing.
+type Ring[T any] struct {
+	buf   []T
+	start int
+	n     int
+}
+
+// NewRing returns a ring holding up to depth values, DefaultDepth when
+// depth is not positive.
+func NewRing[T any](depth int) *Ring[T] {
+	if depth <= 0 {
+		depth = DefaultDepth
+	}
+	return &Ring[T]{buf: make([]T, depth)}
+}
+
+// Push appends v, dropping the oldest value when the ring is full.
+func (r *Ring[T]) Push(v T) {
+	if len(r.buf) == 0 {
+		return
+	}
+	if r.n < len(r.buf) {
+		r.buf[(r.start+r.n)%len(r.buf)] = v
+		r.n++
+		return
+	}
+	r.buf[r.start] = v
+	r.start = (r.start + 1) % len(r.buf)
+}
+
+// Len returns the number of values held.
+func (r *Ring[T]) Len() int { return r.n }
+
+// At returns the i-th oldest value held.
+func (r *Ring[T]) At(i int) T {
+	return r.buf[(r.start+i)%len(r.buf)]
+}
+
+// All returns the values held, oldest first.
+func (r *Ring[T]) All() []T {
+	out := make([]T, r.n)
+	for i := range out {
+		out[i] = r.At(i)
+	}
+	return out
+}
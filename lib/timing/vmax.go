@@ -0,0 +1,116 @@
+// Package timing derives per-car figures from live standings frames that the
+// API only reports as instantaneous values.
+package timing
+
+import (
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// Speeds is the top speed of one car in km/h, 0 when not seen yet.
+type Speeds struct {
+	Lap     float64 // lap in progress
+	LastLap float64 // last completed lap
+	Stint   float64 // since the last pit stop
+	Session float64
+}
+
+// Vmax tracks top speeds per car, per lap and per stint. The API reports
+// each car's current velocity, so the figures are the highest speed seen in
+// the polled frames and are only as good as the poll rate: at 1 Hz a car
+// covers 80 m per frame at 300 km/h and the true peak can fall between
+// frames. Per-lap speeds are kept for the last Depth laps of each car.
+type Vmax struct {
+	// Depth is the number of laps kept per car, DefaultDepth when 0. It
+	// applies to cars seen after it is set.
+	Depth int
+
+	cars map[int]*carVmax
+}
+
+type carVmax struct {
+	Speeds
+	laps     int             // laps completed at the start of the lap in progress
+	pitstops float64         // stops at the start of the stint
+	byLap    *Ring[lapSpeed] // completed laps
+}
+
+type lapSpeed struct {
+	lap   int // 1-based
+	speed float64
+}
+
+func NewVmax() *Vmax {
+	return &Vmax{cars: make(map[int]*carVmax)}
+}
+
+// Update feeds one standings frame.
+func (v *Vmax) Update(standings []lib.RestWatchStandingsResponseItem) {
+	for _, s := range standings {
+		slot := int(s.SlotID)
+		c, ok := v.cars[slot]
+		if !ok {
+			c = &carVmax{laps: int(s.LapsCompleted), pitstops: s.Pitstops, byLap: NewRing[lapSpeed](v.Depth)}
+			v.cars[slot] = c
+		}
+		if n := int(s.LapsCompleted); n > c.laps {
+			c.byLap.Push(lapSpeed{n, c.Lap})
+			c.LastLap, c.Lap, c.laps = c.Lap, 0, n
+		}
+		if s.Pitstops > c.pitstops {
+			c.Stint, c.pitstops = 0, s.Pitstops
+		}
+		spd := s.CarVelocity.Velocity * 3.6
+		c.Lap = max(c.Lap, spd)
+		c.Stint = max(c.Stint, spd)
+		c.Session = max(c.Session, spd)
+	}
+}
+
+// Car returns the top speeds of a slot.
+func (v *Vmax) Car(slot int) Speeds {
+	if c, ok := v.cars[slot]; ok {
+		return c.Speeds
+	}
+	return Speeds{}
+}
+
+// Lap returns a slot's top speed on a completed lap, 0 when unknown or
+// older than Depth laps.
+func (v *Vmax) Lap(slot, lap int) float64 {
+	c, ok := v.cars[slot]
+	if !ok {
+		return 0
+	}
+	for i := c.byLap.Len() - 1; i >= 0; i-- {
+		if l := c.byLap.At(i); l.lap == lap {
+			return l.speed
+		}
+	}
+	return 0
+}
+
+// Laps returns a slot's top speed per completed lap number, for the last
+// Depth laps.
+func (v *Vmax) Laps(slot int) map[int]float64 {
+	c, ok := v.cars[slot]
+	if !ok {
+		return nil
+	}
+	out := make(map[int]float64, c.byLap.Len())
+	for _, l := range c.byLap.All() {
+		out[l.lap] = l.speed
+	}
+	return out
+}
+
+// Reset forgets every car, e.g. when a new session starts.
+func (v *Vmax) Reset() {
+	v.cars = make(map[int]*carVmax)
+}
+
+// Remap carries the tracking over reshuffled SlotIDs (see watch.SlotTracker)
+// and drops it after a new session.
+func (v *Vmax) Remap(c watch.Change) {
+	v.cars = watch.Remap(v.cars, c)
+}
@@ -0,0 +1,41 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Wheels lists the wheel positions in the order the API reports them.
+var Wheels = []string{"FL", "FR", "RL", "RR"}
+
+// WheelTemps are the player car's per-wheel temperatures from the tyre
+// management screen, in the order of Wheels, in °C.
+type WheelTemps struct {
+	Brake []float64
+	Tyre  []float64
+}
+
+// WheelTemps returns the player car's brake and tyre temperatures.
+func (c *Client) WheelTemps() (*WheelTemps, error) {
+	data, err := c.doRequest("GET", "/rest/garage/UIScreen/TireManagement", nil)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		WheelInfo struct {
+			WheelLocs []struct {
+				BrakeTemp float64 `json:"brakeTemp"`
+				TireTemp  float64 `json:"tireTemp"`
+			} `json:"wheelLocs"`
+		} `json:"wheelInfo"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("decode wheel temperatures: %w", err)
+	}
+	w := &WheelTemps{}
+	for _, l := range result.WheelInfo.WheelLocs {
+		w.Brake = append(w.Brake, l.BrakeTemp)
+		w.Tyre = append(w.Tyre, l.TireTemp)
+	}
+	return w, nil
+}
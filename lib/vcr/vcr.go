@@ -0,0 +1,277 @@
+// Package vcr records the client's HTTP interactions to a cassette file and
+// replays them, so code using the API can be tested deterministically
+// without a running game:
+//
+//	rec, err := vcr.Open("testdata/race.jsonl", vcr.Replay)
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer rec.Close()
+//	client := lib.NewClient("http://localhost:6397")
+//	client.HTTPClient = rec.Client()
+//
+// Record against the game once with vcr.Record, commit the cassette, and
+// replay it in tests. A cassette is JSON lines in the layout of cmd/proxy's
+// log, so a proxy log of a real session replays as a cassette too.
+package vcr
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// Interaction is one recorded request and its response. JSON bodies are
+// kept as JSON, anything else as a JSON string.
+type Interaction struct {
+	At           time.Time       `json:"at"`
+	Method       string          `json:"method"`
+	Path         string          `json:"path"`
+	Query        string          `json:"query,omitempty"`
+	Status       int             `json:"status"`
+	DurationMs   float64         `json:"durationMs"`
+	RequestBody  json.RawMessage `json:"requestBody,omitempty"`
+	ResponseBody json.RawMessage `json:"responseBody,omitempty"`
+}
+
+// Mode selects whether a Recorder talks to the API.
+type Mode int
+
+const (
+	// Replay answers from the cassette and fails requests it has no
+	// interaction for.
+	Replay Mode = iota
+	// Record passes requests to the API and appends them to the cassette.
+	Record
+	// ReplayOrRecord replays what the cassette has and records the rest.
+	ReplayOrRecord
+)
+
+// ErrNoInteraction is returned in Replay mode for a request the cassette
+// does not hold.
+var ErrNoInteraction = errors.New("vcr: no recorded interaction")
+
+// Recorder is an http.RoundTripper backed by a cassette. It is safe for
+// concurrent use.
+type Recorder struct {
+	Mode Mode
+	// Transport reaches the API when recording; nil uses
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	mu   sync.Mutex
+	tape []Interaction
+	used []bool
+	file *os.File // open for appending while recording
+	enc  *json.Encoder
+	path string
+}
+
+// Open loads the cassette at path. A missing cassette is empty, which in
+// Replay mode fails every request.
+func Open(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{Mode: mode, path: path}
+	f, err := os.Open(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		defer f.Close()
+		sc := bufio.NewScanner(f)
+		sc.Buffer(nil, 64<<20)
+		for n := 1; sc.Scan(); n++ {
+			if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+				continue
+			}
+			var it Interaction
+			if err := json.Unmarshal(sc.Bytes(), &it); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, n, err)
+			}
+			r.tape = append(r.tape, it)
+		}
+		if err := sc.Err(); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	r.used = make([]bool, len(r.tape))
+	return r, nil
+}
+
+// Client returns an HTTP client using the recorder, for lib.Client's
+// HTTPClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Interactions returns a copy of the cassette, including what was recorded
+// since Open.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Interaction(nil), r.tape...)
+}
+
+// Close closes the cassette file after recording.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// RoundTrip answers req from the cassette or, when recording, from the API.
+//
+// Requests match on method, path, query and request body. Matching
+// interactions are replayed in the order they were recorded; once all have
+// been used the last one answers again, so polling loops can run longer
+// than the recording.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if r.Mode != Record {
+		if it, ok := r.find(req, body); ok {
+			return response(req, it), nil
+		}
+		if r.Mode == Replay {
+			return nil, fmt.Errorf("%w for %s %s", ErrNoInteraction, req.Method, req.URL.RequestURI())
+		}
+	}
+	return r.record(req, body)
+}
+
+func (r *Recorder) find(req *http.Request, body []byte) (Interaction, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	last := -1
+	for i, it := range r.tape {
+		if it.Method != req.Method || it.Path != req.URL.Path || it.Query != req.URL.RawQuery || !sameBody(it.RequestBody, body) {
+			continue
+		}
+		if !r.used[i] {
+			r.used[i] = true
+			return it, true
+		}
+		last = i
+	}
+	if last < 0 {
+		return Interaction{}, false
+	}
+	return r.tape[last], true
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	it := Interaction{
+		At:           start,
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		Query:        req.URL.RawQuery,
+		Status:       resp.StatusCode,
+		DurationMs:   float64(time.Since(start).Microseconds()) / 1000,
+		RequestBody:  raw(body),
+		ResponseBody: raw(respBody),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		f, err := os.OpenFile(r.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		r.file, r.enc = f, json.NewEncoder(f)
+	}
+	if err := r.enc.Encode(it); err != nil {
+		return nil, err
+	}
+	r.tape = append(r.tape, it)
+	r.used = append(r.used, true)
+	return resp, nil
+}
+
+// response builds the reply to req from a recorded interaction. The API
+// answers in JSON; a body that was not JSON comes back as the JSON string
+// it was recorded as.
+func response(req *http.Request, it Interaction) *http.Response {
+	body := []byte(it.ResponseBody)
+	h := http.Header{}
+	if len(body) > 0 {
+		h.Set("Content-Type", "application/json")
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", it.Status, http.StatusText(it.Status)),
+		StatusCode:    it.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        h,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// raw keeps JSON bodies as they are and quotes anything else, as
+// cmd/proxy does.
+func raw(body []byte) json.RawMessage {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return nil
+	}
+	if json.Valid(trimmed) {
+		return trimmed
+	}
+	quoted, _ := json.Marshal(string(body))
+	return quoted
+}
+
+// sameBody compares a recorded request body with a live one, ignoring JSON
+// formatting.
+func sameBody(recorded json.RawMessage, body []byte) bool {
+	a, b := compact(recorded), compact(raw(body))
+	return bytes.Equal(a, b)
+}
+
+func compact(b []byte) []byte {
+	if len(b) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	if json.Compact(&buf, b) != nil {
+		return b
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,76 @@
+package vcr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+func TestRecordReplay(t *testing.T) {
+	var polls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/watch/sessionInfo":
+			fmt.Fprintf(w, `{"session": "RACE1", "currentEventTime": %d}`, 100+polls.Add(1))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	cassette := filepath.Join(t.TempDir(), "session.jsonl")
+
+	rec, err := Open(cassette, Record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := lib.NewClient(srv.URL)
+	client.HTTPClient = rec.Client()
+	var recorded []float64
+	for i := 0; i < 2; i++ {
+		si, err := client.RestWatchSessionInfo()
+		if err != nil {
+			t.Fatal(err)
+		}
+		recorded = append(recorded, si.CurrentEventTime)
+	}
+	if _, err := client.RestWatchTrackmap(); err == nil {
+		t.Fatal("recording: want the 404 passed on")
+	}
+	if err := rec.Close(); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	rec, err = Open(cassette, Replay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rec.Close()
+	if n := len(rec.Interactions()); n != 3 {
+		t.Fatalf("%d interactions on the cassette, want 3", n)
+	}
+	client = lib.NewClient("http://localhost:6397")
+	client.HTTPClient = rec.Client()
+	// In order, then the last one again
+	for i, want := range append(recorded, recorded[1]) {
+		si, err := client.RestWatchSessionInfo()
+		if err != nil {
+			t.Fatalf("replay %d: %v", i, err)
+		}
+		if si.CurrentEventTime != want {
+			t.Errorf("replay %d: currentEventTime %v, want %v", i, si.CurrentEventTime, want)
+		}
+	}
+	if _, err := client.RestWatchTrackmap(); err == nil {
+		t.Error("replay: want the recorded 404")
+	}
+	if _, err := client.RestWatchStandings(); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("unrecorded request: err %v, want ErrNoInteraction", err)
+	}
+}
@@ -0,0 +1,110 @@
+package watch
+
+import (
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Health is the connection state reported by a Breaker.
+type Health struct {
+	Connected bool
+	Since     time.Time // when the state last changed
+	LastOK    time.Time // last successful request, zero if none yet
+	Err       error     // last connection error
+}
+
+// Breaker stops pollers from hammering a game that is not running. After
+// Threshold consecutive connection failures it opens: requests are refused
+// except for one probe every Probe interval, until a probe succeeds. Only
+// transport errors (connection refused, timeouts) count; an HTTP error
+// status means the game is up. Safe for concurrent use.
+//
+//	b := watch.NewBreaker()
+//	b.OnChange = func(h watch.Health) { ... show a banner ... }
+//	for {
+//		if b.Allow(time.Now()) {
+//			standings, err := client.RestWatchStandings()
+//			b.Record(time.Now(), err)
+//			...
+//		}
+//		time.Sleep(interval)
+//	}
+type Breaker struct {
+	Threshold int           // consecutive failures before disconnecting
+	Probe     time.Duration // probe interval while disconnected
+	// OnChange is called on every change between connected and
+	// disconnected, from the goroutine calling Record.
+	OnChange func(Health)
+
+	mu        sync.Mutex
+	health    Health
+	failures  int
+	nextProbe time.Time
+}
+
+// NewBreaker returns a Breaker that disconnects after three failures and
+// probes every five seconds. It starts out connected.
+func NewBreaker() *Breaker {
+	return &Breaker{Threshold: 3, Probe: 5 * time.Second, health: Health{Connected: true, Since: time.Now()}}
+}
+
+// Allow reports whether a request may be made now. While disconnected it
+// returns true once per probe interval.
+func (b *Breaker) Allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.health.Connected {
+		return true
+	}
+	if now.Before(b.nextProbe) {
+		return false
+	}
+	b.nextProbe = now.Add(b.Probe)
+	return true
+}
+
+// Record feeds the outcome of a request made at now.
+func (b *Breaker) Record(now time.Time, err error) {
+	b.mu.Lock()
+	var changed bool
+	if err != nil && IsConnError(err) {
+		b.failures++
+		b.health.Err = err
+		if b.health.Connected && b.failures >= max(b.Threshold, 1) {
+			b.health.Connected = false
+			b.health.Since = now
+			b.nextProbe = now.Add(b.Probe)
+			changed = true
+		}
+	} else {
+		b.failures = 0
+		b.health.LastOK = now
+		if !b.health.Connected {
+			b.health.Connected = true
+			b.health.Since = now
+			b.health.Err = nil
+			changed = true
+		}
+	}
+	h := b.health
+	b.mu.Unlock()
+	if changed && b.OnChange != nil {
+		b.OnChange(h)
+	}
+}
+
+// Health returns the current state.
+func (b *Breaker) Health() Health {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.health
+}
+
+// IsConnError reports whether err means the API could not be reached, as
+// opposed to the game answering with an error.
+func IsConnError(err error) bool {
+	var ue *url.Error
+	return errors.As(err, &ue)
+}
@@ -0,0 +1,92 @@
+package watch
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// FrameMeta describes how a snapshot was captured, so consumers downstream
+// (recordings, plugins) can tell a dropped or repeated frame from a quiet
+// race, and a slow game from a slow consumer.
+type FrameMeta struct {
+	// Seq increases by one with every frame a producer emits, starting at 1.
+	Seq uint64 `json:"seq"`
+	// At is when the capture started.
+	At time.Time `json:"at"`
+	// Latency is the time from the start of the capture until the frame
+	// was complete, in nanoseconds in JSON.
+	Latency time.Duration `json:"latency"`
+	// Timings is how long each source endpoint took, by endpoint name.
+	Timings map[string]time.Duration `json:"timings,omitempty"`
+}
+
+// Sequence hands out a producer's frame numbers. The zero value is ready to
+// use and safe for concurrent use.
+type Sequence struct {
+	n atomic.Uint64
+}
+
+// Next returns the next sequence number.
+func (s *Sequence) Next() uint64 {
+	return s.n.Add(1)
+}
+
+// Start begins capturing a frame at now. Time the endpoints the frame is
+// made of with Observe, then Done numbers it.
+func (s *Sequence) Start(now time.Time) *Capture {
+	return &Capture{seq: s, meta: FrameMeta{At: now}}
+}
+
+// Capture collects the timings of one frame.
+type Capture struct {
+	seq  *Sequence
+	mu   sync.Mutex
+	meta FrameMeta
+}
+
+// Observe records that endpoint took d, e.g.
+//
+//	t := time.Now()
+//	standings, err := client.RestWatchStandings()
+//	c.Observe("standings", time.Since(t))
+func (c *Capture) Observe(endpoint string, d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.meta.Timings == nil {
+		c.meta.Timings = make(map[string]time.Duration)
+	}
+	c.meta.Timings[endpoint] += d
+}
+
+// Done completes the frame and assigns its sequence number. Call it once
+// the frame is emitted, so frames that are abandoned leave no gap.
+func (c *Capture) Done() FrameMeta {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.meta.Latency = time.Since(c.meta.At)
+	c.meta.Seq = c.seq.Next()
+	return c.meta
+}
+
+// SeqCheck follows the sequence numbers a consumer receives from one
+// producer. The zero value is ready to use.
+type SeqCheck struct {
+	last uint64
+}
+
+// Check reports how many frames were dropped before seq, and whether seq
+// was seen already (a duplicate or a frame out of order). A sequence that
+// restarts at 1 is a restarted producer, not a duplicate.
+func (c *SeqCheck) Check(seq uint64) (dropped uint64, duplicate bool) {
+	switch {
+	case c.last == 0 || seq == 1:
+		c.last = seq
+		return 0, false
+	case seq <= c.last:
+		return 0, true
+	}
+	dropped = seq - c.last - 1
+	c.last = seq
+	return dropped, false
+}
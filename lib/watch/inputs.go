@@ -0,0 +1,119 @@
+package watch
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// DefaultInputRate is how often SampleInputs polls by default: 20 Hz, fast
+// enough for a readable throttle and brake trace without loading the game.
+const DefaultInputRate = 50 * time.Millisecond
+
+// SampleInputs polls the player's inputs every interval until ctx is
+// cancelled, calling fn with each sample. Failed polls are skipped, since
+// the endpoint only answers while the player is in a car; a poll still
+// running when the next is due delays it rather than overlapping.
+func SampleInputs(ctx context.Context, c *lib.Client, interval time.Duration, fn func(lib.Inputs)) error {
+	if interval <= 0 {
+		interval = DefaultInputRate
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if in, err := c.Inputs(); err == nil {
+			fn(*in)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// InputTrace buffers input samples between the slower frames of a polling
+// loop: a sampler goroutine Adds, the loop Takes. It is safe for concurrent
+// use.
+type InputTrace struct {
+	// Max bounds the buffer; the oldest samples are dropped beyond it. 0
+	// keeps everything.
+	Max int
+
+	mu      sync.Mutex
+	samples []lib.Inputs
+}
+
+// Add appends a sample.
+func (t *InputTrace) Add(in lib.Inputs) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.samples = append(t.samples, in)
+	if t.Max > 0 && len(t.samples) > t.Max {
+		t.samples = t.samples[len(t.samples)-t.Max:]
+	}
+}
+
+// Take returns the samples added since the last Take and empties the
+// buffer.
+func (t *InputTrace) Take() []lib.Inputs {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := t.samples
+	t.samples = nil
+	return s
+}
+
+// InputFilter thins and smooths input samples for overlays that draw input
+// traces, which need fewer and steadier points than the sampler takes. A
+// filter is for one consumer and not safe for concurrent use.
+type InputFilter struct {
+	// Every is the shortest time between the samples passed on; the ones
+	// in between are folded into the next. 0 passes every sample on.
+	Every time.Duration
+	// Smooth is the time constant of an exponential moving average over
+	// the pedals and the steering; 0 leaves them as sampled.
+	Smooth time.Duration
+
+	cur  lib.Inputs
+	next time.Time // when the next sample is due
+}
+
+// Add takes a sample and returns the filtered one when it is due. Button
+// presses (shifts, TC override) seen since the last sample passed on are
+// kept, so a quick shift shows even when its samples are thinned out.
+func (f *InputFilter) Add(in lib.Inputs) (lib.Inputs, bool) {
+	if f.cur.At.IsZero() {
+		f.cur = in
+	} else {
+		a := 1.0
+		if dt := in.At.Sub(f.cur.At); f.Smooth > 0 && dt > 0 {
+			a = 1 - math.Exp(-dt.Seconds()/f.Smooth.Seconds())
+		}
+		ease := func(v *float64, to float64) { *v += a * (to - *v) }
+		ease(&f.cur.Throttle, in.Throttle)
+		ease(&f.cur.Brake, in.Brake)
+		ease(&f.cur.Clutch, in.Clutch)
+		ease(&f.cur.Handbrake, in.Handbrake)
+		ease(&f.cur.Steer, in.Steer)
+		f.cur.At = in.At
+		f.cur.ShiftUp = f.cur.ShiftUp || in.ShiftUp
+		f.cur.ShiftDown = f.cur.ShiftDown || in.ShiftDown
+		f.cur.TCOverride = f.cur.TCOverride || in.TCOverride
+	}
+	if in.At.Before(f.next) {
+		return lib.Inputs{}, false
+	}
+	// Due on a fixed grid, so jitter in the sampling does not stretch it,
+	// unless the samples stopped for longer than a step
+	f.next = f.next.Add(f.Every)
+	if !f.next.After(in.At) {
+		f.next = in.At.Add(f.Every)
+	}
+	out := f.cur
+	f.cur.ShiftUp, f.cur.ShiftDown, f.cur.TCOverride = false, false, false
+	return out, true
+}
@@ -0,0 +1,70 @@
+package watch
+
+import (
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Activity is what the game is doing, as far as polling is concerned.
+type Activity int
+
+const (
+	// Idle is the menus: no session is loaded or it has no cars.
+	Idle Activity = iota
+	// Waiting is a session where nothing moves: every car in its garage,
+	// the session paused or over.
+	Waiting
+	// Running is a session with cars on track.
+	Running
+)
+
+func (a Activity) String() string {
+	switch a {
+	case Waiting:
+		return "waiting"
+	case Running:
+		return "running"
+	}
+	return "idle"
+}
+
+// Classify tells the activity from a frame's session info and standings;
+// si is nil when the session info could not be fetched.
+func Classify(si *lib.RestWatchSessionInfoResponse, standings []lib.RestWatchStandingsResponseItem) Activity {
+	if si == nil || len(standings) == 0 {
+		return Idle
+	}
+	if p := lib.PhaseOf(si); p == lib.PhaseOver || p == lib.PhasePaused {
+		return Waiting
+	}
+	for _, s := range standings {
+		if !s.InGarageStall {
+			return Running
+		}
+	}
+	return Waiting
+}
+
+// Pace is the poll interval per activity, so tools poll quickly while cars
+// are running and back off while the game sits in the menus.
+type Pace struct {
+	Running time.Duration
+	Waiting time.Duration
+	Idle    time.Duration
+}
+
+// DefaultPace polls four times a second on track, every second in a
+// waiting session and every five seconds in the menus.
+var DefaultPace = Pace{Running: 250 * time.Millisecond, Waiting: time.Second, Idle: 5 * time.Second}
+
+// Interval returns the poll interval for an activity.
+func (p Pace) Interval(a Activity) time.Duration {
+	switch a {
+	case Running:
+		return p.Running
+	case Waiting:
+		return p.Waiting
+	}
+	return p.Idle
+}
@@ -0,0 +1,56 @@
+package watch
+
+import (
+	"container/heap"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+type slot struct {
+	ep   Endpoint
+	due  time.Time
+	busy atomic.Bool
+}
+
+// dueQueue is a min-heap ordered by due time; priority only orders slots
+// due at the same instant. popDue applies priority across everything due.
+type dueQueue []*slot
+
+func (q dueQueue) Len() int { return len(q) }
+
+func (q dueQueue) Less(i, j int) bool {
+	if !q[i].due.Equal(q[j].due) {
+		return q[i].due.Before(q[j].due)
+	}
+	return q[i].ep.Priority > q[j].ep.Priority
+}
+
+func (q dueQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *dueQueue) Push(x interface{}) { *q = append(*q, x.(*slot)) }
+
+func (q *dueQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	s := old[n-1]
+	*q = old[:n-1]
+	return s
+}
+
+// popDue pops every slot due at now, highest priority first and the earlier
+// due first among equal priorities, so a busy tick that finds several
+// endpoints overdue starts the important ones before the semaphore fills.
+func (q *dueQueue) popDue(now time.Time) []*slot {
+	var due []*slot
+	for len(*q) > 0 && !(*q)[0].due.After(now) {
+		due = append(due, heap.Pop(q).(*slot))
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		if due[i].ep.Priority != due[j].ep.Priority {
+			return due[i].ep.Priority > due[j].ep.Priority
+		}
+		return due[i].due.Before(due[j].due)
+	})
+	return due
+}
@@ -0,0 +1,38 @@
+package watch
+
+import (
+	"container/heap"
+	"testing"
+	"time"
+)
+
+func TestPopDuePriority(t *testing.T) {
+	now := time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC)
+	var q dueQueue
+	for _, sl := range []*slot{
+		{ep: Endpoint{Name: "history", Priority: 5}, due: now.Add(-2 * time.Millisecond)},
+		{ep: Endpoint{Name: "standings", Priority: 10}, due: now.Add(-time.Millisecond)},
+		{ep: Endpoint{Name: "sessionInfo", Priority: 8}, due: now.Add(-3 * time.Millisecond)},
+		{ep: Endpoint{Name: "trackmap", Priority: 5}, due: now.Add(-5 * time.Millisecond)},
+		{ep: Endpoint{Name: "later", Priority: 20}, due: now.Add(time.Millisecond)},
+	} {
+		heap.Push(&q, sl)
+	}
+
+	var got []string
+	for _, sl := range q.popDue(now) {
+		got = append(got, sl.ep.Name)
+	}
+	want := []string{"standings", "sessionInfo", "trackmap", "history"}
+	if len(got) != len(want) {
+		t.Fatalf("popDue = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("popDue = %q, want %q", got, want)
+		}
+	}
+	if len(q) != 1 || q[0].ep.Name != "later" {
+		t.Errorf("left in the queue: %d slots, want only the one not yet due", len(q))
+	}
+}
@@ -0,0 +1,109 @@
+package watch
+
+import (
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/identity"
+)
+
+// CarKey identifies a car independently of its SlotID, which the game
+// reassigns when it reloads a session or a client reconnects.
+type CarKey struct {
+	Number string
+	Driver string // identity.Key of the driver name
+}
+
+// KeyOf returns the key of a standings entry.
+func KeyOf(s lib.RestWatchStandingsResponseItem) CarKey {
+	return CarKey{Number: s.CarNumber, Driver: identity.Key(s.DriverName)}
+}
+
+// Change is what SlotTracker.Update found in a frame.
+type Change struct {
+	// NewSession is set when a different session started, or the same one
+	// was reloaded (session time went backwards): derived per-car state is
+	// stale and should be dropped.
+	NewSession bool
+	// Prev maps each SlotID of the frame to the SlotID the same car had in
+	// the previous frame. Cars new to the frame are absent.
+	Prev map[int]int
+	// Reshuffled is set when a car changed slots or a slot now holds a
+	// different car; per-slot state needs Remap.
+	Reshuffled bool
+}
+
+// SlotTracker follows cars across frames by car number and driver, so state
+// keyed by SlotID can be carried over when the slots reshuffle.
+type SlotTracker struct {
+	started bool
+	track   string
+	session string
+	lastET  float64
+	slots   map[int]CarKey
+}
+
+// Update feeds one frame. si may be nil, in which case session changes are
+// not detected.
+func (t *SlotTracker) Update(standings []lib.RestWatchStandingsResponseItem, si *lib.RestWatchSessionInfoResponse) Change {
+	var c Change
+	if si != nil {
+		if t.started && (si.TrackName != t.track || si.Session != t.session || si.CurrentEventTime < t.lastET) {
+			c.NewSession = true
+		}
+		t.started = true
+		t.track, t.session, t.lastET = si.TrackName, si.Session, si.CurrentEventTime
+	}
+	if len(standings) == 0 {
+		return c // a loading frame; keep the last known slots
+	}
+
+	// Match by car number and driver first, then by number alone when it is
+	// unique, which follows a car through a driver change.
+	byKey := make(map[CarKey]int, len(t.slots))
+	byNumber := make(map[string]int, len(t.slots))
+	numbers := make(map[string]int, len(t.slots))
+	for slot, k := range t.slots {
+		byKey[k] = slot
+		byNumber[k.Number] = slot
+		numbers[k.Number]++
+	}
+	slots := make(map[int]CarKey, len(standings))
+	c.Prev = make(map[int]int, len(standings))
+	for _, s := range standings {
+		slot, k := int(s.SlotID), KeyOf(s)
+		slots[slot] = k
+		prev, ok := byKey[k]
+		if !ok && k.Number != "" && numbers[k.Number] == 1 {
+			prev, ok = byNumber[k.Number]
+		}
+		if ok {
+			c.Prev[slot] = prev
+			if prev != slot {
+				c.Reshuffled = true
+			}
+		} else if _, taken := t.slots[slot]; taken {
+			c.Reshuffled = true
+		}
+	}
+	t.slots = slots
+	return c
+}
+
+// Remap carries per-slot state over a Change: it returns m unchanged when
+// nothing moved, an empty map after a new session, and otherwise m re-keyed
+// to the new slots with the values of cars that left or were replaced
+// dropped.
+func Remap[V any](m map[int]V, c Change) map[int]V {
+	switch {
+	case c.NewSession:
+		return make(map[int]V)
+	case !c.Reshuffled:
+		return m
+	}
+	out := make(map[int]V, len(c.Prev))
+	for slot, prev := range c.Prev {
+		if v, ok := m[prev]; ok {
+			out[slot] = v
+		}
+	}
+	return out
+}
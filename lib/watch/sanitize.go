@@ -0,0 +1,138 @@
+package watch
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Fix records one repair made by the sanitizer.
+type Fix struct {
+	SlotID int
+	Rule   string
+}
+
+func (f Fix) String() string {
+	return fmt.Sprintf("slot %d: %s", f.SlotID, f.Rule)
+}
+
+// SanitizeStandings cleans a raw standings frame. The input is not modified.
+//
+// Rules, applied in order:
+//   - entries with an empty driver and vehicle name are dropped (loading placeholders)
+//   - duplicate SlotIDs are collapsed, keeping the entry with the most laps
+//   - negative lap, sector and gap times are zeroed ("no time")
+//   - negative lap counts are zeroed
+//   - positions are renumbered 1..n when any are zero, negative or duplicated,
+//     keeping the game's relative order and sending position 0 to the back
+//
+// The returned slice is sorted by position.
+func SanitizeStandings(in []lib.RestWatchStandingsResponseItem) ([]lib.RestWatchStandingsResponseItem, []Fix) {
+	var fixes []Fix
+	out := make([]lib.RestWatchStandingsResponseItem, 0, len(in))
+	bySlot := make(map[int]int, len(in))
+
+	for _, s := range in {
+		slot := int(s.SlotID)
+		if s.DriverName == "" && s.VehicleName == "" {
+			fixes = append(fixes, Fix{slot, "dropped empty placeholder"})
+			continue
+		}
+		if i, ok := bySlot[slot]; ok {
+			fixes = append(fixes, Fix{slot, "dropped duplicate slot"})
+			if s.LapsCompleted > out[i].LapsCompleted {
+				out[i] = s
+			}
+			continue
+		}
+		bySlot[slot] = len(out)
+		out = append(out, s)
+	}
+
+	for i := range out {
+		s := &out[i]
+		slot := int(s.SlotID)
+		for _, f := range []struct {
+			name string
+			v    *float64
+		}{
+			{"lastLapTime", &s.LastLapTime},
+			{"bestLapTime", &s.BestLapTime},
+			{"lastSectorTime1", &s.LastSectorTime1},
+			{"lastSectorTime2", &s.LastSectorTime2},
+			{"bestSectorTime1", &s.BestSectorTime1},
+			{"bestSectorTime2", &s.BestSectorTime2},
+			{"currentSectorTime1", &s.CurrentSectorTime1},
+			{"currentSectorTime2", &s.CurrentSectorTime2},
+			{"bestLapSectorTime1", &s.BestLapSectorTime1},
+			{"bestLapSectorTime2", &s.BestLapSectorTime2},
+			{"timeBehindLeader", &s.TimeBehindLeader},
+			{"timeBehindNext", &s.TimeBehindNext},
+			{"lapsCompleted", &s.LapsCompleted},
+			{"lapsBehindLeader", &s.LapsBehindLeader},
+			{"lapsBehindNext", &s.LapsBehindNext},
+		} {
+			if *f.v < 0 {
+				*f.v = 0
+				fixes = append(fixes, Fix{slot, "zeroed negative " + f.name})
+			}
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		pi, pj := out[i].Position, out[j].Position
+		if (pi <= 0) != (pj <= 0) {
+			return pj <= 0
+		}
+		return pi < pj
+	})
+	renumber := false
+	for i, s := range out {
+		if s.Position <= 0 || (i > 0 && s.Position == out[i-1].Position) {
+			renumber = true
+			break
+		}
+	}
+	if renumber {
+		for i := range out {
+			if out[i].Position != float64(i+1) {
+				fixes = append(fixes, Fix{int(out[i].SlotID), fmt.Sprintf("renumbered P%.0f to P%d", out[i].Position, i+1)})
+				out[i].Position = float64(i + 1)
+			}
+		}
+	}
+	return out, fixes
+}
+
+// SanitizeHistory cleans a raw standings history payload, keyed by SlotID as
+// the API returns it. Laps with a negative lap time have their times zeroed so
+// they read as "no time"; sector times that are negative or exceed the lap
+// time are zeroed too. The input is not modified.
+func SanitizeHistory(in map[string][]lib.RestWatchStandingsHistoryResponseItemItem) (map[string][]lib.RestWatchStandingsHistoryResponseItemItem, []Fix) {
+	var fixes []Fix
+	out := make(map[string][]lib.RestWatchStandingsHistoryResponseItemItem, len(in))
+	for k, laps := range in {
+		cp := make([]lib.RestWatchStandingsHistoryResponseItemItem, len(laps))
+		copy(cp, laps)
+		for i := range cp {
+			l := &cp[i]
+			slot := int(l.SlotID)
+			if l.LapTime < 0 {
+				l.LapTime, l.SectorTime1, l.SectorTime2 = 0, 0, 0
+				fixes = append(fixes, Fix{slot, fmt.Sprintf("zeroed negative lap time on lap %d", i+1)})
+				continue
+			}
+			if l.SectorTime1 < 0 || (l.LapTime > 0 && l.SectorTime1 > l.LapTime) {
+				l.SectorTime1 = 0
+				fixes = append(fixes, Fix{slot, fmt.Sprintf("zeroed bad sector 1 on lap %d", i+1)})
+			}
+			if l.SectorTime2 < 0 || (l.LapTime > 0 && l.SectorTime2 > l.LapTime) {
+				l.SectorTime2 = 0
+				fixes = append(fixes, Fix{slot, fmt.Sprintf("zeroed bad sector 2 on lap %d", i+1)})
+			}
+		}
+		out[k] = cp
+	}
+	return out, fixes
+}
@@ -0,0 +1,153 @@
+// Package watch polls the LMU REST API on a schedule and hands the responses
+// to consumers. Endpoints are staggered with phase offsets and jitter so the
+// game's HTTP server never sees every request land in the same instant.
+package watch
+
+import (
+	"container/heap"
+	"context"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+)
+
+// Endpoint describes one periodically polled resource.
+type Endpoint struct {
+	Name     string
+	Interval time.Duration
+	Priority int // higher runs first when several endpoints are due together
+	Fetch    func(ctx context.Context) (interface{}, error)
+}
+
+// Result is a single completed fetch. Seq numbers the results of a Run in
+// the order they are sent (see SeqCheck).
+type Result struct {
+	Seq      uint64
+	Endpoint string
+	Value    interface{}
+	Err      error
+	Start    time.Time
+	Duration time.Duration
+}
+
+// Scheduler runs endpoints at their own intervals. Each endpoint gets a fixed
+// phase offset inside its interval plus per-tick jitter, and at most
+// MaxInFlight fetches run concurrently.
+type Scheduler struct {
+	Jitter      float64 // fraction of the interval, e.g. 0.1 = ±10%
+	MaxInFlight int
+	Breaker     *Breaker // optional; while disconnected only probes are fetched
+
+	endpoints []Endpoint
+	seq       Sequence
+	sendMu    sync.Mutex
+}
+
+func NewScheduler(endpoints ...Endpoint) *Scheduler {
+	return &Scheduler{Jitter: 0.1, MaxInFlight: 2, endpoints: endpoints}
+}
+
+// ClientEndpoints returns the endpoints the live tools usually need, with
+// standings polled fastest and the heavier history payload less often.
+func ClientEndpoints(c *lib.Client, interval time.Duration) []Endpoint {
+	return []Endpoint{
+		{Name: "standings", Interval: interval, Priority: 10, Fetch: func(context.Context) (interface{}, error) {
+			return c.RestWatchStandings()
+		}},
+		{Name: "sessionInfo", Interval: interval, Priority: 8, Fetch: func(context.Context) (interface{}, error) {
+			return c.RestWatchSessionInfo()
+		}},
+		{Name: "history", Interval: 2 * interval, Priority: 5, Fetch: func(context.Context) (interface{}, error) {
+			return c.RestWatchStandingsHistory()
+		}},
+	}
+}
+
+// Run polls until ctx is cancelled, sending every result to out. An endpoint
+// whose previous fetch is still running skips its tick rather than piling up.
+func (s *Scheduler) Run(ctx context.Context, out chan<- Result) error {
+	maxInFlight := s.MaxInFlight
+	if maxInFlight <= 0 {
+		maxInFlight = 1
+	}
+	sem := make(chan struct{}, maxInFlight)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	now := time.Now()
+	q := make(dueQueue, 0, len(s.endpoints))
+	for i, ep := range s.endpoints {
+		if ep.Interval <= 0 || ep.Fetch == nil {
+			continue
+		}
+		offset := time.Duration(int64(ep.Interval) * int64(i) / int64(len(s.endpoints)))
+		heap.Push(&q, &slot{ep: ep, due: now.Add(offset)})
+	}
+	if len(q) == 0 {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(time.Until(q[0].due))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		// Start everything that is due in priority order; the semaphore
+		// may block, so the first ones in get the free fetch slots.
+		now := time.Now()
+		for _, sl := range q.popDue(now) {
+			if !sl.busy.Load() && (s.Breaker == nil || s.Breaker.Allow(now)) {
+				sl.busy.Store(true)
+				select {
+				case sem <- struct{}{}:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+				wg.Add(1)
+				go func(sl *slot) {
+					defer wg.Done()
+					defer func() { <-sem; sl.busy.Store(false) }()
+					start := time.Now()
+					v, err := sl.ep.Fetch(ctx)
+					if s.Breaker != nil {
+						s.Breaker.Record(time.Now(), err)
+					}
+					r := Result{Endpoint: sl.ep.Name, Value: v, Err: err, Start: start, Duration: time.Since(start)}
+					// Numbered under the lock, so results arrive in sequence
+					s.sendMu.Lock()
+					defer s.sendMu.Unlock()
+					if ctx.Err() != nil {
+						return
+					}
+					r.Seq = s.seq.Next()
+					select {
+					case out <- r:
+					case <-ctx.Done():
+					}
+				}(sl)
+			}
+			sl.due = sl.due.Add(sl.ep.Interval + s.jitter(sl.ep.Interval))
+			if sl.due.Before(now) {
+				sl.due = now.Add(sl.ep.Interval)
+			}
+			heap.Push(&q, sl)
+		}
+		timer.Reset(time.Until(q[0].due))
+	}
+}
+
+func (s *Scheduler) jitter(interval time.Duration) time.Duration {
+	if s.Jitter <= 0 {
+		return 0
+	}
+	span := float64(interval) * s.Jitter
+	return time.Duration((rand.Float64()*2 - 1) * span)
+}
@@ -0,0 +1,191 @@
+package watch_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/snipem/go-lmu-api/lib"
+	"github.com/snipem/go-lmu-api/lib/events"
+	"github.com/snipem/go-lmu-api/lib/feed"
+	"github.com/snipem/go-lmu-api/lib/timing"
+	"github.com/snipem/go-lmu-api/lib/watch"
+)
+
+// TestSoak is a short cmd/soak: an hour of game time in a few seconds,
+// with the game away for a minute every quarter of an hour. make
+// check-soak runs the long version.
+func TestSoak(t *testing.T) {
+	if testing.Short() {
+		t.Skip("soak test in -short mode")
+	}
+	const speed = 1000 // game seconds per real second
+	scaled := func(d time.Duration) time.Duration { return d / speed }
+	start := time.Now()
+	gameTime := func() time.Duration { return time.Since(start) * speed }
+
+	srv := httptest.NewServer(&soakGame{clock: gameTime})
+	defer srv.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), scaled(time.Hour))
+	defer cancel()
+
+	client := lib.NewClient(srv.URL)
+	client.HTTPClient = &http.Client{Timeout: 50 * time.Millisecond}
+	brk := watch.NewBreaker()
+	brk.Probe = scaled(5 * time.Second)
+	sched := watch.NewScheduler(watch.ClientEndpoints(client, scaled(time.Second))...)
+	sched.Breaker = brk
+
+	out, err := feed.Listen("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	go soakClients(ctx, out.Addr().String(), scaled(time.Second))
+
+	results := make(chan watch.Result, 16)
+	go sched.Run(ctx, results)
+	var polls, failures atomic.Int64
+	go soakConsume(results, out, &polls, &failures)
+
+	measure := func() (goroutines int, heap uint64) {
+		runtime.GC()
+		var m runtime.MemStats
+		runtime.ReadMemStats(&m)
+		return runtime.NumGoroutine(), m.HeapAlloc
+	}
+	// The first measurement once everything is running
+	time.Sleep(scaled(5 * time.Minute))
+	firstG, firstHeap := measure()
+	firstPolls := polls.Load()
+	<-ctx.Done()
+	time.Sleep(100 * time.Millisecond)
+	srv.CloseClientConnections()
+	lastG, lastHeap := measure()
+
+	if polls.Load() == firstPolls {
+		t.Fatal("no polls after the first measurement")
+	}
+	if failures.Load() == 0 {
+		t.Error("no failed polls; the outages did not happen")
+	}
+	if lastG > firstG+10 {
+		t.Errorf("goroutines grew from %d to %d", firstG, lastG)
+	}
+	if lastHeap > 2*firstHeap {
+		t.Errorf("live heap grew from %.1f to %.1f KiB", float64(firstHeap)/1024, float64(lastHeap)/1024)
+	}
+	t.Logf("%d polls, %d failed; goroutines %d -> %d, heap %.1f -> %.1f KiB", polls.Load(), failures.Load(),
+		firstG, lastG, float64(firstHeap)/1024, float64(lastHeap)/1024)
+}
+
+// soakGame serves a six-car race and drops every connection during the
+// last minute of each quarter hour, as a closed game does.
+type soakGame struct {
+	clock func() time.Duration
+}
+
+func (g *soakGame) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	now := g.clock()
+	if now%(15*time.Minute) >= 14*time.Minute {
+		if conn, _, err := w.(http.Hijacker).Hijack(); err == nil {
+			conn.Close()
+		}
+		return
+	}
+	et := now.Seconds()
+	var v interface{}
+	switch r.URL.Path {
+	case "/rest/watch/standings":
+		const cars, trackLen = 6, 5000.0
+		standings := make([]lib.RestWatchStandingsResponseItem, cars)
+		for i := range standings {
+			pace := 100 * (1 + 0.01*float64(i))
+			laps := et / pace
+			standings[i] = lib.RestWatchStandingsResponseItem{
+				SlotID: float64(i), Position: float64(i + 1), DriverName: fmt.Sprintf("Driver %d", i+1),
+				LapsCompleted: float64(int(laps)), LapDistance: (laps - float64(int(laps))) * trackLen,
+				LastLapTime: pace, BestLapTime: pace, PitState: "NONE",
+			}
+			standings[i].CarVelocity.Velocity = trackLen / pace
+		}
+		v = standings
+	case "/rest/watch/sessionInfo":
+		v = lib.RestWatchSessionInfoResponse{Session: "RACE1", GamePhase: float64(lib.PhaseGreen),
+			CurrentEventTime: et, EndEventTime: 86400, NumberOfVehicles: 6}
+	case "/rest/watch/standings/history":
+		v = map[string]interface{}{}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	json.NewEncoder(w).Encode(v)
+}
+
+// soakConsume runs the results through the trackers and detectors the
+// tools use and writes a line per frame to the feed.
+func soakConsume(results <-chan watch.Result, out *feed.Server, polls, failures *atomic.Int64) {
+	vmax, gaps := timing.NewVmax(), timing.NewGaps()
+	det, incidents := events.NewDetector(), events.NewIncidents()
+	var slots watch.SlotTracker
+	var si *lib.RestWatchSessionInfoResponse
+	for r := range results {
+		polls.Add(1)
+		if r.Err != nil {
+			failures.Add(1)
+			continue
+		}
+		switch v := r.Value.(type) {
+		case *lib.RestWatchSessionInfoResponse:
+			si = v
+		case []lib.RestWatchStandingsResponseItem:
+			standings, _ := watch.SanitizeStandings(v)
+			if c := slots.Update(standings, si); c.NewSession || c.Reshuffled {
+				vmax.Remap(c)
+				gaps.Remap(c)
+			}
+			vmax.Update(standings)
+			gaps.Update(standings)
+			snap := events.Snapshot{At: r.Start, Standings: standings, Session: si}
+			evs := append(det.Process(snap), incidents.Process(snap)...)
+			line, _ := json.Marshal(map[string]interface{}{"seq": r.Seq, "events": evs})
+			out.Write(append(line, '\n'))
+		}
+	}
+}
+
+// soakClients keeps up to four feed clients connected, the oldest hanging
+// up as a new one comes.
+func soakClients(ctx context.Context, addr string, every time.Duration) {
+	t := time.NewTicker(every)
+	defer t.Stop()
+	var conns []net.Conn
+	defer func() {
+		for _, c := range conns {
+			c.Close()
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+		if c, err := net.Dial("tcp", addr); err == nil {
+			go io.Copy(io.Discard, c)
+			conns = append(conns, c)
+		}
+		if len(conns) > 4 {
+			conns[0].Close()
+			conns = conns[1:]
+		}
+	}
+}
@@ -0,0 +1,295 @@
+// Code generated by cmd/generate. DO NOT EDIT.
+
+//go:build !lmu_stdjson
+
+package lib
+
+// UnmarshalJSON decodes without reflection (see jsonLexer).
+func (s *RestWatchStandingsHistoryResponseItemItem) UnmarshalJSON(data []byte) error {
+	l := jsonLexer{data: data}
+	s.decodeFast(&l)
+	return l.done()
+}
+
+func (s *RestWatchStandingsHistoryResponseItemItem) decodeFast(l *jsonLexer) {
+	for key, ok := l.openObject("RestWatchStandingsHistoryResponseItemItem"); ok; key, ok = l.nextKey("RestWatchStandingsHistoryResponseItemItem") {
+		if !s.decodeField(l, key) && !s.decodeField(l, l.fold(key, restWatchStandingsHistoryResponseItemItemKeys)) {
+			l.skip()
+		}
+	}
+}
+
+func (s *RestWatchStandingsHistoryResponseItemItem) decodeField(l *jsonLexer, key []byte) bool {
+	switch string(key) {
+	case "carClass":
+		l.decodeString(&s.CarClass)
+	case "driverName":
+		l.decodeString(&s.DriverName)
+	case "finishStatus":
+		l.decodeString(&s.FinishStatus)
+	case "lapTime":
+		l.decodeFloat(&s.LapTime)
+	case "pitting":
+		l.decodeBool(&s.Pitting)
+	case "position":
+		l.decodeFloat(&s.Position)
+	case "sectorTime1":
+		l.decodeFloat(&s.SectorTime1)
+	case "sectorTime2":
+		l.decodeFloat(&s.SectorTime2)
+	case "slotID":
+		l.decodeFloat(&s.SlotID)
+	case "totalLaps":
+		l.decodeFloat(&s.TotalLaps)
+	case "vehicleName":
+		l.decodeString(&s.VehicleName)
+	default:
+		return false
+	}
+	return true
+}
+
+var restWatchStandingsHistoryResponseItemItemKeys = []string{"carClass", "driverName", "finishStatus", "lapTime", "pitting", "position", "sectorTime1", "sectorTime2", "slotID", "totalLaps", "vehicleName"}
+
+// UnmarshalJSON decodes without reflection (see jsonLexer).
+func (s *RestWatchStandingsResponseItem) UnmarshalJSON(data []byte) error {
+	l := jsonLexer{data: data}
+	s.decodeFast(&l)
+	return l.done()
+}
+
+func (s *RestWatchStandingsResponseItem) decodeFast(l *jsonLexer) {
+	for key, ok := l.openObject("RestWatchStandingsResponseItem"); ok; key, ok = l.nextKey("RestWatchStandingsResponseItem") {
+		if !s.decodeField(l, key) && !s.decodeField(l, l.fold(key, restWatchStandingsResponseItemKeys)) {
+			l.skip()
+		}
+	}
+}
+
+func (s *RestWatchStandingsResponseItem) decodeField(l *jsonLexer, key []byte) bool {
+	switch string(key) {
+	case "attackMode":
+		s.AttackMode.decodeFast(l)
+	case "bestLapSectorTime1":
+		l.decodeFloat(&s.BestLapSectorTime1)
+	case "bestLapSectorTime2":
+		l.decodeFloat(&s.BestLapSectorTime2)
+	case "bestLapTime":
+		l.decodeFloat(&s.BestLapTime)
+	case "bestSectorTime1":
+		l.decodeFloat(&s.BestSectorTime1)
+	case "bestSectorTime2":
+		l.decodeFloat(&s.BestSectorTime2)
+	case "carAcceleration":
+		s.CarAcceleration.decodeFast(l)
+	case "carClass":
+		l.decodeString(&s.CarClass)
+	case "carId":
+		l.decodeString(&s.CarId)
+	case "carNumber":
+		l.decodeString(&s.CarNumber)
+	case "carPosition":
+		s.CarPosition.decodeFast(l)
+	case "carVelocity":
+		s.CarVelocity.decodeFast(l)
+	case "countLapFlag":
+		l.decodeString(&s.CountLapFlag)
+	case "currentSectorTime1":
+		l.decodeFloat(&s.CurrentSectorTime1)
+	case "currentSectorTime2":
+		l.decodeFloat(&s.CurrentSectorTime2)
+	case "driverName":
+		l.decodeString(&s.DriverName)
+	case "drsActive":
+		l.decodeBool(&s.DrsActive)
+	case "estimatedLapTime":
+		l.decodeFloat(&s.EstimatedLapTime)
+	case "finishStatus":
+		l.decodeString(&s.FinishStatus)
+	case "flag":
+		l.decodeString(&s.Flag)
+	case "focus":
+		l.decodeBool(&s.Focus)
+	case "fuelFraction":
+		l.decodeFloat(&s.FuelFraction)
+	case "fullTeamName":
+		l.decodeString(&s.FullTeamName)
+	case "gamePhase":
+		l.decodeString(&s.GamePhase)
+	case "hasFocus":
+		l.decodeBool(&s.HasFocus)
+	case "headlights":
+		l.decodeBool(&s.Headlights)
+	case "inControl":
+		l.decodeFloat(&s.InControl)
+	case "inGarageStall":
+		l.decodeBool(&s.InGarageStall)
+	case "lapDistance":
+		l.decodeFloat(&s.LapDistance)
+	case "lapStartET":
+		l.decodeFloat(&s.LapStartET)
+	case "lapsBehindLeader":
+		l.decodeFloat(&s.LapsBehindLeader)
+	case "lapsBehindNext":
+		l.decodeFloat(&s.LapsBehindNext)
+	case "lapsCompleted":
+		l.decodeFloat(&s.LapsCompleted)
+	case "lastLapTime":
+		l.decodeFloat(&s.LastLapTime)
+	case "lastSectorTime1":
+		l.decodeFloat(&s.LastSectorTime1)
+	case "lastSectorTime2":
+		l.decodeFloat(&s.LastSectorTime2)
+	case "pathLateral":
+		l.decodeFloat(&s.PathLateral)
+	case "penalties":
+		l.decodeFloat(&s.Penalties)
+	case "pitGroup":
+		l.decodeString(&s.PitGroup)
+	case "pitLapDistance":
+		l.decodeFloat(&s.PitLapDistance)
+	case "pitState":
+		l.decodeString(&s.PitState)
+	case "pitstops":
+		l.decodeFloat(&s.Pitstops)
+	case "pitting":
+		l.decodeBool(&s.Pitting)
+	case "player":
+		l.decodeBool(&s.Player)
+	case "position":
+		l.decodeFloat(&s.Position)
+	case "qualification":
+		l.decodeFloat(&s.Qualification)
+	case "sector":
+		l.decodeString(&s.Sector)
+	case "serverScored":
+		l.decodeBool(&s.ServerScored)
+	case "slotID":
+		l.decodeFloat(&s.SlotID)
+	case "steamID":
+		l.decodeNumber(&s.SteamID)
+	case "timeBehindLeader":
+		l.decodeFloat(&s.TimeBehindLeader)
+	case "timeBehindNext":
+		l.decodeFloat(&s.TimeBehindNext)
+	case "timeIntoLap":
+		l.decodeFloat(&s.TimeIntoLap)
+	case "trackEdge":
+		l.decodeFloat(&s.TrackEdge)
+	case "underYellow":
+		l.decodeBool(&s.UnderYellow)
+	case "upgradePack":
+		l.decodeString(&s.UpgradePack)
+	case "vehicleFilename":
+		l.decodeString(&s.VehicleFilename)
+	case "vehicleName":
+		l.decodeString(&s.VehicleName)
+	default:
+		return false
+	}
+	return true
+}
+
+var restWatchStandingsResponseItemKeys = []string{"attackMode", "bestLapSectorTime1", "bestLapSectorTime2", "bestLapTime", "bestSectorTime1", "bestSectorTime2", "carAcceleration", "carClass", "carId", "carNumber", "carPosition", "carVelocity", "countLapFlag", "currentSectorTime1", "currentSectorTime2", "driverName", "drsActive", "estimatedLapTime", "finishStatus", "flag", "focus", "fuelFraction", "fullTeamName", "gamePhase", "hasFocus", "headlights", "inControl", "inGarageStall", "lapDistance", "lapStartET", "lapsBehindLeader", "lapsBehindNext", "lapsCompleted", "lastLapTime", "lastSectorTime1", "lastSectorTime2", "pathLateral", "penalties", "pitGroup", "pitLapDistance", "pitState", "pitstops", "pitting", "player", "position", "qualification", "sector", "serverScored", "slotID", "steamID", "timeBehindLeader", "timeBehindNext", "timeIntoLap", "trackEdge", "underYellow", "upgradePack", "vehicleFilename", "vehicleName"}
+
+func (s *RestWatchStandingsResponseItemAttackMode) decodeFast(l *jsonLexer) {
+	for key, ok := l.openObject("RestWatchStandingsResponseItemAttackMode"); ok; key, ok = l.nextKey("RestWatchStandingsResponseItemAttackMode") {
+		if !s.decodeField(l, key) && !s.decodeField(l, l.fold(key, restWatchStandingsResponseItemAttackModeKeys)) {
+			l.skip()
+		}
+	}
+}
+
+func (s *RestWatchStandingsResponseItemAttackMode) decodeField(l *jsonLexer, key []byte) bool {
+	switch string(key) {
+	case "remainingCount":
+		l.decodeFloat(&s.RemainingCount)
+	case "timeRemaining":
+		l.decodeFloat(&s.TimeRemaining)
+	case "totalCount":
+		l.decodeFloat(&s.TotalCount)
+	default:
+		return false
+	}
+	return true
+}
+
+var restWatchStandingsResponseItemAttackModeKeys = []string{"remainingCount", "timeRemaining", "totalCount"}
+
+func (s *RestWatchStandingsResponseItemCarAcceleration) decodeFast(l *jsonLexer) {
+	for key, ok := l.openObject("RestWatchStandingsResponseItemCarAcceleration"); ok; key, ok = l.nextKey("RestWatchStandingsResponseItemCarAcceleration") {
+		if !s.decodeField(l, key) && !s.decodeField(l, l.fold(key, restWatchStandingsResponseItemCarAccelerationKeys)) {
+			l.skip()
+		}
+	}
+}
+
+func (s *RestWatchStandingsResponseItemCarAcceleration) decodeField(l *jsonLexer, key []byte) bool {
+	switch string(key) {
+	case "velocity":
+		l.decodeFloat(&s.Velocity)
+	case "x":
+		l.decodeFloat(&s.X)
+	case "y":
+		l.decodeFloat(&s.Y)
+	case "z":
+		l.decodeFloat(&s.Z)
+	default:
+		return false
+	}
+	return true
+}
+
+var restWatchStandingsResponseItemCarAccelerationKeys = []string{"velocity", "x", "y", "z"}
+
+func (s *RestWatchStandingsResponseItemCarPosition) decodeFast(l *jsonLexer) {
+	for key, ok := l.openObject("RestWatchStandingsResponseItemCarPosition"); ok; key, ok = l.nextKey("RestWatchStandingsResponseItemCarPosition") {
+		if !s.decodeField(l, key) && !s.decodeField(l, l.fold(key, restWatchStandingsResponseItemCarPositionKeys)) {
+			l.skip()
+		}
+	}
+}
+
+func (s *RestWatchStandingsResponseItemCarPosition) decodeField(l *jsonLexer, key []byte) bool {
+	switch string(key) {
+	case "type":
+		l.decodeFloat(&s.Type)
+	case "x":
+		l.decodeFloat(&s.X)
+	case "y":
+		l.decodeFloat(&s.Y)
+	case "z":
+		l.decodeFloat(&s.Z)
+	default:
+		return false
+	}
+	return true
+}
+
+var restWatchStandingsResponseItemCarPositionKeys = []string{"type", "x", "y", "z"}
+
+func (s *RestWatchStandingsResponseItemCarVelocity) decodeFast(l *jsonLexer) {
+	for key, ok := l.openObject("RestWatchStandingsResponseItemCarVelocity"); ok; key, ok = l.nextKey("RestWatchStandingsResponseItemCarVelocity") {
+		if !s.decodeField(l, key) && !s.decodeField(l, l.fold(key, restWatchStandingsResponseItemCarVelocityKeys)) {
+			l.skip()
+		}
+	}
+}
+
+func (s *RestWatchStandingsResponseItemCarVelocity) decodeField(l *jsonLexer, key []byte) bool {
+	switch string(key) {
+	case "velocity":
+		l.decodeFloat(&s.Velocity)
+	case "x":
+		l.decodeFloat(&s.X)
+	case "y":
+		l.decodeFloat(&s.Y)
+	case "z":
+		l.decodeFloat(&s.Z)
+	default:
+		return false
+	}
+	return true
+}
+
+var restWatchStandingsResponseItemCarVelocityKeys = []string{"velocity", "x", "y", "z"}
@@ -0,0 +1,207 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ForecastNode is one step of the session weather forecast.
+type ForecastNode struct {
+	StartTime     float64 // session seconds at which the node begins
+	Duration      float64 // seconds
+	RainChance    float64 // percent
+	Sky           float64 // sky type index, 0 = clear
+	Temperature   float64 // °C
+	Humidity      float64 // percent
+	WindSpeed     float64 // m/s
+	WindDirection float64 // degrees
+}
+
+// Forecast is the weather forecast for the current session, in time order.
+type Forecast struct {
+	Nodes []ForecastNode
+}
+
+// Forecast returns the current session's forecast. The API reports it as
+// parallel arrays per property; this zips them into nodes.
+func (c *Client) Forecast() (*Forecast, error) {
+	data, err := c.doRequest("GET", "/rest/garage/UIScreen/RepairAndRefuel", nil)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		WeatherForecast struct {
+			Nodes struct {
+				Duration      []float64 `json:"Duration"`
+				Humidity      []float64 `json:"Humidity"`
+				RainChance    []float64 `json:"RainChance"`
+				Sky           []float64 `json:"Sky"`
+				StartTime     []float64 `json:"StartTime"`
+				Temperature   []float64 `json:"Temperature"`
+				WindDirection []float64 `json:"WindDirection"`
+				WindSpeed     []float64 `json:"WindSpeed"`
+			} `json:"nodes"`
+		} `json:"weatherForecast"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("decode forecast: %w", err)
+	}
+	n := result.WeatherForecast.Nodes
+	at := func(v []float64, i int) float64 {
+		if i < len(v) {
+			return v[i]
+		}
+		return 0
+	}
+	f := &Forecast{Nodes: make([]ForecastNode, len(n.StartTime))}
+	for i := range n.StartTime {
+		f.Nodes[i] = ForecastNode{
+			StartTime:     n.StartTime[i],
+			Duration:      at(n.Duration, i),
+			RainChance:    at(n.RainChance, i),
+			Sky:           at(n.Sky, i),
+			Temperature:   at(n.Temperature, i),
+			Humidity:      at(n.Humidity, i),
+			WindSpeed:     at(n.WindSpeed, i),
+			WindDirection: at(n.WindDirection, i),
+		}
+	}
+	return f, nil
+}
+
+// At returns the node in effect at session time t, or false before the
+// first node.
+func (f *Forecast) At(t float64) (ForecastNode, bool) {
+	var cur ForecastNode
+	found := false
+	for _, n := range f.Nodes {
+		if n.StartTime > t {
+			break
+		}
+		cur, found = n, true
+	}
+	return cur, found
+}
+
+// Timeline is the forecast resampled into even steps from now, for charts:
+// a weather radar strip on a dashboard or an overlay.
+type Timeline struct {
+	SessionTime float64          `json:"sessionTime"` // session seconds the timeline starts at
+	Step        float64          `json:"step"`        // bucket length, seconds
+	Wetness     float64          `json:"wetness"`     // current average wetness on the racing line, 0..1
+	Raining     float64          `json:"raining"`     // current rain intensity, 0..1
+	Buckets     []TimelineBucket `json:"buckets"`
+}
+
+// TimelineBucket is one step of a Timeline.
+type TimelineBucket struct {
+	Minute     float64 `json:"minute"`     // start, minutes from now
+	RainChance float64 `json:"rainChance"` // percent, at the middle of the bucket
+	// Wetness is the expected average wetness on the racing line at the end
+	// of the bucket, 0..1, and Trend whether the track is getting "wetter",
+	// "drier" or stays "steady" during it.
+	Wetness float64 `json:"wetness"`
+	Trend   string  `json:"trend"`
+}
+
+// Wetness model: the track goes towards the expected share of rain (the
+// rain chance) with these time constants. It is a rough guide for the
+// trend, the game does not publish its own.
+const (
+	wettingTime = 10 * 60 // seconds
+	dryingTime  = 20 * 60
+	trendStep   = 0.02
+)
+
+// Timeline resamples the forecast from session time now over span in
+// buckets of step, starting from the current conditions c. The rain
+// chance is interpolated linearly between the middles of the forecast
+// nodes and held before the first and after the last.
+func (f *Forecast) Timeline(now float64, c Conditions, span, step time.Duration) Timeline {
+	st := step.Seconds()
+	tl := Timeline{SessionTime: now, Step: st, Wetness: c.Wetness, Raining: c.Raining}
+	if st <= 0 {
+		return tl
+	}
+	w := c.Wetness
+	for t := 0.0; t < span.Seconds(); t += st {
+		rain := f.rainAt(now + t + st/2)
+		target := rain / 100
+		tau := float64(dryingTime)
+		if target > w {
+			tau = wettingTime
+		}
+		next := w + (target-w)*(1-math.Exp(-st/tau))
+		trend := "steady"
+		switch {
+		case next-w > trendStep:
+			trend = "wetter"
+		case w-next > trendStep:
+			trend = "drier"
+		}
+		tl.Buckets = append(tl.Buckets, TimelineBucket{
+			Minute:     t / 60,
+			RainChance: math.Round(rain*10) / 10,
+			Wetness:    math.Round(next*1000) / 1000,
+			Trend:      trend,
+		})
+		w = next
+	}
+	return tl
+}
+
+// rainAt is the rain chance at session time t, interpolated between the
+// middles of the nodes around it.
+func (f *Forecast) rainAt(t float64) float64 {
+	if f == nil || len(f.Nodes) == 0 {
+		return 0
+	}
+	mid := func(n ForecastNode) float64 { return n.StartTime + n.Duration/2 }
+	if t <= mid(f.Nodes[0]) {
+		return f.Nodes[0].RainChance
+	}
+	for i := 1; i < len(f.Nodes); i++ {
+		a, b := f.Nodes[i-1], f.Nodes[i]
+		if t > mid(b) {
+			continue
+		}
+		if d := mid(b) - mid(a); d > 0 {
+			return a.RainChance + (t-mid(a))/d*(b.RainChance-a.RainChance)
+		}
+		return b.RainChance
+	}
+	return f.Nodes[len(f.Nodes)-1].RainChance
+}
+
+// Conditions are the live track conditions from the session info.
+type Conditions struct {
+	Ambient   float64 // air temperature, °C
+	Track     float64 // track temperature, °C
+	Wind      float64 // wind speed, m/s
+	WindAngle float64 // direction the wind blows towards in track coordinates, degrees from +Z
+	Wetness   float64 // average wetness on the racing line, 0..1
+	Raining   float64 // rain intensity, 0..1
+}
+
+// ConditionsOf extracts the conditions from a session info response.
+func ConditionsOf(si *RestWatchSessionInfoResponse) Conditions {
+	w := si.WindSpeed
+	speed := w.Velocity
+	if speed == 0 {
+		speed = math.Hypot(w.X, w.Z)
+	}
+	angle := math.Atan2(w.X, w.Z) * 180 / math.Pi
+	if angle < 0 {
+		angle += 360
+	}
+	return Conditions{
+		Ambient:   si.AmbientTemp,
+		Track:     si.TrackTemp,
+		Wind:      speed,
+		WindAngle: angle,
+		Wetness:   si.AveragePathWetness,
+		Raining:   si.Raining,
+	}
+}